@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS service_accounts (
+    id varchar(36) UNIQUE,
+    name varchar(255) NOT NULL,
+    client_id varchar(255) NOT NULL UNIQUE,
+    client_secret_hash varchar(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS service_account_groups (
+    service_account_id varchar(36),
+    group_id varchar(36),
+    CONSTRAINT unique_service_account_group UNIQUE (service_account_id, group_id),
+    CONSTRAINT fk_service_account FOREIGN KEY(service_account_id) REFERENCES service_accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_group FOREIGN KEY(group_id) REFERENCES groups(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS service_account_groups;
+DROP TABLE IF EXISTS service_accounts;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id varchar(36) PRIMARY KEY,
+    secret varchar(255) NOT NULL,
+    recovery_codes text[] NOT NULL DEFAULT '{}',
+    last_used_step bigint NOT NULL DEFAULT 0,
+    enabled_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_mfa;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS group_permissions_permission_id_idx ON group_permissions (permission_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS group_permissions_permission_id_idx;
+-- +goose StatementEnd
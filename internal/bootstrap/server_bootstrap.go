@@ -6,12 +6,15 @@ import (
 	"net"
 
 	"net/http"
+	"time"
 
 	"github.com/krobus00/auth-service/internal/config"
 	"github.com/krobus00/auth-service/internal/infrastructure"
 	"github.com/krobus00/auth-service/internal/repository"
 	grpcTransport "github.com/krobus00/auth-service/internal/transport/grpc"
+	httpTransport "github.com/krobus00/auth-service/internal/transport/http"
 	"github.com/krobus00/auth-service/internal/usecase"
+	"github.com/krobus00/auth-service/internal/utils"
 	pb "github.com/krobus00/auth-service/pb/auth"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -33,6 +36,9 @@ func StartServer() {
 	tp, err := infrastructure.JaegerTraceProvider()
 	continueOrFatal(err)
 
+	err = utils.LoadTokenKeys()
+	continueOrFatal(err)
+
 	// init repository
 	userRepo := repository.NewUserRepository()
 	err = userRepo.InjectDB(infrastructure.DB)
@@ -68,7 +74,93 @@ func StartServer() {
 	err = groupPermissionRepo.InjectRedisClient(redisClient)
 	continueOrFatal(err)
 
+	securityEventRepo := repository.NewSecurityEventRepository()
+	err = securityEventRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+
+	personalAccessTokenRepo := repository.NewPersonalAccessTokenRepository()
+	err = personalAccessTokenRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = personalAccessTokenRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	serviceAccountRepo := repository.NewServiceAccountRepository()
+	err = serviceAccountRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = serviceAccountRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	serviceAccountGroupRepo := repository.NewServiceAccountGroupRepository()
+	err = serviceAccountGroupRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = serviceAccountGroupRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	oauthClientRepo := repository.NewOAuthClientRepository()
+	err = oauthClientRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = oauthClientRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	authorizationCodeRepo := repository.NewAuthorizationCodeRepository()
+	err = authorizationCodeRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	userIdentityRepo := repository.NewUserIdentityRepository()
+	err = userIdentityRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = userIdentityRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	federationStateRepo := repository.NewFederationStateRepository()
+	err = federationStateRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	identityProviderRepo := repository.NewIdentityProviderRepository()
+	err = identityProviderRepo.InjectHTTPClient(&http.Client{Timeout: 10 * time.Second})
+	continueOrFatal(err)
+
+	userMFARepo := repository.NewUserMFARepository()
+	err = userMFARepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+
+	mfaChallengeRepo := repository.NewMFAChallengeRepository()
+	err = mfaChallengeRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	emailVerificationRepo := repository.NewEmailVerificationRepository()
+	err = emailVerificationRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	passwordResetRepo := repository.NewPasswordResetRepository()
+	err = passwordResetRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	passwordHistoryRepo := repository.NewPasswordHistoryRepository()
+	err = passwordHistoryRepo.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+
+	loginAttemptRepo := repository.NewLoginAttemptRepository()
+	err = loginAttemptRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	rateLimitRepo := repository.NewRateLimitRepository()
+	err = rateLimitRepo.InjectRedisClient(redisClient)
+	continueOrFatal(err)
+
+	mailer, err := infrastructure.NewMailer()
+	continueOrFatal(err)
+
+	breachedPasswords, err := infrastructure.NewBreachedPasswordList()
+	continueOrFatal(err)
+
 	// init usecase
+	passwordPolicyUsecase := usecase.NewPasswordPolicyUsecase()
+	err = passwordPolicyUsecase.InjectPasswordHistoryRepo(passwordHistoryRepo)
+	continueOrFatal(err)
+	err = passwordPolicyUsecase.InjectBreachedPasswordList(breachedPasswords)
+	continueOrFatal(err)
+
 	userUsecase := usecase.NewUserUsecase()
 	err = userUsecase.InjectDB(infrastructure.DB)
 	continueOrFatal(err)
@@ -76,14 +168,111 @@ func StartServer() {
 	continueOrFatal(err)
 	err = userUsecase.InjectTokenRepo(tokenRepo)
 	continueOrFatal(err)
+	err = userUsecase.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+	continueOrFatal(err)
 	err = userUsecase.InjectGroupRepo(groupRepo)
 	continueOrFatal(err)
 	err = userUsecase.InjectUserGroupRepo(userGroupRepo)
 	continueOrFatal(err)
+	err = userUsecase.InjectSecurityEventRepo(securityEventRepo)
+	continueOrFatal(err)
+	err = userUsecase.InjectPasswordPolicyUsecase(passwordPolicyUsecase)
+	continueOrFatal(err)
+
+	mfaUsecase := usecase.NewMFAUsecase()
+	err = mfaUsecase.InjectUserUsecase(userUsecase)
+	continueOrFatal(err)
+	err = mfaUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = mfaUsecase.InjectUserMFARepo(userMFARepo)
+	continueOrFatal(err)
+	err = mfaUsecase.InjectMFAChallengeRepo(mfaChallengeRepo)
+	continueOrFatal(err)
+	err = mfaUsecase.InjectSecurityEventRepo(securityEventRepo)
+	continueOrFatal(err)
+
+	err = userUsecase.InjectMFAUsecase(mfaUsecase)
+	continueOrFatal(err)
+
+	emailVerificationUsecase := usecase.NewEmailVerificationUsecase()
+	err = emailVerificationUsecase.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = emailVerificationUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = emailVerificationUsecase.InjectGroupRepo(groupRepo)
+	continueOrFatal(err)
+	err = emailVerificationUsecase.InjectUserGroupRepo(userGroupRepo)
+	continueOrFatal(err)
+	err = emailVerificationUsecase.InjectEmailVerificationRepo(emailVerificationRepo)
+	continueOrFatal(err)
+	err = emailVerificationUsecase.InjectSecurityEventRepo(securityEventRepo)
+	continueOrFatal(err)
+	err = emailVerificationUsecase.InjectMailer(mailer)
+	continueOrFatal(err)
+
+	err = userUsecase.InjectEmailVerificationUsecase(emailVerificationUsecase)
+	continueOrFatal(err)
+
+	passwordResetUsecase := usecase.NewPasswordResetUsecase()
+	err = passwordResetUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = passwordResetUsecase.InjectTokenRepo(tokenRepo)
+	continueOrFatal(err)
+	err = passwordResetUsecase.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+	continueOrFatal(err)
+	err = passwordResetUsecase.InjectPasswordResetRepo(passwordResetRepo)
+	continueOrFatal(err)
+	err = passwordResetUsecase.InjectSecurityEventRepo(securityEventRepo)
+	continueOrFatal(err)
+	err = passwordResetUsecase.InjectMailer(mailer)
+	continueOrFatal(err)
+	err = passwordResetUsecase.InjectPasswordPolicyUsecase(passwordPolicyUsecase)
+	continueOrFatal(err)
 
 	authUsecase := usecase.NewAuthUsecase()
 	err = authUsecase.InjectUserGroupRepo(userGroupRepo)
 	continueOrFatal(err)
+	err = authUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = authUsecase.InjectTokenRepo(tokenRepo)
+	continueOrFatal(err)
+	err = authUsecase.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+	continueOrFatal(err)
+	err = authUsecase.InjectServiceAccountGroupRepo(serviceAccountGroupRepo)
+	continueOrFatal(err)
+
+	loginAttemptUsecase := usecase.NewLoginAttemptUsecase()
+	err = loginAttemptUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = loginAttemptUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = loginAttemptUsecase.InjectLoginAttemptRepo(loginAttemptRepo)
+	continueOrFatal(err)
+	err = loginAttemptUsecase.InjectSecurityEventRepo(securityEventRepo)
+	continueOrFatal(err)
+
+	err = userUsecase.InjectLoginAttemptUsecase(loginAttemptUsecase)
+	continueOrFatal(err)
+	err = mfaUsecase.InjectLoginAttemptUsecase(loginAttemptUsecase)
+	continueOrFatal(err)
+	err = userUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+
+	rateLimitUsecase := usecase.NewRateLimitUsecase()
+	err = rateLimitUsecase.InjectRateLimitRepo(rateLimitRepo)
+	continueOrFatal(err)
+
+	userStatusUsecase := usecase.NewUserStatusUsecase()
+	err = userStatusUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = userStatusUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = userStatusUsecase.InjectTokenRepo(tokenRepo)
+	continueOrFatal(err)
+	err = userStatusUsecase.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+	continueOrFatal(err)
+	err = userStatusUsecase.InjectSecurityEventRepo(securityEventRepo)
+	continueOrFatal(err)
 
 	permissionUsecase := usecase.NewPermissionUsecase()
 	err = permissionUsecase.InjectPermissionRepo(permissionRepo)
@@ -106,6 +295,8 @@ func StartServer() {
 	continueOrFatal(err)
 	err = userGroupUsecase.InjectUserGroupRepo(userGroupRepo)
 	continueOrFatal(err)
+	err = userGroupUsecase.InjectPermissionRepo(permissionRepo)
+	continueOrFatal(err)
 
 	groupPermissionUsecase := usecase.NewGroupPermissionUsecase()
 	err = groupPermissionUsecase.InjectAuthUsecase(authUsecase)
@@ -117,6 +308,83 @@ func StartServer() {
 	err = groupPermissionUsecase.InjectPermisisonRepo(permissionRepo)
 	continueOrFatal(err)
 
+	sessionUsecase := usecase.NewSessionUsecase()
+	err = sessionUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = sessionUsecase.InjectTokenRepo(tokenRepo)
+	continueOrFatal(err)
+
+	personalAccessTokenUsecase := usecase.NewPersonalAccessTokenUsecase()
+	err = personalAccessTokenUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = personalAccessTokenUsecase.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+	continueOrFatal(err)
+
+	serviceAccountUsecase := usecase.NewServiceAccountUsecase()
+	err = serviceAccountUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = serviceAccountUsecase.InjectServiceAccountRepo(serviceAccountRepo)
+	continueOrFatal(err)
+	err = serviceAccountUsecase.InjectServiceAccountGroupRepo(serviceAccountGroupRepo)
+	continueOrFatal(err)
+	err = serviceAccountUsecase.InjectGroupRepo(groupRepo)
+	continueOrFatal(err)
+	err = serviceAccountUsecase.InjectGroupPermissionRepo(groupPermissionRepo)
+	continueOrFatal(err)
+	err = serviceAccountUsecase.InjectTokenRepo(tokenRepo)
+	continueOrFatal(err)
+
+	oauthUsecase := usecase.NewOAuthUsecase()
+	err = oauthUsecase.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = oauthUsecase.InjectUserUsecase(userUsecase)
+	continueOrFatal(err)
+	err = oauthUsecase.InjectMFAUsecase(mfaUsecase)
+	continueOrFatal(err)
+	err = oauthUsecase.InjectOAuthClientRepo(oauthClientRepo)
+	continueOrFatal(err)
+	err = oauthUsecase.InjectAuthorizationCodeRepo(authorizationCodeRepo)
+	continueOrFatal(err)
+	err = oauthUsecase.InjectTokenRepo(tokenRepo)
+	continueOrFatal(err)
+
+	if config.LDAPEnabled() {
+		directoryUsecase := usecase.NewDirectoryUsecase()
+		err = directoryUsecase.InjectDB(infrastructure.DB)
+		continueOrFatal(err)
+		err = directoryUsecase.InjectUserUsecase(userUsecase)
+		continueOrFatal(err)
+		err = directoryUsecase.InjectUserRepo(userRepo)
+		continueOrFatal(err)
+		err = directoryUsecase.InjectGroupRepo(groupRepo)
+		continueOrFatal(err)
+		err = directoryUsecase.InjectUserGroupRepo(userGroupRepo)
+		continueOrFatal(err)
+		err = directoryUsecase.InjectUserIdentityRepo(userIdentityRepo)
+		continueOrFatal(err)
+		err = directoryUsecase.InjectDirectoryRepo(repository.NewDirectoryRepository())
+		continueOrFatal(err)
+
+		err = userUsecase.InjectAuthenticators(directoryUsecase)
+		continueOrFatal(err)
+	}
+
+	userIdentityUsecase := usecase.NewUserIdentityUsecase()
+	err = userIdentityUsecase.InjectDB(infrastructure.DB)
+	continueOrFatal(err)
+	err = userIdentityUsecase.InjectOAuthUsecase(oauthUsecase)
+	continueOrFatal(err)
+	err = userIdentityUsecase.InjectUserUsecase(userUsecase)
+	continueOrFatal(err)
+	err = userIdentityUsecase.InjectUserRepo(userRepo)
+	continueOrFatal(err)
+	err = userIdentityUsecase.InjectUserIdentityRepo(userIdentityRepo)
+	continueOrFatal(err)
+	err = userIdentityUsecase.InjectFederationStateRepo(federationStateRepo)
+	continueOrFatal(err)
+	err = userIdentityUsecase.InjectIdentityProviderRepo(identityProviderRepo)
+	continueOrFatal(err)
+
 	grpcDelivery := grpcTransport.NewGRPCServer()
 	err = grpcDelivery.InjectUserUsecase(userUsecase)
 	continueOrFatal(err)
@@ -130,8 +398,53 @@ func StartServer() {
 	continueOrFatal(err)
 	err = grpcDelivery.InjectGroupPermissionUsecase(groupPermissionUsecase)
 	continueOrFatal(err)
+	err = grpcDelivery.InjectSessionUsecase(sessionUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectPersonalAccessTokenUsecase(personalAccessTokenUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectServiceAccountUsecase(serviceAccountUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectOAuthUsecase(oauthUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectUserIdentityUsecase(userIdentityUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectMFAUsecase(mfaUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectEmailVerificationUsecase(emailVerificationUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectPasswordResetUsecase(passwordResetUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectLoginAttemptUsecase(loginAttemptUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectRateLimitUsecase(rateLimitUsecase)
+	continueOrFatal(err)
+	err = grpcDelivery.InjectUserStatusUsecase(userStatusUsecase)
+	continueOrFatal(err)
 
-	authGrpcServer := grpc.NewServer()
+	httpDelivery := httpTransport.NewHTTPServer()
+	err = httpDelivery.InjectAuthUsecase(authUsecase)
+	continueOrFatal(err)
+	err = httpDelivery.InjectOAuthUsecase(oauthUsecase)
+	continueOrFatal(err)
+	err = httpDelivery.InjectUserIdentityUsecase(userIdentityUsecase)
+	continueOrFatal(err)
+	err = httpDelivery.InjectEmailVerificationUsecase(emailVerificationUsecase)
+	continueOrFatal(err)
+
+	// address limits run before authentication, user and client limits need the resolved caller
+	interceptors := []grpc.UnaryServerInterceptor{
+		grpcDelivery.UnarySessionMetadataInterceptor,
+	}
+	if config.RateLimitEnabled() {
+		interceptors = append(interceptors, grpcDelivery.UnaryAddressRateLimitInterceptor)
+	}
+	interceptors = append(interceptors, grpcDelivery.UnaryAuthInterceptor)
+	if config.RateLimitEnabled() {
+		interceptors = append(interceptors, grpcDelivery.UnaryRateLimitInterceptor)
+	}
+	authGrpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)
 
 	pb.RegisterAuthServiceServer(authGrpcServer, grpcDelivery)
 	if config.Env() == "development" {
@@ -145,12 +458,26 @@ func StartServer() {
 	logrus.Info(fmt.Sprintf("grpc server started on :%s", config.PortGRPC()))
 
 	http.Handle("/metrics", promhttp.Handler())
+	http.HandleFunc("/.well-known/jwks.json", httpDelivery.JWKS)
 
 	go func() {
 		_ = http.ListenAndServe(fmt.Sprintf(":%s", config.PortMetrics()), nil)
 	}()
 	logrus.Info(fmt.Sprintf("metrics server started on :%s", config.PortMetrics()))
 
+	httpMux := http.NewServeMux()
+	httpDelivery.RegisterHandlers(httpMux)
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%s", config.PortHTTP()),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		_ = httpServer.ListenAndServe()
+	}()
+	logrus.Info(fmt.Sprintf("http server started on :%s", config.PortHTTP()))
+
 	wait := gracefulShutdown(context.Background(), config.GracefulShutdownTimeOut(), map[string]operation{
 		"redis connection": func(ctx context.Context) error {
 			return redisClient.Close()
@@ -162,6 +489,9 @@ func StartServer() {
 		"grpc": func(ctx context.Context) error {
 			return lis.Close()
 		},
+		"http": func(ctx context.Context) error {
+			return httpServer.Shutdown(ctx)
+		},
 		"trace provider": func(ctx context.Context) error {
 			return tp.Shutdown(ctx)
 		},
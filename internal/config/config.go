@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -45,6 +46,10 @@ func PortMetrics() string {
 	return viper.GetString("ports.metrics")
 }
 
+func PortHTTP() string {
+	return viper.GetString("ports.http")
+}
+
 func GracefulShutdownTimeOut() time.Duration {
 	cfg := viper.GetString("graceful_shutdown_timeout")
 	return parseDuration(cfg, DefaultGracefulShutdownTimeOut)
@@ -166,6 +171,40 @@ func TokenSecret() string {
 	return viper.GetString("jwt.secret_key")
 }
 
+func TokenSigningMethod() string {
+	if viper.IsSet("jwt.signing_method") {
+		return viper.GetString("jwt.signing_method")
+	}
+	return DefaultTokenSigningMethod
+}
+
+func TokenSigningKeyID() string {
+	return viper.GetString("jwt.signing_key_id")
+}
+
+func TokenSigningKeyFile() string {
+	return viper.GetString("jwt.signing_key_file")
+}
+
+// TokenAcceptSharedSecret keep HS256 tokens signed with jwt.secret_key valid once an asymmetric
+// method is set, so the tokens issued before the migration are not revoked all at once.
+func TokenAcceptSharedSecret() bool {
+	return viper.GetBool("jwt.accept_shared_secret")
+}
+
+// TokenVerificationKey is an additional public key accepted when verifying tokens, used while rotating keys.
+type TokenVerificationKey struct {
+	KeyID     string `mapstructure:"key_id"`
+	Algorithm string `mapstructure:"algorithm"`
+	File      string `mapstructure:"file"`
+}
+
+func TokenVerificationKeys() []TokenVerificationKey {
+	keys := make([]TokenVerificationKey, 0)
+	_ = viper.UnmarshalKey("jwt.verification_keys", &keys)
+	return keys
+}
+
 func AccessTokenDuration() time.Duration {
 	cfg := viper.GetString("jwt.access_token_duration")
 	return parseDuration(cfg, DefaultAccessTokenDuration)
@@ -176,17 +215,358 @@ func RefreshTokenDuration() time.Duration {
 	return parseDuration(cfg, DefaultRefreshTokenDuration)
 }
 
+func PersonalAccessTokenDuration() time.Duration {
+	cfg := viper.GetString("personal_access_token.default_duration")
+	return parseDuration(cfg, DefaultPersonalAccessTokenDuration)
+}
+
+func PersonalAccessTokenMaxDuration() time.Duration {
+	cfg := viper.GetString("personal_access_token.max_duration")
+	return parseDuration(cfg, DefaultPersonalAccessTokenMaxDuration)
+}
+
+func OAuthAuthorizationCodeDuration() time.Duration {
+	cfg := viper.GetString("oauth.authorization_code_duration")
+	return parseDuration(cfg, DefaultOAuthAuthorizationCodeDuration)
+}
+
+// OAuthIssuer is the public base url of the http server, used as the OpenID Connect issuer.
+func OAuthIssuer() string {
+	issuer := strings.TrimSuffix(viper.GetString("oauth.issuer"), "/")
+	if issuer == "" {
+		return fmt.Sprintf("http://localhost:%s", PortHTTP())
+	}
+	return issuer
+}
+
+func OAuthFederationStateDuration() time.Duration {
+	cfg := viper.GetString("oauth.federation_state_duration")
+	return parseDuration(cfg, DefaultOAuthFederationStateDuration)
+}
+
+// IdentityProvider is an upstream OpenID Connect provider users can sign in with.
+type IdentityProvider struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+	// TrustEmail accept emails the provider doesn't mark as verified, for providers that never send email_verified.
+	TrustEmail bool `mapstructure:"trust_email"`
+}
+
+func IdentityProviders() []IdentityProvider {
+	providers := make([]IdentityProvider, 0)
+	_ = viper.UnmarshalKey("identity_providers", &providers)
+	return providers
+}
+
+func LDAPEnabled() bool {
+	return viper.GetBool("ldap.enabled")
+}
+
+func LDAPURL() string {
+	return viper.GetString("ldap.url")
+}
+
+func LDAPStartTLS() bool {
+	return viper.GetBool("ldap.start_tls")
+}
+
+// LDAPBindDN and LDAPBindPassword is the service account used to search for the user entry.
+func LDAPBindDN() string {
+	return viper.GetString("ldap.bind_dn")
+}
+
+func LDAPBindPassword() string {
+	return viper.GetString("ldap.bind_password")
+}
+
+func LDAPBaseDN() string {
+	return viper.GetString("ldap.base_dn")
+}
+
+// LDAPUserFilter is a filter with a single %s replaced by the escaped username.
+func LDAPUserFilter() string {
+	if viper.GetString("ldap.user_filter") == "" {
+		return DefaultLDAPUserFilter
+	}
+	return viper.GetString("ldap.user_filter")
+}
+
+func LDAPUsernameAttribute() string {
+	if viper.GetString("ldap.username_attribute") == "" {
+		return DefaultLDAPUsernameAttribute
+	}
+	return viper.GetString("ldap.username_attribute")
+}
+
+func LDAPEmailAttribute() string {
+	if viper.GetString("ldap.email_attribute") == "" {
+		return DefaultLDAPEmailAttribute
+	}
+	return viper.GetString("ldap.email_attribute")
+}
+
+func LDAPNameAttribute() string {
+	if viper.GetString("ldap.name_attribute") == "" {
+		return DefaultLDAPNameAttribute
+	}
+	return viper.GetString("ldap.name_attribute")
+}
+
+func LDAPGroupAttribute() string {
+	if viper.GetString("ldap.group_attribute") == "" {
+		return DefaultLDAPGroupAttribute
+	}
+	return viper.GetString("ldap.group_attribute")
+}
+
+func LDAPTimeout() time.Duration {
+	cfg := viper.GetString("ldap.timeout")
+	return parseDuration(cfg, DefaultLDAPTimeout)
+}
+
+// LDAPGroupMapping map a directory group to the local group its members are synced into.
+type LDAPGroupMapping struct {
+	DN    string `mapstructure:"dn"`
+	Group string `mapstructure:"group"`
+}
+
+func LDAPGroupMappings() []LDAPGroupMapping {
+	mappings := make([]LDAPGroupMapping, 0)
+	_ = viper.UnmarshalKey("ldap.group_mapping", &mappings)
+	return mappings
+}
+
+// MFAIssuer is the account issuer shown by authenticator apps.
+func MFAIssuer() string {
+	if viper.GetString("mfa.issuer") == "" {
+		return DefaultMFAIssuer
+	}
+	return viper.GetString("mfa.issuer")
+}
+
+func MFAChallengeDuration() time.Duration {
+	cfg := viper.GetString("mfa.challenge_duration")
+	return parseDuration(cfg, DefaultMFAChallengeDuration)
+}
+
+// MFAMaxAttempts is the number of wrong codes after which the mfa challenge is dropped.
+func MFAMaxAttempts() int {
+	if viper.GetInt("mfa.max_attempts") <= 0 {
+		return DefaultMFAMaxAttempts
+	}
+	return viper.GetInt("mfa.max_attempts")
+}
+
+// EmailVerificationPolicy is how unverified users are treated: none, restrict or block.
+func EmailVerificationPolicy() string {
+	switch policy := strings.ToLower(viper.GetString("email_verification.policy")); policy {
+	case EmailVerificationPolicyRestrict, EmailVerificationPolicyBlock:
+		return policy
+	default:
+		return EmailVerificationPolicyNone
+	}
+}
+
+func EmailVerificationTokenDuration() time.Duration {
+	cfg := viper.GetString("email_verification.token_duration")
+	return parseDuration(cfg, DefaultEmailVerificationTokenDuration)
+}
+
+// EmailVerificationURL is the link sent to the user, the token is appended as the token query parameter.
+func EmailVerificationURL() string {
+	if viper.GetString("email_verification.url") == "" {
+		return OAuthIssuer() + "/verify-email"
+	}
+	return viper.GetString("email_verification.url")
+}
+
+func PasswordResetTokenDuration() time.Duration {
+	cfg := viper.GetString("password_reset.token_duration")
+	return parseDuration(cfg, DefaultPasswordResetTokenDuration)
+}
+
+// PasswordResetURL is the page of the client app the user choose a new password on, the token
+// is appended as the token query parameter.
+func PasswordResetURL() string {
+	if viper.GetString("password_reset.url") == "" {
+		return OAuthIssuer() + "/reset-password"
+	}
+	return viper.GetString("password_reset.url")
+}
+
+func PasswordPolicyMinLength() int {
+	if viper.GetInt("password_policy.min_length") <= 0 {
+		return DefaultPasswordPolicyMinLength
+	}
+	return viper.GetInt("password_policy.min_length")
+}
+
+func PasswordPolicyRequireUppercase() bool {
+	return viper.GetBool("password_policy.require_uppercase")
+}
+
+func PasswordPolicyRequireLowercase() bool {
+	return viper.GetBool("password_policy.require_lowercase")
+}
+
+func PasswordPolicyRequireDigit() bool {
+	return viper.GetBool("password_policy.require_digit")
+}
+
+func PasswordPolicyRequireSymbol() bool {
+	return viper.GetBool("password_policy.require_symbol")
+}
+
+// PasswordPolicyBreachedPasswordFile is a local list of known breached passwords, one per line.
+// No file means the check is skipped.
+func PasswordPolicyBreachedPasswordFile() string {
+	return viper.GetString("password_policy.breached_password_file")
+}
+
+// PasswordPolicyHistorySize is how many previous passwords can't be reused, 0 turn the check off.
+func PasswordPolicyHistorySize() int {
+	if !viper.IsSet("password_policy.history_size") || viper.GetInt("password_policy.history_size") < 0 {
+		return DefaultPasswordPolicyHistorySize
+	}
+	return viper.GetInt("password_policy.history_size")
+}
+
+// MailerDriver select how mails are delivered: smtp, log or memory.
+func MailerDriver() string {
+	if viper.GetString("mailer.driver") == "" {
+		return DefaultMailerDriver
+	}
+	return strings.ToLower(viper.GetString("mailer.driver"))
+}
+
+func MailerFrom() string {
+	if viper.GetString("mailer.from") == "" {
+		return DefaultMailerFrom
+	}
+	return viper.GetString("mailer.from")
+}
+
+func MailerSMTPAddr() string {
+	return viper.GetString("mailer.smtp_addr")
+}
+
+func MailerSMTPUsername() string {
+	return viper.GetString("mailer.smtp_username")
+}
+
+func MailerSMTPPassword() string {
+	return viper.GetString("mailer.smtp_password")
+}
+
+func MailerTimeout() time.Duration {
+	cfg := viper.GetString("mailer.timeout")
+	return parseDuration(cfg, DefaultMailerTimeout)
+}
+
+// LoginAttemptMaxAttempts is how many failed attempts lock an account.
+func LoginAttemptMaxAttempts() int64 {
+	if viper.GetInt64("login_attempt.max_attempts") <= 0 {
+		return DefaultLoginAttemptMaxAttempts
+	}
+	return viper.GetInt64("login_attempt.max_attempts")
+}
+
+// LoginAttemptIPMaxAttempts is how many failed attempts block a client address.
+func LoginAttemptIPMaxAttempts() int64 {
+	if viper.GetInt64("login_attempt.ip_max_attempts") <= 0 {
+		return DefaultLoginAttemptIPMaxAttempts
+	}
+	return viper.GetInt64("login_attempt.ip_max_attempts")
+}
+
+// LoginAttemptFailureWindow is how long failures are remembered after the last one.
+func LoginAttemptFailureWindow() time.Duration {
+	cfg := viper.GetString("login_attempt.failure_window")
+	return parseDuration(cfg, DefaultLoginAttemptFailureWindow)
+}
+
+// LoginAttemptLockout is the cool-down before a locked subject is unlocked.
+func LoginAttemptLockout() time.Duration {
+	cfg := viper.GetString("login_attempt.lockout")
+	return parseDuration(cfg, DefaultLoginAttemptLockout)
+}
+
+// LoginAttemptBackoffBase is the delay after the first failure, doubled on every next one.
+func LoginAttemptBackoffBase() time.Duration {
+	cfg := viper.GetString("login_attempt.backoff_base")
+	return parseDuration(cfg, DefaultLoginAttemptBackoffBase)
+}
+
+func LoginAttemptBackoffMax() time.Duration {
+	cfg := viper.GetString("login_attempt.backoff_max")
+	return parseDuration(cfg, DefaultLoginAttemptBackoffMax)
+}
+
+func RateLimitEnabled() bool {
+	return viper.GetBool("rate_limit.enabled")
+}
+
+// RateLimitRule is the token bucket limiting one rpc, identified by its full method name or * for every rpc.
+// The bucket hold up to Burst requests and is refilled with Requests every Period.
+type RateLimitRule struct {
+	Method string `mapstructure:"method"`
+	// Key is what the bucket is counted for: ip, user or client, ip rules are counted before authentication
+	Key      string        `mapstructure:"key"`
+	Requests int64         `mapstructure:"requests"`
+	Period   time.Duration `mapstructure:"period"`
+	Burst    int64         `mapstructure:"burst"`
+}
+
+func RateLimitRules() []RateLimitRule {
+	rules := make([]RateLimitRule, 0)
+	_ = viper.UnmarshalKey("rate_limit.rules", &rules)
+	return rules
+}
+
 func BcryptCost() int {
 	if viper.GetInt("bcrypt.cost") > 4 && viper.GetInt("bcrypt.cost") < 31 {
-		return viper.GetInt("redis.bcrypt.cost")
+		return viper.GetInt("bcrypt.cost")
 	}
 	return DefaultBycryptCost
 }
 
+// BcryptSalt is appended to the password of legacy bcrypt hashes, it's only used to verify them.
 func BcryptSalt() string {
 	return viper.GetString("bcrypt.salt")
 }
 
+// PasswordHashPepper is a secret mixed into every new password hash. It's kept out of the
+// database so a leaked dump can't be cracked offline, changing it invalidate every password.
+func PasswordHashPepper() string {
+	return viper.GetString("password_hash.pepper")
+}
+
+// PasswordHashArgon2Memory is the argon2id memory cost in KiB.
+func PasswordHashArgon2Memory() uint32 {
+	if viper.GetUint32("password_hash.argon2id.memory") < MinPasswordHashArgon2Memory {
+		return DefaultPasswordHashArgon2Memory
+	}
+	return viper.GetUint32("password_hash.argon2id.memory")
+}
+
+func PasswordHashArgon2Iterations() uint32 {
+	if viper.GetUint32("password_hash.argon2id.iterations") == 0 {
+		return DefaultPasswordHashArgon2Iterations
+	}
+	return viper.GetUint32("password_hash.argon2id.iterations")
+}
+
+func PasswordHashArgon2Parallelism() uint8 {
+	parallelism := viper.GetUint("password_hash.argon2id.parallelism")
+	if parallelism == 0 || parallelism > 255 {
+		return DefaultPasswordHashArgon2Parallelism
+	}
+	return uint8(parallelism)
+}
+
 func JaegerProtocol() string {
 	return viper.GetString("jaeger.protocol")
 }
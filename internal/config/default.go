@@ -19,8 +19,53 @@ const (
 	DefaultRedisReadTimeout  = 2 * time.Second
 	DefaultRedisCacheTTL     = 15 * time.Minute
 
+	DefaultTokenSigningMethod   = "HS256"
 	DefaultAccessTokenDuration  = 15 * time.Minute
 	DefaultRefreshTokenDuration = 24 * time.Hour
 
+	DefaultPersonalAccessTokenDuration    = 90 * 24 * time.Hour
+	DefaultPersonalAccessTokenMaxDuration = 365 * 24 * time.Hour
+
+	DefaultOAuthAuthorizationCodeDuration = 1 * time.Minute
+	DefaultOAuthFederationStateDuration   = 10 * time.Minute
+
+	DefaultLDAPUserFilter        = "(uid=%s)"
+	DefaultLDAPUsernameAttribute = "uid"
+	DefaultLDAPEmailAttribute    = "mail"
+	DefaultLDAPNameAttribute     = "cn"
+	DefaultLDAPGroupAttribute    = "memberOf"
+	DefaultLDAPTimeout           = 5 * time.Second
+
+	DefaultMFAIssuer            = "auth-service"
+	DefaultMFAChallengeDuration = 5 * time.Minute
+	DefaultMFAMaxAttempts       = 5
+
+	EmailVerificationPolicyNone           = "none"
+	EmailVerificationPolicyRestrict       = "restrict"
+	EmailVerificationPolicyBlock          = "block"
+	DefaultEmailVerificationTokenDuration = 24 * time.Hour
+
+	DefaultPasswordResetTokenDuration = 1 * time.Hour
+
+	DefaultPasswordPolicyMinLength   = 8
+	DefaultPasswordPolicyHistorySize = 5
+
+	DefaultMailerDriver  = "log"
+	DefaultMailerFrom    = "no-reply@localhost"
+	DefaultMailerTimeout = 10 * time.Second
+
+	DefaultLoginAttemptMaxAttempts   = 5
+	DefaultLoginAttemptIPMaxAttempts = 50
+	DefaultLoginAttemptFailureWindow = 15 * time.Minute
+	DefaultLoginAttemptLockout       = 15 * time.Minute
+	DefaultLoginAttemptBackoffBase   = 1 * time.Second
+	DefaultLoginAttemptBackoffMax    = 30 * time.Second
+
 	DefaultBycryptCost = 10
+
+	// OWASP recommended argon2id parameters
+	DefaultPasswordHashArgon2Memory      = 19 * 1024
+	DefaultPasswordHashArgon2Iterations  = 2
+	DefaultPasswordHashArgon2Parallelism = 1
+	MinPasswordHashArgon2Memory          = 8 * 1024
 )
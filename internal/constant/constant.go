@@ -3,9 +3,18 @@ package constant
 type ctxKey string
 
 const (
-	KeyDBCtx     ctxKey = "DB"
-	KeyUserIDCtx ctxKey = "USERID"
+	KeyDBCtx      ctxKey = "DB"
+	KeyUserIDCtx  ctxKey = "USERID"
+	KeyTokenIDCtx ctxKey = "TOKENID"
+	// KeyTokenScopesCtx hold the scopes of a scoped token, it is absent for unscoped tokens.
+	KeyTokenScopesCtx ctxKey = "TOKENSCOPES"
+	// KeyClientIDCtx hold the OAuth client the token was issued to, it is absent for first party tokens.
+	KeyClientIDCtx ctxKey = "CLIENTID"
 
+	KeySessionMetadataCtx ctxKey = "SESSIONMETADATA"
+
+	// SystemID is only reachable from in-process callers (e.g. the permission seeder),
+	// it is never derived from a request.
 	SystemID = string("SYSTEM")
 	GuestID  = string("GUEST")
 )
@@ -4,6 +4,8 @@ const (
 	// define group.
 	GroupDefault   = "DEFAULT"
 	GroupSuperUser = "SUPER_USER"
+	// GroupUnverified hold the users waiting for their email verification, it grant nothing.
+	GroupUnverified = "UNVERIFIED"
 
 	// define permission.
 	PermissionFullAccess = "FULL_ACCESS"
@@ -30,6 +32,28 @@ const (
 	PermissionUserGroupRead   = "USER_GROUP_READ"
 	PermissionUserGroupCreate = "USER_GROUP_CREATE"
 	PermissionUserGroupDelete = "USER_GROUP_DELETE"
+
+	PermissionSessionAll    = "SESSION_ALL"
+	PermissionSessionRead   = "SESSION_READ"
+	PermissionSessionDelete = "SESSION_DELETE"
+
+	PermissionServiceAccountAll    = "SERVICE_ACCOUNT_ALL"
+	PermissionServiceAccountCreate = "SERVICE_ACCOUNT_CREATE"
+	PermissionServiceAccountRead   = "SERVICE_ACCOUNT_READ"
+	PermissionServiceAccountDelete = "SERVICE_ACCOUNT_DELETE"
+
+	PermissionOAuthClientAll    = "OAUTH_CLIENT_ALL"
+	PermissionOAuthClientCreate = "OAUTH_CLIENT_CREATE"
+	PermissionOAuthClientDelete = "OAUTH_CLIENT_DELETE"
+
+	PermissionUserAll        = "USER_ALL"
+	PermissionUserUnlock     = "USER_UNLOCK"
+	PermissionUserSuspend    = "USER_SUSPEND"
+	PermissionUserDeactivate = "USER_DEACTIVATE"
+	PermissionUserReactivate = "USER_REACTIVATE"
+	PermissionUserDelete     = "USER_DELETE"
+	PermissionUserUpdate     = "USER_UPDATE"
+	PermissionUserRead       = "USER_READ"
 )
 
 var (
@@ -54,10 +78,29 @@ var (
 		PermissionUserGroupRead,
 		PermissionUserGroupCreate,
 		PermissionUserGroupDelete,
+		PermissionSessionAll,
+		PermissionSessionRead,
+		PermissionSessionDelete,
+		PermissionServiceAccountAll,
+		PermissionServiceAccountCreate,
+		PermissionServiceAccountRead,
+		PermissionServiceAccountDelete,
+		PermissionOAuthClientAll,
+		PermissionOAuthClientCreate,
+		PermissionOAuthClientDelete,
+		PermissionUserAll,
+		PermissionUserUnlock,
+		PermissionUserSuspend,
+		PermissionUserDeactivate,
+		PermissionUserReactivate,
+		PermissionUserDelete,
+		PermissionUserUpdate,
+		PermissionUserRead,
 	}
 	SeedGroups = []string{
 		GroupDefault,
 		GroupSuperUser,
+		GroupUnverified,
 	}
 	SeedGroupPermissios = map[string][]string{
 		GroupDefault: {
@@ -87,6 +130,24 @@ var (
 			PermissionUserGroupRead,
 			PermissionUserGroupCreate,
 			PermissionUserGroupDelete,
+			PermissionSessionAll,
+			PermissionSessionRead,
+			PermissionSessionDelete,
+			PermissionServiceAccountAll,
+			PermissionServiceAccountCreate,
+			PermissionServiceAccountRead,
+			PermissionServiceAccountDelete,
+			PermissionOAuthClientAll,
+			PermissionOAuthClientCreate,
+			PermissionOAuthClientDelete,
+			PermissionUserAll,
+			PermissionUserUnlock,
+			PermissionUserSuspend,
+			PermissionUserDeactivate,
+			PermissionUserReactivate,
+			PermissionUserDelete,
+			PermissionUserUpdate,
+			PermissionUserRead,
 		},
 	}
 )
@@ -0,0 +1,57 @@
+package infrastructure
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/sirupsen/logrus"
+)
+
+type breachedPasswordList struct {
+	passwords map[string]struct{}
+}
+
+// NewBreachedPasswordList load the breached password file from the config,
+// an empty list is returned when no file is configured.
+func NewBreachedPasswordList() (model.BreachedPasswordList, error) {
+	path := config.PasswordPolicyBreachedPasswordFile()
+	if path == "" {
+		return &breachedPasswordList{passwords: map[string]struct{}{}}, nil
+	}
+	return LoadBreachedPasswordList(path)
+}
+
+// LoadBreachedPasswordList read one password per line, blank lines and lines starting with # are skipped.
+// Passwords are compared case insensitively.
+func LoadBreachedPasswordList(path string) (model.BreachedPasswordList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	list := &breachedPasswordList{passwords: map[string]struct{}{}}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list.passwords[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("loaded %d breached passwords from %s", len(list.passwords), path)
+
+	return list, nil
+}
+
+func (l *breachedPasswordList) Contains(password string) bool {
+	_, ok := l.passwords[strings.ToLower(password)]
+	return ok
+}
@@ -0,0 +1,192 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/sirupsen/logrus"
+)
+
+// NewMailer create the mailer selected by the mailer driver config.
+func NewMailer() (model.Mailer, error) {
+	switch config.MailerDriver() {
+	case "smtp":
+		return NewSMTPMailer(config.MailerSMTPAddr(), config.MailerSMTPUsername(), config.MailerSMTPPassword(), config.MailerFrom(), config.MailerTimeout())
+	case "log":
+		return NewLogMailer(), nil
+	case "memory":
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("unknown mailer driver %q", config.MailerDriver())
+	}
+}
+
+type smtpMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     *mail.Address
+	timeout  time.Duration
+}
+
+// NewSMTPMailer create a mailer delivering through an SMTP relay, STARTTLS is used whenever the
+// relay offer it and is required before authenticating.
+func NewSMTPMailer(addr string, username string, password string, from string, timeout time.Duration) (model.Mailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	fromAddress, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	return &smtpMailer{
+		addr:     addr,
+		host:     host,
+		username: username,
+		password: password,
+		from:     fromAddress,
+		timeout:  timeout,
+	}, nil
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg *model.Mail) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	dialer := new(net.Dialer)
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{
+			ServerName: m.host,
+			MinVersion: tls.VersionTLS12,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if m.username != "" {
+		// smtp.PlainAuth refuse to send the credentials over an unencrypted connection
+		err = client.Auth(smtp.PlainAuth("", m.username, m.password, m.host))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(m.from.Address)
+	if err != nil {
+		return err
+	}
+	err = client.Rcpt(to.Address)
+	if err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(m.buildMessage(to, msg))
+	if err != nil {
+		_ = writer.Close()
+		return err
+	}
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (m *smtpMailer) buildMessage(to *mail.Address, msg *model.Mail) []byte {
+	headers := []string{
+		fmt.Sprintf("From: %s", m.from.String()),
+		fmt.Sprintf("To: %s", to.String()),
+		fmt.Sprintf("Subject: %s", mime.QEncoding.Encode("utf-8", msg.Subject)),
+		fmt.Sprintf("Date: %s", time.Now().Format(time.RFC1123Z)),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+	body := strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n")
+	return []byte(strings.Join(headers, "\r\n") + "\r\n\r\n" + body)
+}
+
+type logMailer struct{}
+
+// NewLogMailer create a mailer that only log the mails, for local development.
+func NewLogMailer() model.Mailer {
+	return new(logMailer)
+}
+
+func (m *logMailer) Send(ctx context.Context, msg *model.Mail) error {
+	logrus.WithFields(logrus.Fields{
+		"to":      msg.To,
+		"subject": msg.Subject,
+	}).Info(msg.Body)
+	return nil
+}
+
+// MemoryMailer keep every sent mail, for tests.
+type MemoryMailer struct {
+	mu    sync.Mutex
+	mails []*model.Mail
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return new(MemoryMailer)
+}
+
+func (m *MemoryMailer) Send(ctx context.Context, msg *model.Mail) error {
+	if msg == nil {
+		return errors.New("invalid mail")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sent := *msg
+	m.mails = append(m.mails, &sent)
+	return nil
+}
+
+// Mails return a copy of the mails sent so far.
+func (m *MemoryMailer) Mails() []*model.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	mails := make([]*model.Mail, len(m.mails))
+	copy(mails, m.mails)
+	return mails
+}
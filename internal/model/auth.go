@@ -4,6 +4,7 @@ package model
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	pb "github.com/krobus00/auth-service/pb/auth"
@@ -12,27 +13,117 @@ import (
 var (
 	ErrUsernameOrEmailAlreadyTaken = errors.New("username or email already taken")
 	ErrWrongUsernameOrPassword     = errors.New("wrong username/email or password")
+	ErrWrongPassword               = errors.New("wrong password")
 	ErrUnauthorizeAccess           = errors.New("unautohirze access")
+	ErrInvalidAuthorizationHeader  = errors.New("invalid authorization header")
 )
 
 type JWTClaims struct {
 	jwt.RegisteredClaims
-	UserID string `json:"userID"`
+	UserID    string `json:"userID"`
+	TokenType string `json:"tokenType"`
+	// ClientID is the OAuth client the token was issued to, empty for first party tokens.
+	ClientID string `json:"clientID,omitempty"`
+}
+
+// IsUntyped report whether the token was issued before tokens carried their type. Access and
+// refresh tokens of that time shared their claims, so it can't tell which one it is.
+func (m *JWTClaims) IsUntyped() bool {
+	return m.TokenType == "" && m.ClientID == ""
 }
 
 type HasAccessPayload struct {
 	UserID      string
 	Permissions []string
+	// Scopes restrict the permissions a scoped token can use, nil when the token is not scoped.
+	Scopes []string
 }
 
 func (m *HasAccessPayload) ParseFromProto(req *pb.HasAccessRequest) {
 	m.UserID = req.GetUserId()
 	m.Permissions = req.GetPermissions()
+	if len(req.GetScopes()) > 0 {
+		m.Scopes = req.GetScopes()
+	}
+}
+
+type ValidateTokenPayload struct {
+	AccessToken string
+}
+
+func (m *ValidateTokenPayload) ParseFromProto(req *pb.ValidateTokenRequest) {
+	m.AccessToken = req.GetAccessToken()
+}
+
+type ValidateTokenResponse struct {
+	UserID    string
+	TokenID   string
+	ExpiredAt time.Time
+	Issuer    string
+	Scopes    []string
+	// ClientID is the OAuth client the token was issued to, empty for first party tokens.
+	ClientID string
+}
+
+func (m *ValidateTokenResponse) ToGRPCResponse() *pb.ValidateTokenResponse {
+	return &pb.ValidateTokenResponse{
+		UserId:    m.UserID,
+		TokenId:   m.TokenID,
+		ExpiredAt: m.ExpiredAt.Format(time.RFC3339Nano),
+		Issuer:    m.Issuer,
+		Scopes:    m.Scopes,
+	}
+}
+
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+func (m *JSONWebKey) ToGRPCResponse() *pb.JSONWebKey {
+	return &pb.JSONWebKey{
+		Kty: m.KeyType,
+		Kid: m.KeyID,
+		Use: m.Use,
+		Alg: m.Algorithm,
+		N:   m.N,
+		E:   m.E,
+		Crv: m.Curve,
+		X:   m.X,
+		Y:   m.Y,
+	}
+}
+
+type JSONWebKeySet struct {
+	Keys []*JSONWebKey `json:"keys"`
+}
+
+func (m *JSONWebKeySet) ToGRPCResponse() *pb.GetJWKSResponse {
+	keys := make([]*pb.JSONWebKey, 0)
+	for _, key := range m.Keys {
+		keys = append(keys, key.ToGRPCResponse())
+	}
+	return &pb.GetJWKSResponse{
+		Keys: keys,
+	}
 }
 
 type AuthUsecase interface {
 	HasAccess(ctx context.Context, payload *HasAccessPayload) error
+	ValidateToken(ctx context.Context, payload *ValidateTokenPayload) (*ValidateTokenResponse, error)
+	GetJWKS(ctx context.Context) (*JSONWebKeySet, error)
 
 	// DI
 	InjectUserGroupRepo(repo UserGroupRepository) error
+	InjectUserRepo(repo UserRepository) error
+	InjectTokenRepo(repo TokenRepository) error
+	InjectPersonalAccessTokenRepo(repo PersonalAccessTokenRepository) error
+	InjectServiceAccountGroupRepo(repo ServiceAccountGroupRepository) error
 }
@@ -0,0 +1,55 @@
+//go:generate mockgen -destination=mock/mock_directory_repository.go -package=mock github.com/krobus00/auth-service/internal/model DirectoryRepository
+//go:generate mockgen -destination=mock/mock_directory_usecase.go -package=mock github.com/krobus00/auth-service/internal/model DirectoryUsecase
+//go:generate mockgen -destination=mock/mock_authenticator.go -package=mock github.com/krobus00/auth-service/internal/model Authenticator
+
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// IdentityProviderLDAP is the provider of the user identities linking directory entries to local users.
+const IdentityProviderLDAP = "ldap"
+
+var (
+	ErrDirectoryUnavailable     = errors.New("directory unavailable")
+	ErrDirectoryEntryIncomplete = errors.New("directory entry has no email")
+	ErrDirectoryManagedPassword = errors.New("password is managed by the directory")
+)
+
+// DirectoryEntry is the user entry found in the directory after a successful bind.
+type DirectoryEntry struct {
+	DN       string
+	Username string
+	Email    string
+	FullName string
+	// Groups hold the DN of every group the entry is member of.
+	Groups []string
+}
+
+// Authenticator is a credential backend tried by UserUsecase.Authenticate after the local password,
+// ErrWrongUsernameOrPassword let the next authenticator try the credentials.
+type Authenticator interface {
+	Authenticate(ctx context.Context, payload *UserLoginPayload) (*User, error)
+}
+
+type DirectoryRepository interface {
+	// Authenticate find the entry of the username and bind as it with the password.
+	Authenticate(ctx context.Context, username string, password string) (*DirectoryEntry, error)
+}
+
+type DirectoryUsecase interface {
+	Authenticator
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectUserUsecase(usecase UserUsecase) error
+	InjectUserRepo(repo UserRepository) error
+	InjectGroupRepo(repo GroupRepository) error
+	InjectUserGroupRepo(repo UserGroupRepository) error
+	InjectUserIdentityRepo(repo UserIdentityRepository) error
+	InjectDirectoryRepo(repo DirectoryRepository) error
+}
@@ -0,0 +1,92 @@
+//go:generate mockgen -destination=mock/mock_email_verification_repository.go -package=mock github.com/krobus00/auth-service/internal/model EmailVerificationRepository
+//go:generate mockgen -destination=mock/mock_email_verification_usecase.go -package=mock github.com/krobus00/auth-service/internal/model EmailVerificationUsecase
+//go:generate mockgen -destination=mock/mock_mailer.go -package=mock github.com/krobus00/auth-service/internal/model Mailer
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"gorm.io/gorm"
+)
+
+const VerifyEmailPath = "/verify-email"
+
+var (
+	ErrEmailRequired                 = errors.New("email is required")
+	ErrEmailNotVerified              = errors.New("email is not verified")
+	ErrEmailAlreadyVerified          = errors.New("email is already verified")
+	ErrEmailVerificationTokenInvalid = errors.New("invalid or expired email verification token")
+)
+
+// Mail is a plain text message sent by a Mailer.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, mail *Mail) error
+}
+
+// EmailVerification bind a verification token to the email address it was sent to,
+// so the token is useless once the user changed the address.
+type EmailVerification struct {
+	UserID string
+	Email  string
+}
+
+func NewEmailVerificationCacheKey(tokenID string) string {
+	return fmt.Sprintf("email-verifications:%s", tokenID)
+}
+
+// Usecase payload
+
+type SendVerificationEmailPayload struct {
+	Email string
+}
+
+func (m *SendVerificationEmailPayload) ParseFromProto(req *pb.SendVerificationEmailRequest) {
+	m.Email = strings.TrimSpace(req.GetEmail())
+}
+
+type VerifyEmailPayload struct {
+	Token string
+}
+
+func (m *VerifyEmailPayload) ParseFromProto(req *pb.VerifyEmailRequest) {
+	m.Token = strings.TrimSpace(req.GetToken())
+}
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, tokenID string, data *EmailVerification, expiration time.Duration) error
+	// Consume return and delete the verification, so a token can be used only once.
+	Consume(ctx context.Context, tokenID string) (*EmailVerification, error)
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type EmailVerificationUsecase interface {
+	// SendVerificationEmail send a new link to the current user, a guest get one sent to the
+	// given email without learning whether an account exist.
+	SendVerificationEmail(ctx context.Context, payload *SendVerificationEmailPayload) error
+	SendToUser(ctx context.Context, user *User) error
+	VerifyEmail(ctx context.Context, payload *VerifyEmailPayload) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectUserRepo(repo UserRepository) error
+	InjectGroupRepo(repo GroupRepository) error
+	InjectUserGroupRepo(repo UserGroupRepository) error
+	InjectEmailVerificationRepo(repo EmailVerificationRepository) error
+	InjectSecurityEventRepo(repo SecurityEventRepository) error
+	InjectMailer(mailer Mailer) error
+}
@@ -86,10 +86,64 @@ func (m *DeleteGroupByIDPayload) ParseFromProto(req *pb.DeleteGroupRequest) {
 	m.ID = req.GetId()
 }
 
+// GroupSummary is a group with the size of its membership and grants.
+type GroupSummary struct {
+	Group
+	MemberCount     int64
+	PermissionCount int64
+}
+
+func (m *GroupSummary) ToGRPCResponse() *pb.GroupSummary {
+	return &pb.GroupSummary{
+		Id:              m.ID,
+		Name:            m.Name,
+		MemberCount:     m.MemberCount,
+		PermissionCount: m.PermissionCount,
+	}
+}
+
+// GroupFilter select a page of groups sorted by name then id.
+type GroupFilter struct {
+	NamePrefix string
+	// After is the last group of the previous page, nil start from the first group.
+	After *PageCursor
+	Limit int
+}
+
+type ListGroupsPayload struct {
+	NamePrefix string
+	PageSize   int
+	PageToken  string
+}
+
+func (m *ListGroupsPayload) ParseFromProto(req *pb.ListGroupsRequest) {
+	m.NamePrefix = req.GetNamePrefix()
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+}
+
+type ListGroupsResponse struct {
+	Groups        []*GroupSummary
+	NextPageToken string
+}
+
+func (m *ListGroupsResponse) ToGRPCResponse() *pb.ListGroupsResponse {
+	groups := make([]*pb.GroupSummary, 0, len(m.Groups))
+	for _, group := range m.Groups {
+		groups = append(groups, group.ToGRPCResponse())
+	}
+	return &pb.ListGroupsResponse{
+		Groups:        groups,
+		NextPageToken: m.NextPageToken,
+	}
+}
+
 type GroupRepository interface {
 	Create(ctx context.Context, group *Group) error
 	FindByID(ctx context.Context, id string) (*Group, error)
 	FindByName(ctx context.Context, name string) (*Group, error)
+	// FindAll return up to filter.Limit groups after the cursor of the filter, with their counts.
+	FindAll(ctx context.Context, filter *GroupFilter) ([]*GroupSummary, error)
 	Update(ctx context.Context, group *Group) error
 	DeleteByID(ctx context.Context, id string) error
 
@@ -102,6 +156,7 @@ type GroupUsecase interface {
 	Create(ctx context.Context, payload *CreateGroupPayload) (*Group, error)
 	FindByID(ctx context.Context, payload *FindGroupByIDPayload) (*Group, error)
 	FindByName(ctx context.Context, payload *FindGroupByNamePayload) (*Group, error)
+	List(ctx context.Context, payload *ListGroupsPayload) (*ListGroupsResponse, error)
 	Update(ctx context.Context, payload *UpdateGroupPayload) (*Group, error)
 	DeleteByID(ctx context.Context, payload *DeleteGroupByIDPayload) error
 
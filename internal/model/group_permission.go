@@ -71,10 +71,89 @@ func (m *DeleteGroupPermissionPayload) ParseFromProto(req *pb.DeleteGroupPermiss
 	m.PermissionID = req.GetPermissionId()
 }
 
+// GroupPermissionFilter select a page of the permissions granted to a group sorted by name then id.
+type GroupPermissionFilter struct {
+	GroupID string
+	// After is the last permission of the previous page, nil start from the first permission.
+	After *PageCursor
+	Limit int
+}
+
+// PermissionHolderFilter select a page of the groups or users holding a permission, groups are
+// sorted by name and users by username, then by id.
+type PermissionHolderFilter struct {
+	// PermissionNames are the permission and the ones granting it, holding any of them is enough.
+	PermissionNames []string
+	// After is the last holder of the previous page, nil start from the first holder.
+	After *PageCursor
+	Limit int
+}
+
+type ListGroupPermissionsPayload struct {
+	GroupID   string
+	PageSize  int
+	PageToken string
+}
+
+func (m *ListGroupPermissionsPayload) ParseFromProto(req *pb.ListGroupPermissionsRequest) {
+	m.GroupID = req.GetGroupId()
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+}
+
+type ListGroupPermissionsResponse struct {
+	Permissions   []*Permission
+	NextPageToken string
+}
+
+func (m *ListGroupPermissionsResponse) ToGRPCResponse() *pb.ListGroupPermissionsResponse {
+	permissions := make([]*pb.Permission, 0, len(m.Permissions))
+	for _, permission := range m.Permissions {
+		permissions = append(permissions, permission.ToGRPCResponse())
+	}
+	return &pb.ListGroupPermissionsResponse{
+		Permissions:   permissions,
+		NextPageToken: m.NextPageToken,
+	}
+}
+
+type ListGroupsWithPermissionPayload struct {
+	PermissionID string
+	PageSize     int
+	PageToken    string
+}
+
+func (m *ListGroupsWithPermissionPayload) ParseFromProto(req *pb.ListGroupsWithPermissionRequest) {
+	m.PermissionID = req.GetPermissionId()
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+}
+
+type ListGroupsWithPermissionResponse struct {
+	Groups        []*Group
+	NextPageToken string
+}
+
+func (m *ListGroupsWithPermissionResponse) ToGRPCResponse() *pb.ListGroupsWithPermissionResponse {
+	groups := make([]*pb.Group, 0, len(m.Groups))
+	for _, group := range m.Groups {
+		groups = append(groups, group.ToGRPCResponse())
+	}
+	return &pb.ListGroupsWithPermissionResponse{
+		Groups:        groups,
+		NextPageToken: m.NextPageToken,
+	}
+}
+
 type GroupPermissionRepository interface {
 	Create(ctx context.Context, data *GroupPermission) error
 	FindByGroupIDAndPermissionID(ctx context.Context, groupID, permissionID string) (*GroupPermission, error)
 	DeleteByGroupIDAndPermissionID(ctx context.Context, groupID, permissionID string) error
+	// FindPermissions return up to filter.Limit permissions granted to the group after the cursor of the filter.
+	FindPermissions(ctx context.Context, filter *GroupPermissionFilter) ([]*Permission, error)
+	// FindGroupsWithPermission return up to filter.Limit groups granted any of filter.PermissionNames after the
+	// cursor of the filter.
+	FindGroupsWithPermission(ctx context.Context, filter *PermissionHolderFilter) ([]*Group, error)
 
 	// DI
 	InjectDB(db *gorm.DB) error
@@ -85,6 +164,8 @@ type GroupPermissionUsecase interface {
 	Create(ctx context.Context, payload *CreateGroupPermissionPayload) (*GroupPermission, error)
 	FindByGroupIDAndPermissionID(ctx context.Context, payload *FindGroupPermissionPayload) (*GroupPermission, error)
 	DeleteByGroupIDAndPermissionID(ctx context.Context, payload *DeleteGroupPermissionPayload) error
+	ListGroupPermissions(ctx context.Context, payload *ListGroupPermissionsPayload) (*ListGroupPermissionsResponse, error)
+	ListGroupsWithPermission(ctx context.Context, payload *ListGroupsWithPermissionPayload) (*ListGroupsWithPermissionResponse, error)
 
 	// DI
 	InjectAuthUsecase(usecase AuthUsecase) error
@@ -0,0 +1,100 @@
+//go:generate mockgen -destination=mock/mock_login_attempt_repository.go -package=mock github.com/krobus00/auth-service/internal/model LoginAttemptRepository
+//go:generate mockgen -destination=mock/mock_login_attempt_usecase.go -package=mock github.com/krobus00/auth-service/internal/model LoginAttemptUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+)
+
+var (
+	ErrAccountLocked        = errors.New("account is temporarily locked after too many failed login attempts")
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts, retry later")
+)
+
+// LoginBlock refuse the login attempts of a subject until it expire. Locked tell a lockout
+// apart from the short back-off delay between two failed attempts.
+type LoginBlock struct {
+	Locked bool
+	Until  time.Time
+}
+
+// NewLoginAttemptUserSubject is the subject counting the failures of an account, whichever
+// identifier the attempts used.
+func NewLoginAttemptUserSubject(userID string) string {
+	return fmt.Sprintf("user:%s", userID)
+}
+
+// NewLoginAttemptIdentifierSubject is the subject counting the failures of a login identifier
+// matching no account.
+func NewLoginAttemptIdentifierSubject(identifier string) string {
+	return fmt.Sprintf("identifier:%s", strings.ToLower(strings.TrimSpace(identifier)))
+}
+
+// NewLoginAttemptIPSubject is the subject counting the failures of a client address.
+func NewLoginAttemptIPSubject(ipAddress string) string {
+	return fmt.Sprintf("ip:%s", ipAddress)
+}
+
+func NewLoginFailuresCacheKey(subject string) string {
+	return fmt.Sprintf("login-failures:%s", subject)
+}
+
+func NewLoginBlockCacheKey(subject string) string {
+	return fmt.Sprintf("login-blocks:%s", subject)
+}
+
+// Usecase payload
+
+type UnlockUserPayload struct {
+	UserID string
+}
+
+func (m *UnlockUserPayload) ParseFromProto(req *pb.UnlockUserRequest) {
+	m.UserID = req.GetUserId()
+}
+
+type LoginAttemptRepository interface {
+	// IncrementFailures count one more failed attempt of subject, the count is forgotten once
+	// window passed without a failure.
+	IncrementFailures(ctx context.Context, subject string, window time.Duration) (int64, error)
+	Block(ctx context.Context, subject string, block *LoginBlock) error
+	// FindBlock return the active block of subject, nil when it can try to log in.
+	FindBlock(ctx context.Context, subject string) (*LoginBlock, error)
+	// Reset forget the failures and the block of subject.
+	Reset(ctx context.Context, subject string) error
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type LoginAttemptUsecase interface {
+	// Check refuse the attempt while the account of the login identifier or the client address is blocked.
+	Check(ctx context.Context, identifier string) error
+	// RecordFailure count a failed attempt against the account of the identifier, delaying the next one
+	// and locking the account or the client address once they failed too often. An identifier matching
+	// no account is counted on its own.
+	RecordFailure(ctx context.Context, identifier string) error
+	// RecordSuccess forget the failures of the account of the identifier, the client address keep its count.
+	RecordSuccess(ctx context.Context, identifier string) error
+	// CheckUser is Check for an account already known, before its second factor is verified.
+	CheckUser(ctx context.Context, userID string) error
+	// RecordUserFailure count a wrong second factor against the account like a wrong password.
+	RecordUserFailure(ctx context.Context, userID string) error
+	// RecordUserSuccess forget the failures of the account once its second factor is verified.
+	RecordUserSuccess(ctx context.Context, userID string) error
+	UnlockUser(ctx context.Context, payload *UnlockUserPayload) error
+
+	// DI
+	InjectAuthUsecase(usecase AuthUsecase) error
+	InjectUserRepo(repo UserRepository) error
+	InjectLoginAttemptRepo(repo LoginAttemptRepository) error
+	InjectSecurityEventRepo(repo SecurityEventRepository) error
+}
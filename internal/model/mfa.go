@@ -0,0 +1,163 @@
+//go:generate mockgen -destination=mock/mock_user_mfa_repository.go -package=mock github.com/krobus00/auth-service/internal/model UserMFARepository
+//go:generate mockgen -destination=mock/mock_mfa_challenge_repository.go -package=mock github.com/krobus00/auth-service/internal/model MFAChallengeRepository
+//go:generate mockgen -destination=mock/mock_mfa_usecase.go -package=mock github.com/krobus00/auth-service/internal/model MFAUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+const (
+	MFATokenPrefix = "mfa_"
+
+	// MFARecoveryCodeCount is the number of one-time recovery codes handed out when mfa is enabled.
+	MFARecoveryCodeCount = 10
+)
+
+var (
+	ErrMFANotEnrolled      = errors.New("mfa is not enrolled")
+	ErrMFANotEnabled       = errors.New("mfa is not enabled")
+	ErrMFAAlreadyEnabled   = errors.New("mfa is already enabled")
+	ErrMFACodeRequired     = errors.New("mfa code is required")
+	ErrMFACodeInvalid      = errors.New("invalid mfa code")
+	ErrMFAChallengeInvalid = errors.New("invalid or expired mfa challenge")
+)
+
+// UserMFA hold the TOTP secret of a user. The enrollment is pending until the first code is confirmed.
+type UserMFA struct {
+	UserID string `gorm:"primaryKey"`
+	Secret string
+	// RecoveryCodes are the hashes of the unused recovery codes.
+	RecoveryCodes pq.StringArray `gorm:"type:text[]"`
+	// LastUsedStep is the TOTP time step of the last accepted code, older steps are rejected.
+	LastUsedStep int64
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+func (m *UserMFA) IsEnabled() bool {
+	return m != nil && m.EnabledAt != nil
+}
+
+// MFAChallenge is kept between the password step of the login and the mfa code step.
+type MFAChallenge struct {
+	UserID   string
+	Attempts int
+}
+
+func NewMFAChallengeCacheKey(tokenHash string) string {
+	return fmt.Sprintf("mfa-challenges:%s", tokenHash)
+}
+
+// Usecase payload
+
+type ConfirmMFAPayload struct {
+	Code string
+}
+
+func (m *ConfirmMFAPayload) ParseFromProto(req *pb.ConfirmMFARequest) {
+	m.Code = strings.TrimSpace(req.GetCode())
+}
+
+type DisableMFAPayload struct {
+	Code string
+}
+
+func (m *DisableMFAPayload) ParseFromProto(req *pb.DisableMFARequest) {
+	m.Code = strings.TrimSpace(req.GetCode())
+}
+
+type VerifyMFAPayload struct {
+	MFAToken string
+	Code     string
+}
+
+func (m *VerifyMFAPayload) ParseFromProto(req *pb.VerifyMFARequest) {
+	m.MFAToken = req.GetMfaToken()
+	m.Code = strings.TrimSpace(req.GetCode())
+}
+
+type EnrollMFAResponse struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+func (m *EnrollMFAResponse) ToGRPCResponse() *pb.EnrollMFAResponse {
+	return &pb.EnrollMFAResponse{
+		Secret:     m.Secret,
+		OtpauthUri: m.OTPAuthURI,
+	}
+}
+
+// ConfirmMFAResponse carry the plain recovery codes, they are only shown once.
+type ConfirmMFAResponse struct {
+	RecoveryCodes []string
+}
+
+func (m *ConfirmMFAResponse) ToGRPCResponse() *pb.ConfirmMFAResponse {
+	return &pb.ConfirmMFAResponse{
+		RecoveryCodes: m.RecoveryCodes,
+	}
+}
+
+type UserMFARepository interface {
+	Create(ctx context.Context, mfa *UserMFA) error
+	FindByUserID(ctx context.Context, userID string) (*UserMFA, error)
+	// Enable mark a pending enrollment as enabled, it report false when the enrollment is not pending anymore.
+	Enable(ctx context.Context, userID string, recoveryCodes []string, step int64) (bool, error)
+	// UseStep record the time step of an accepted code, it report false when the step was already used.
+	UseStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseRecoveryCode remove the recovery code hash, it report false when the code was already used.
+	UseRecoveryCode(ctx context.Context, userID string, recoveryCode string) (bool, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+}
+
+type MFAChallengeRepository interface {
+	Create(ctx context.Context, token string, data *MFAChallenge, expiration time.Duration) error
+	Find(ctx context.Context, token string) (*MFAChallenge, error)
+	// IncrementAttempts count one more attempt on the challenge and return the attempts counted so far,
+	// it return 0 when the challenge doesn't exist anymore.
+	IncrementAttempts(ctx context.Context, token string) (int64, error)
+	// Delete report whether the challenge still existed, so it can be completed only once.
+	Delete(ctx context.Context, token string) (bool, error)
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type MFAUsecase interface {
+	Enroll(ctx context.Context) (*EnrollMFAResponse, error)
+	Confirm(ctx context.Context, payload *ConfirmMFAPayload) (*ConfirmMFAResponse, error)
+	Disable(ctx context.Context, payload *DisableMFAPayload) error
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+	// Verify check a TOTP or a recovery code of a user with mfa enabled.
+	Verify(ctx context.Context, userID string, code string) error
+	CreateChallenge(ctx context.Context, userID string) (string, error)
+	VerifyChallenge(ctx context.Context, payload *VerifyMFAPayload) (*AuthResponse, error)
+
+	// DI
+	InjectUserUsecase(usecase UserUsecase) error
+	InjectUserRepo(repo UserRepository) error
+	InjectUserMFARepo(repo UserMFARepository) error
+	InjectMFAChallengeRepo(repo MFAChallengeRepository) error
+	InjectSecurityEventRepo(repo SecurityEventRepository) error
+	InjectLoginAttemptUsecase(usecase LoginAttemptUsecase) error
+}
@@ -35,6 +35,21 @@ func (m *MockAuthUsecase) EXPECT() *MockAuthUsecaseMockRecorder {
 	return m.recorder
 }
 
+// GetJWKS mocks base method.
+func (m *MockAuthUsecase) GetJWKS(arg0 context.Context) (*model.JSONWebKeySet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJWKS", arg0)
+	ret0, _ := ret[0].(*model.JSONWebKeySet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJWKS indicates an expected call of GetJWKS.
+func (mr *MockAuthUsecaseMockRecorder) GetJWKS(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockAuthUsecase)(nil).GetJWKS), arg0)
+}
+
 // HasAccess mocks base method.
 func (m *MockAuthUsecase) HasAccess(arg0 context.Context, arg1 *model.HasAccessPayload) error {
 	m.ctrl.T.Helper()
@@ -49,6 +64,48 @@ func (mr *MockAuthUsecaseMockRecorder) HasAccess(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAccess", reflect.TypeOf((*MockAuthUsecase)(nil).HasAccess), arg0, arg1)
 }
 
+// InjectPersonalAccessTokenRepo mocks base method.
+func (m *MockAuthUsecase) InjectPersonalAccessTokenRepo(arg0 model.PersonalAccessTokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPersonalAccessTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPersonalAccessTokenRepo indicates an expected call of InjectPersonalAccessTokenRepo.
+func (mr *MockAuthUsecaseMockRecorder) InjectPersonalAccessTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPersonalAccessTokenRepo", reflect.TypeOf((*MockAuthUsecase)(nil).InjectPersonalAccessTokenRepo), arg0)
+}
+
+// InjectServiceAccountGroupRepo mocks base method.
+func (m *MockAuthUsecase) InjectServiceAccountGroupRepo(arg0 model.ServiceAccountGroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectServiceAccountGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectServiceAccountGroupRepo indicates an expected call of InjectServiceAccountGroupRepo.
+func (mr *MockAuthUsecaseMockRecorder) InjectServiceAccountGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectServiceAccountGroupRepo", reflect.TypeOf((*MockAuthUsecase)(nil).InjectServiceAccountGroupRepo), arg0)
+}
+
+// InjectTokenRepo mocks base method.
+func (m *MockAuthUsecase) InjectTokenRepo(arg0 model.TokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectTokenRepo indicates an expected call of InjectTokenRepo.
+func (mr *MockAuthUsecaseMockRecorder) InjectTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectTokenRepo", reflect.TypeOf((*MockAuthUsecase)(nil).InjectTokenRepo), arg0)
+}
+
 // InjectUserGroupRepo mocks base method.
 func (m *MockAuthUsecase) InjectUserGroupRepo(arg0 model.UserGroupRepository) error {
 	m.ctrl.T.Helper()
@@ -62,3 +119,32 @@ func (mr *MockAuthUsecaseMockRecorder) InjectUserGroupRepo(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserGroupRepo", reflect.TypeOf((*MockAuthUsecase)(nil).InjectUserGroupRepo), arg0)
 }
+
+// InjectUserRepo mocks base method.
+func (m *MockAuthUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockAuthUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockAuthUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// ValidateToken mocks base method.
+func (m *MockAuthUsecase) ValidateToken(arg0 context.Context, arg1 *model.ValidateTokenPayload) (*model.ValidateTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", arg0, arg1)
+	ret0, _ := ret[0].(*model.ValidateTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockAuthUsecaseMockRecorder) ValidateToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockAuthUsecase)(nil).ValidateToken), arg0, arg1)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: AuthorizationCodeRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	redis "github.com/go-redis/redis/v8"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockAuthorizationCodeRepository is a mock of AuthorizationCodeRepository interface.
+type MockAuthorizationCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationCodeRepositoryMockRecorder
+}
+
+// MockAuthorizationCodeRepositoryMockRecorder is the mock recorder for MockAuthorizationCodeRepository.
+type MockAuthorizationCodeRepositoryMockRecorder struct {
+	mock *MockAuthorizationCodeRepository
+}
+
+// NewMockAuthorizationCodeRepository creates a new mock instance.
+func NewMockAuthorizationCodeRepository(ctrl *gomock.Controller) *MockAuthorizationCodeRepository {
+	mock := &MockAuthorizationCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationCodeRepository) EXPECT() *MockAuthorizationCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockAuthorizationCodeRepository) Consume(arg0 context.Context, arg1 string) (*model.AuthorizationCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", arg0, arg1)
+	ret0, _ := ret[0].(*model.AuthorizationCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockAuthorizationCodeRepositoryMockRecorder) Consume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockAuthorizationCodeRepository)(nil).Consume), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockAuthorizationCodeRepository) Create(arg0 context.Context, arg1 string, arg2 *model.AuthorizationCode, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuthorizationCodeRepositoryMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthorizationCodeRepository)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// InjectRedisClient mocks base method.
+func (m *MockAuthorizationCodeRepository) InjectRedisClient(arg0 *redis.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectRedisClient", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectRedisClient indicates an expected call of InjectRedisClient.
+func (mr *MockAuthorizationCodeRepositoryMockRecorder) InjectRedisClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectRedisClient", reflect.TypeOf((*MockAuthorizationCodeRepository)(nil).InjectRedisClient), arg0)
+}
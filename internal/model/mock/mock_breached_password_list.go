@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: BreachedPasswordList)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBreachedPasswordList is a mock of BreachedPasswordList interface.
+type MockBreachedPasswordList struct {
+	ctrl     *gomock.Controller
+	recorder *MockBreachedPasswordListMockRecorder
+}
+
+// MockBreachedPasswordListMockRecorder is the mock recorder for MockBreachedPasswordList.
+type MockBreachedPasswordListMockRecorder struct {
+	mock *MockBreachedPasswordList
+}
+
+// NewMockBreachedPasswordList creates a new mock instance.
+func NewMockBreachedPasswordList(ctrl *gomock.Controller) *MockBreachedPasswordList {
+	mock := &MockBreachedPasswordList{ctrl: ctrl}
+	mock.recorder = &MockBreachedPasswordListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBreachedPasswordList) EXPECT() *MockBreachedPasswordListMockRecorder {
+	return m.recorder
+}
+
+// Contains mocks base method.
+func (m *MockBreachedPasswordList) Contains(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Contains indicates an expected call of Contains.
+func (mr *MockBreachedPasswordListMockRecorder) Contains(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockBreachedPasswordList)(nil).Contains), arg0)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: DirectoryRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockDirectoryRepository is a mock of DirectoryRepository interface.
+type MockDirectoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDirectoryRepositoryMockRecorder
+}
+
+// MockDirectoryRepositoryMockRecorder is the mock recorder for MockDirectoryRepository.
+type MockDirectoryRepositoryMockRecorder struct {
+	mock *MockDirectoryRepository
+}
+
+// NewMockDirectoryRepository creates a new mock instance.
+func NewMockDirectoryRepository(ctrl *gomock.Controller) *MockDirectoryRepository {
+	mock := &MockDirectoryRepository{ctrl: ctrl}
+	mock.recorder = &MockDirectoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDirectoryRepository) EXPECT() *MockDirectoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockDirectoryRepository) Authenticate(arg0 context.Context, arg1, arg2 string) (*model.DirectoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.DirectoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockDirectoryRepositoryMockRecorder) Authenticate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockDirectoryRepository)(nil).Authenticate), arg0, arg1, arg2)
+}
@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: DirectoryUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+	gorm "gorm.io/gorm"
+)
+
+// MockDirectoryUsecase is a mock of DirectoryUsecase interface.
+type MockDirectoryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDirectoryUsecaseMockRecorder
+}
+
+// MockDirectoryUsecaseMockRecorder is the mock recorder for MockDirectoryUsecase.
+type MockDirectoryUsecaseMockRecorder struct {
+	mock *MockDirectoryUsecase
+}
+
+// NewMockDirectoryUsecase creates a new mock instance.
+func NewMockDirectoryUsecase(ctrl *gomock.Controller) *MockDirectoryUsecase {
+	mock := &MockDirectoryUsecase{ctrl: ctrl}
+	mock.recorder = &MockDirectoryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDirectoryUsecase) EXPECT() *MockDirectoryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockDirectoryUsecase) Authenticate(arg0 context.Context, arg1 *model.UserLoginPayload) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockDirectoryUsecaseMockRecorder) Authenticate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockDirectoryUsecase)(nil).Authenticate), arg0, arg1)
+}
+
+// InjectDB mocks base method.
+func (m *MockDirectoryUsecase) InjectDB(arg0 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectDB", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectDB indicates an expected call of InjectDB.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectDB", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectDB), arg0)
+}
+
+// InjectDirectoryRepo mocks base method.
+func (m *MockDirectoryUsecase) InjectDirectoryRepo(arg0 model.DirectoryRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectDirectoryRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectDirectoryRepo indicates an expected call of InjectDirectoryRepo.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectDirectoryRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectDirectoryRepo", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectDirectoryRepo), arg0)
+}
+
+// InjectGroupRepo mocks base method.
+func (m *MockDirectoryUsecase) InjectGroupRepo(arg0 model.GroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectGroupRepo indicates an expected call of InjectGroupRepo.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupRepo", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectGroupRepo), arg0)
+}
+
+// InjectUserGroupRepo mocks base method.
+func (m *MockDirectoryUsecase) InjectUserGroupRepo(arg0 model.UserGroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserGroupRepo indicates an expected call of InjectUserGroupRepo.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectUserGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserGroupRepo", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectUserGroupRepo), arg0)
+}
+
+// InjectUserIdentityRepo mocks base method.
+func (m *MockDirectoryUsecase) InjectUserIdentityRepo(arg0 model.UserIdentityRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserIdentityRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserIdentityRepo indicates an expected call of InjectUserIdentityRepo.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectUserIdentityRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserIdentityRepo", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectUserIdentityRepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockDirectoryUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// InjectUserUsecase mocks base method.
+func (m *MockDirectoryUsecase) InjectUserUsecase(arg0 model.UserUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserUsecase indicates an expected call of InjectUserUsecase.
+func (mr *MockDirectoryUsecaseMockRecorder) InjectUserUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserUsecase", reflect.TypeOf((*MockDirectoryUsecase)(nil).InjectUserUsecase), arg0)
+}
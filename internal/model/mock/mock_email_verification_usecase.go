@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: EmailVerificationUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+	gorm "gorm.io/gorm"
+)
+
+// MockEmailVerificationUsecase is a mock of EmailVerificationUsecase interface.
+type MockEmailVerificationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationUsecaseMockRecorder
+}
+
+// MockEmailVerificationUsecaseMockRecorder is the mock recorder for MockEmailVerificationUsecase.
+type MockEmailVerificationUsecaseMockRecorder struct {
+	mock *MockEmailVerificationUsecase
+}
+
+// NewMockEmailVerificationUsecase creates a new mock instance.
+func NewMockEmailVerificationUsecase(ctrl *gomock.Controller) *MockEmailVerificationUsecase {
+	mock := &MockEmailVerificationUsecase{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationUsecase) EXPECT() *MockEmailVerificationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// InjectDB mocks base method.
+func (m *MockEmailVerificationUsecase) InjectDB(arg0 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectDB", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectDB indicates an expected call of InjectDB.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectDB", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectDB), arg0)
+}
+
+// InjectEmailVerificationRepo mocks base method.
+func (m *MockEmailVerificationUsecase) InjectEmailVerificationRepo(arg0 model.EmailVerificationRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectEmailVerificationRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectEmailVerificationRepo indicates an expected call of InjectEmailVerificationRepo.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectEmailVerificationRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectEmailVerificationRepo", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectEmailVerificationRepo), arg0)
+}
+
+// InjectGroupRepo mocks base method.
+func (m *MockEmailVerificationUsecase) InjectGroupRepo(arg0 model.GroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectGroupRepo indicates an expected call of InjectGroupRepo.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupRepo", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectGroupRepo), arg0)
+}
+
+// InjectMailer mocks base method.
+func (m *MockEmailVerificationUsecase) InjectMailer(arg0 model.Mailer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectMailer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectMailer indicates an expected call of InjectMailer.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectMailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectMailer", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectMailer), arg0)
+}
+
+// InjectSecurityEventRepo mocks base method.
+func (m *MockEmailVerificationUsecase) InjectSecurityEventRepo(arg0 model.SecurityEventRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectSecurityEventRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectSecurityEventRepo indicates an expected call of InjectSecurityEventRepo.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectSecurityEventRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSecurityEventRepo", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectSecurityEventRepo), arg0)
+}
+
+// InjectUserGroupRepo mocks base method.
+func (m *MockEmailVerificationUsecase) InjectUserGroupRepo(arg0 model.UserGroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserGroupRepo indicates an expected call of InjectUserGroupRepo.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectUserGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserGroupRepo", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectUserGroupRepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockEmailVerificationUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockEmailVerificationUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// SendToUser mocks base method.
+func (m *MockEmailVerificationUsecase) SendToUser(arg0 context.Context, arg1 *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendToUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendToUser indicates an expected call of SendToUser.
+func (mr *MockEmailVerificationUsecaseMockRecorder) SendToUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendToUser", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).SendToUser), arg0, arg1)
+}
+
+// SendVerificationEmail mocks base method.
+func (m *MockEmailVerificationUsecase) SendVerificationEmail(arg0 context.Context, arg1 *model.SendVerificationEmailPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerificationEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerificationEmail indicates an expected call of SendVerificationEmail.
+func (mr *MockEmailVerificationUsecaseMockRecorder) SendVerificationEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationEmail", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).SendVerificationEmail), arg0, arg1)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockEmailVerificationUsecase) VerifyEmail(arg0 context.Context, arg1 *model.VerifyEmailPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockEmailVerificationUsecaseMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).VerifyEmail), arg0, arg1)
+}
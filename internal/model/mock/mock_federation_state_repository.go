@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: FederationStateRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	redis "github.com/go-redis/redis/v8"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockFederationStateRepository is a mock of FederationStateRepository interface.
+type MockFederationStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFederationStateRepositoryMockRecorder
+}
+
+// MockFederationStateRepositoryMockRecorder is the mock recorder for MockFederationStateRepository.
+type MockFederationStateRepositoryMockRecorder struct {
+	mock *MockFederationStateRepository
+}
+
+// NewMockFederationStateRepository creates a new mock instance.
+func NewMockFederationStateRepository(ctrl *gomock.Controller) *MockFederationStateRepository {
+	mock := &MockFederationStateRepository{ctrl: ctrl}
+	mock.recorder = &MockFederationStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFederationStateRepository) EXPECT() *MockFederationStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockFederationStateRepository) Consume(arg0 context.Context, arg1 string) (*model.FederationState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", arg0, arg1)
+	ret0, _ := ret[0].(*model.FederationState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockFederationStateRepositoryMockRecorder) Consume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockFederationStateRepository)(nil).Consume), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockFederationStateRepository) Create(arg0 context.Context, arg1 string, arg2 *model.FederationState, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFederationStateRepositoryMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFederationStateRepository)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// InjectRedisClient mocks base method.
+func (m *MockFederationStateRepository) InjectRedisClient(arg0 *redis.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectRedisClient", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectRedisClient indicates an expected call of InjectRedisClient.
+func (mr *MockFederationStateRepositoryMockRecorder) InjectRedisClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectRedisClient", reflect.TypeOf((*MockFederationStateRepository)(nil).InjectRedisClient), arg0)
+}
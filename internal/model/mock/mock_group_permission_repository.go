@@ -80,6 +80,36 @@ func (mr *MockGroupPermissionRepositoryMockRecorder) FindByGroupIDAndPermissionI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByGroupIDAndPermissionID", reflect.TypeOf((*MockGroupPermissionRepository)(nil).FindByGroupIDAndPermissionID), arg0, arg1, arg2)
 }
 
+// FindGroupsWithPermission mocks base method.
+func (m *MockGroupPermissionRepository) FindGroupsWithPermission(arg0 context.Context, arg1 *model.PermissionHolderFilter) ([]*model.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGroupsWithPermission", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGroupsWithPermission indicates an expected call of FindGroupsWithPermission.
+func (mr *MockGroupPermissionRepositoryMockRecorder) FindGroupsWithPermission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGroupsWithPermission", reflect.TypeOf((*MockGroupPermissionRepository)(nil).FindGroupsWithPermission), arg0, arg1)
+}
+
+// FindPermissions mocks base method.
+func (m *MockGroupPermissionRepository) FindPermissions(arg0 context.Context, arg1 *model.GroupPermissionFilter) ([]*model.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPermissions", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPermissions indicates an expected call of FindPermissions.
+func (mr *MockGroupPermissionRepositoryMockRecorder) FindPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPermissions", reflect.TypeOf((*MockGroupPermissionRepository)(nil).FindPermissions), arg0, arg1)
+}
+
 // InjectDB mocks base method.
 func (m *MockGroupPermissionRepository) InjectDB(arg0 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -134,3 +134,33 @@ func (mr *MockGroupPermissionUsecaseMockRecorder) InjectPermisisonRepo(arg0 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPermisisonRepo", reflect.TypeOf((*MockGroupPermissionUsecase)(nil).InjectPermisisonRepo), arg0)
 }
+
+// ListGroupPermissions mocks base method.
+func (m *MockGroupPermissionUsecase) ListGroupPermissions(arg0 context.Context, arg1 *model.ListGroupPermissionsPayload) (*model.ListGroupPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupPermissions", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListGroupPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupPermissions indicates an expected call of ListGroupPermissions.
+func (mr *MockGroupPermissionUsecaseMockRecorder) ListGroupPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupPermissions", reflect.TypeOf((*MockGroupPermissionUsecase)(nil).ListGroupPermissions), arg0, arg1)
+}
+
+// ListGroupsWithPermission mocks base method.
+func (m *MockGroupPermissionUsecase) ListGroupsWithPermission(arg0 context.Context, arg1 *model.ListGroupsWithPermissionPayload) (*model.ListGroupsWithPermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupsWithPermission", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListGroupsWithPermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupsWithPermission indicates an expected call of ListGroupsWithPermission.
+func (mr *MockGroupPermissionUsecaseMockRecorder) ListGroupsWithPermission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupsWithPermission", reflect.TypeOf((*MockGroupPermissionUsecase)(nil).ListGroupsWithPermission), arg0, arg1)
+}
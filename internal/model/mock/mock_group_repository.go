@@ -65,6 +65,21 @@ func (mr *MockGroupRepositoryMockRecorder) DeleteByID(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockGroupRepository)(nil).DeleteByID), arg0, arg1)
 }
 
+// FindAll mocks base method.
+func (m *MockGroupRepository) FindAll(arg0 context.Context, arg1 *model.GroupFilter) ([]*model.GroupSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret0, _ := ret[0].([]*model.GroupSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockGroupRepositoryMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockGroupRepository)(nil).FindAll), arg0, arg1)
+}
+
 // FindByID mocks base method.
 func (m *MockGroupRepository) FindByID(arg0 context.Context, arg1 string) (*model.Group, error) {
 	m.ctrl.T.Helper()
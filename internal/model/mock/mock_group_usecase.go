@@ -122,6 +122,21 @@ func (mr *MockGroupUsecaseMockRecorder) InjectGroupRepo(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupRepo", reflect.TypeOf((*MockGroupUsecase)(nil).InjectGroupRepo), arg0)
 }
 
+// List mocks base method.
+func (m *MockGroupUsecase) List(arg0 context.Context, arg1 *model.ListGroupsPayload) (*model.ListGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGroupUsecaseMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGroupUsecase)(nil).List), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockGroupUsecase) Update(arg0 context.Context, arg1 *model.UpdateGroupPayload) (*model.Group, error) {
 	m.ctrl.T.Helper()
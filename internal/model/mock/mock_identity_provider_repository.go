@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: IdentityProviderRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockIdentityProviderRepository is a mock of IdentityProviderRepository interface.
+type MockIdentityProviderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityProviderRepositoryMockRecorder
+}
+
+// MockIdentityProviderRepositoryMockRecorder is the mock recorder for MockIdentityProviderRepository.
+type MockIdentityProviderRepositoryMockRecorder struct {
+	mock *MockIdentityProviderRepository
+}
+
+// NewMockIdentityProviderRepository creates a new mock instance.
+func NewMockIdentityProviderRepository(ctrl *gomock.Controller) *MockIdentityProviderRepository {
+	mock := &MockIdentityProviderRepository{ctrl: ctrl}
+	mock.recorder = &MockIdentityProviderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityProviderRepository) EXPECT() *MockIdentityProviderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AuthorizationURL mocks base method.
+func (m *MockIdentityProviderRepository) AuthorizationURL(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizationURL", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizationURL indicates an expected call of AuthorizationURL.
+func (mr *MockIdentityProviderRepositoryMockRecorder) AuthorizationURL(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizationURL", reflect.TypeOf((*MockIdentityProviderRepository)(nil).AuthorizationURL), arg0, arg1, arg2, arg3)
+}
+
+// Exchange mocks base method.
+func (m *MockIdentityProviderRepository) Exchange(arg0 context.Context, arg1, arg2, arg3 string) (*model.ExternalIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.ExternalIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockIdentityProviderRepositoryMockRecorder) Exchange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockIdentityProviderRepository)(nil).Exchange), arg0, arg1, arg2, arg3)
+}
+
+// InjectHTTPClient mocks base method.
+func (m *MockIdentityProviderRepository) InjectHTTPClient(arg0 *http.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectHTTPClient", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectHTTPClient indicates an expected call of InjectHTTPClient.
+func (mr *MockIdentityProviderRepositoryMockRecorder) InjectHTTPClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectHTTPClient", reflect.TypeOf((*MockIdentityProviderRepository)(nil).InjectHTTPClient), arg0)
+}
+
+// Providers mocks base method.
+func (m *MockIdentityProviderRepository) Providers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Providers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Providers indicates an expected call of Providers.
+func (mr *MockIdentityProviderRepositoryMockRecorder) Providers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Providers", reflect.TypeOf((*MockIdentityProviderRepository)(nil).Providers))
+}
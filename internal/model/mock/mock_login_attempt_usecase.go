@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: LoginAttemptUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockLoginAttemptUsecase is a mock of LoginAttemptUsecase interface.
+type MockLoginAttemptUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptUsecaseMockRecorder
+}
+
+// MockLoginAttemptUsecaseMockRecorder is the mock recorder for MockLoginAttemptUsecase.
+type MockLoginAttemptUsecaseMockRecorder struct {
+	mock *MockLoginAttemptUsecase
+}
+
+// NewMockLoginAttemptUsecase creates a new mock instance.
+func NewMockLoginAttemptUsecase(ctrl *gomock.Controller) *MockLoginAttemptUsecase {
+	mock := &MockLoginAttemptUsecase{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptUsecase) EXPECT() *MockLoginAttemptUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockLoginAttemptUsecase) Check(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockLoginAttemptUsecaseMockRecorder) Check(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).Check), arg0, arg1)
+}
+
+// CheckUser mocks base method.
+func (m *MockLoginAttemptUsecase) CheckUser(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUser indicates an expected call of CheckUser.
+func (mr *MockLoginAttemptUsecaseMockRecorder) CheckUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUser", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).CheckUser), arg0, arg1)
+}
+
+// InjectAuthUsecase mocks base method.
+func (m *MockLoginAttemptUsecase) InjectAuthUsecase(arg0 model.AuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthUsecase indicates an expected call of InjectAuthUsecase.
+func (mr *MockLoginAttemptUsecaseMockRecorder) InjectAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthUsecase", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).InjectAuthUsecase), arg0)
+}
+
+// InjectLoginAttemptRepo mocks base method.
+func (m *MockLoginAttemptUsecase) InjectLoginAttemptRepo(arg0 model.LoginAttemptRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectLoginAttemptRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectLoginAttemptRepo indicates an expected call of InjectLoginAttemptRepo.
+func (mr *MockLoginAttemptUsecaseMockRecorder) InjectLoginAttemptRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectLoginAttemptRepo", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).InjectLoginAttemptRepo), arg0)
+}
+
+// InjectSecurityEventRepo mocks base method.
+func (m *MockLoginAttemptUsecase) InjectSecurityEventRepo(arg0 model.SecurityEventRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectSecurityEventRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectSecurityEventRepo indicates an expected call of InjectSecurityEventRepo.
+func (mr *MockLoginAttemptUsecaseMockRecorder) InjectSecurityEventRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSecurityEventRepo", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).InjectSecurityEventRepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockLoginAttemptUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockLoginAttemptUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// RecordFailure mocks base method.
+func (m *MockLoginAttemptUsecase) RecordFailure(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockLoginAttemptUsecaseMockRecorder) RecordFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).RecordFailure), arg0, arg1)
+}
+
+// RecordSuccess mocks base method.
+func (m *MockLoginAttemptUsecase) RecordSuccess(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSuccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSuccess indicates an expected call of RecordSuccess.
+func (mr *MockLoginAttemptUsecaseMockRecorder) RecordSuccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).RecordSuccess), arg0, arg1)
+}
+
+// RecordUserFailure mocks base method.
+func (m *MockLoginAttemptUsecase) RecordUserFailure(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUserFailure", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUserFailure indicates an expected call of RecordUserFailure.
+func (mr *MockLoginAttemptUsecaseMockRecorder) RecordUserFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUserFailure", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).RecordUserFailure), arg0, arg1)
+}
+
+// RecordUserSuccess mocks base method.
+func (m *MockLoginAttemptUsecase) RecordUserSuccess(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUserSuccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUserSuccess indicates an expected call of RecordUserSuccess.
+func (mr *MockLoginAttemptUsecaseMockRecorder) RecordUserSuccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUserSuccess", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).RecordUserSuccess), arg0, arg1)
+}
+
+// UnlockUser mocks base method.
+func (m *MockLoginAttemptUsecase) UnlockUser(arg0 context.Context, arg1 *model.UnlockUserPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockLoginAttemptUsecaseMockRecorder) UnlockUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockLoginAttemptUsecase)(nil).UnlockUser), arg0, arg1)
+}
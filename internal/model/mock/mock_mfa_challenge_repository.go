@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: MFAChallengeRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	redis "github.com/go-redis/redis/v8"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockMFAChallengeRepository is a mock of MFAChallengeRepository interface.
+type MockMFAChallengeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAChallengeRepositoryMockRecorder
+}
+
+// MockMFAChallengeRepositoryMockRecorder is the mock recorder for MockMFAChallengeRepository.
+type MockMFAChallengeRepositoryMockRecorder struct {
+	mock *MockMFAChallengeRepository
+}
+
+// NewMockMFAChallengeRepository creates a new mock instance.
+func NewMockMFAChallengeRepository(ctrl *gomock.Controller) *MockMFAChallengeRepository {
+	mock := &MockMFAChallengeRepository{ctrl: ctrl}
+	mock.recorder = &MockMFAChallengeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAChallengeRepository) EXPECT() *MockMFAChallengeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMFAChallengeRepository) Create(arg0 context.Context, arg1 string, arg2 *model.MFAChallenge, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMFAChallengeRepositoryMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMFAChallengeRepository)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockMFAChallengeRepository) Delete(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMFAChallengeRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMFAChallengeRepository)(nil).Delete), arg0, arg1)
+}
+
+// Find mocks base method.
+func (m *MockMFAChallengeRepository) Find(arg0 context.Context, arg1 string) (*model.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0, arg1)
+	ret0, _ := ret[0].(*model.MFAChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockMFAChallengeRepositoryMockRecorder) Find(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockMFAChallengeRepository)(nil).Find), arg0, arg1)
+}
+
+// IncrementAttempts mocks base method.
+func (m *MockMFAChallengeRepository) IncrementAttempts(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementAttempts", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementAttempts indicates an expected call of IncrementAttempts.
+func (mr *MockMFAChallengeRepositoryMockRecorder) IncrementAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAttempts", reflect.TypeOf((*MockMFAChallengeRepository)(nil).IncrementAttempts), arg0, arg1)
+}
+
+// InjectRedisClient mocks base method.
+func (m *MockMFAChallengeRepository) InjectRedisClient(arg0 *redis.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectRedisClient", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectRedisClient indicates an expected call of InjectRedisClient.
+func (mr *MockMFAChallengeRepositoryMockRecorder) InjectRedisClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectRedisClient", reflect.TypeOf((*MockMFAChallengeRepository)(nil).InjectRedisClient), arg0)
+}
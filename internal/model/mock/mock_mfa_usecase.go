@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: MFAUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockMFAUsecase is a mock of MFAUsecase interface.
+type MockMFAUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAUsecaseMockRecorder
+}
+
+// MockMFAUsecaseMockRecorder is the mock recorder for MockMFAUsecase.
+type MockMFAUsecaseMockRecorder struct {
+	mock *MockMFAUsecase
+}
+
+// NewMockMFAUsecase creates a new mock instance.
+func NewMockMFAUsecase(ctrl *gomock.Controller) *MockMFAUsecase {
+	mock := &MockMFAUsecase{ctrl: ctrl}
+	mock.recorder = &MockMFAUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAUsecase) EXPECT() *MockMFAUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockMFAUsecase) Confirm(arg0 context.Context, arg1 *model.ConfirmMFAPayload) (*model.ConfirmMFAResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1)
+	ret0, _ := ret[0].(*model.ConfirmMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockMFAUsecaseMockRecorder) Confirm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockMFAUsecase)(nil).Confirm), arg0, arg1)
+}
+
+// CreateChallenge mocks base method.
+func (m *MockMFAUsecase) CreateChallenge(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChallenge", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockMFAUsecaseMockRecorder) CreateChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*MockMFAUsecase)(nil).CreateChallenge), arg0, arg1)
+}
+
+// Disable mocks base method.
+func (m *MockMFAUsecase) Disable(arg0 context.Context, arg1 *model.DisableMFAPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockMFAUsecaseMockRecorder) Disable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockMFAUsecase)(nil).Disable), arg0, arg1)
+}
+
+// Enroll mocks base method.
+func (m *MockMFAUsecase) Enroll(arg0 context.Context) (*model.EnrollMFAResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", arg0)
+	ret0, _ := ret[0].(*model.EnrollMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockMFAUsecaseMockRecorder) Enroll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockMFAUsecase)(nil).Enroll), arg0)
+}
+
+// InjectLoginAttemptUsecase mocks base method.
+func (m *MockMFAUsecase) InjectLoginAttemptUsecase(arg0 model.LoginAttemptUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectLoginAttemptUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectLoginAttemptUsecase indicates an expected call of InjectLoginAttemptUsecase.
+func (mr *MockMFAUsecaseMockRecorder) InjectLoginAttemptUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectLoginAttemptUsecase", reflect.TypeOf((*MockMFAUsecase)(nil).InjectLoginAttemptUsecase), arg0)
+}
+
+// InjectMFAChallengeRepo mocks base method.
+func (m *MockMFAUsecase) InjectMFAChallengeRepo(arg0 model.MFAChallengeRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectMFAChallengeRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectMFAChallengeRepo indicates an expected call of InjectMFAChallengeRepo.
+func (mr *MockMFAUsecaseMockRecorder) InjectMFAChallengeRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectMFAChallengeRepo", reflect.TypeOf((*MockMFAUsecase)(nil).InjectMFAChallengeRepo), arg0)
+}
+
+// InjectSecurityEventRepo mocks base method.
+func (m *MockMFAUsecase) InjectSecurityEventRepo(arg0 model.SecurityEventRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectSecurityEventRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectSecurityEventRepo indicates an expected call of InjectSecurityEventRepo.
+func (mr *MockMFAUsecaseMockRecorder) InjectSecurityEventRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSecurityEventRepo", reflect.TypeOf((*MockMFAUsecase)(nil).InjectSecurityEventRepo), arg0)
+}
+
+// InjectUserMFARepo mocks base method.
+func (m *MockMFAUsecase) InjectUserMFARepo(arg0 model.UserMFARepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserMFARepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserMFARepo indicates an expected call of InjectUserMFARepo.
+func (mr *MockMFAUsecaseMockRecorder) InjectUserMFARepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserMFARepo", reflect.TypeOf((*MockMFAUsecase)(nil).InjectUserMFARepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockMFAUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockMFAUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockMFAUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// InjectUserUsecase mocks base method.
+func (m *MockMFAUsecase) InjectUserUsecase(arg0 model.UserUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserUsecase indicates an expected call of InjectUserUsecase.
+func (mr *MockMFAUsecaseMockRecorder) InjectUserUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserUsecase", reflect.TypeOf((*MockMFAUsecase)(nil).InjectUserUsecase), arg0)
+}
+
+// IsEnabled mocks base method.
+func (m *MockMFAUsecase) IsEnabled(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEnabled", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEnabled indicates an expected call of IsEnabled.
+func (mr *MockMFAUsecaseMockRecorder) IsEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnabled", reflect.TypeOf((*MockMFAUsecase)(nil).IsEnabled), arg0, arg1)
+}
+
+// Verify mocks base method.
+func (m *MockMFAUsecase) Verify(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockMFAUsecaseMockRecorder) Verify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockMFAUsecase)(nil).Verify), arg0, arg1, arg2)
+}
+
+// VerifyChallenge mocks base method.
+func (m *MockMFAUsecase) VerifyChallenge(arg0 context.Context, arg1 *model.VerifyMFAPayload) (*model.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyChallenge", arg0, arg1)
+	ret0, _ := ret[0].(*model.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyChallenge indicates an expected call of VerifyChallenge.
+func (mr *MockMFAUsecaseMockRecorder) VerifyChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyChallenge", reflect.TypeOf((*MockMFAUsecase)(nil).VerifyChallenge), arg0, arg1)
+}
@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: OAuthUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockOAuthUsecase is a mock of OAuthUsecase interface.
+type MockOAuthUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthUsecaseMockRecorder
+}
+
+// MockOAuthUsecaseMockRecorder is the mock recorder for MockOAuthUsecase.
+type MockOAuthUsecaseMockRecorder struct {
+	mock *MockOAuthUsecase
+}
+
+// NewMockOAuthUsecase creates a new mock instance.
+func NewMockOAuthUsecase(ctrl *gomock.Controller) *MockOAuthUsecase {
+	mock := &MockOAuthUsecase{ctrl: ctrl}
+	mock.recorder = &MockOAuthUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthUsecase) EXPECT() *MockOAuthUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockOAuthUsecase) Authorize(arg0 context.Context, arg1 *model.AuthorizePayload) (*model.AuthorizeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1)
+	ret0, _ := ret[0].(*model.AuthorizeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockOAuthUsecaseMockRecorder) Authorize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockOAuthUsecase)(nil).Authorize), arg0, arg1)
+}
+
+// AuthorizeUser mocks base method.
+func (m *MockOAuthUsecase) AuthorizeUser(arg0 context.Context, arg1 *model.AuthorizePayload, arg2 string) (*model.AuthorizeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.AuthorizeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeUser indicates an expected call of AuthorizeUser.
+func (mr *MockOAuthUsecaseMockRecorder) AuthorizeUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeUser", reflect.TypeOf((*MockOAuthUsecase)(nil).AuthorizeUser), arg0, arg1, arg2)
+}
+
+// CreateClient mocks base method.
+func (m *MockOAuthUsecase) CreateClient(arg0 context.Context, arg1 *model.CreateOAuthClientPayload) (*model.CreatedOAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClient", arg0, arg1)
+	ret0, _ := ret[0].(*model.CreatedOAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClient indicates an expected call of CreateClient.
+func (mr *MockOAuthUsecaseMockRecorder) CreateClient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClient", reflect.TypeOf((*MockOAuthUsecase)(nil).CreateClient), arg0, arg1)
+}
+
+// DeleteClient mocks base method.
+func (m *MockOAuthUsecase) DeleteClient(arg0 context.Context, arg1 *model.DeleteOAuthClientPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClient", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClient indicates an expected call of DeleteClient.
+func (mr *MockOAuthUsecaseMockRecorder) DeleteClient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClient", reflect.TypeOf((*MockOAuthUsecase)(nil).DeleteClient), arg0, arg1)
+}
+
+// InjectAuthUsecase mocks base method.
+func (m *MockOAuthUsecase) InjectAuthUsecase(arg0 model.AuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthUsecase indicates an expected call of InjectAuthUsecase.
+func (mr *MockOAuthUsecaseMockRecorder) InjectAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthUsecase", reflect.TypeOf((*MockOAuthUsecase)(nil).InjectAuthUsecase), arg0)
+}
+
+// InjectAuthorizationCodeRepo mocks base method.
+func (m *MockOAuthUsecase) InjectAuthorizationCodeRepo(arg0 model.AuthorizationCodeRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthorizationCodeRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthorizationCodeRepo indicates an expected call of InjectAuthorizationCodeRepo.
+func (mr *MockOAuthUsecaseMockRecorder) InjectAuthorizationCodeRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthorizationCodeRepo", reflect.TypeOf((*MockOAuthUsecase)(nil).InjectAuthorizationCodeRepo), arg0)
+}
+
+// InjectMFAUsecase mocks base method.
+func (m *MockOAuthUsecase) InjectMFAUsecase(arg0 model.MFAUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectMFAUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectMFAUsecase indicates an expected call of InjectMFAUsecase.
+func (mr *MockOAuthUsecaseMockRecorder) InjectMFAUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectMFAUsecase", reflect.TypeOf((*MockOAuthUsecase)(nil).InjectMFAUsecase), arg0)
+}
+
+// InjectOAuthClientRepo mocks base method.
+func (m *MockOAuthUsecase) InjectOAuthClientRepo(arg0 model.OAuthClientRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectOAuthClientRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectOAuthClientRepo indicates an expected call of InjectOAuthClientRepo.
+func (mr *MockOAuthUsecaseMockRecorder) InjectOAuthClientRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectOAuthClientRepo", reflect.TypeOf((*MockOAuthUsecase)(nil).InjectOAuthClientRepo), arg0)
+}
+
+// InjectTokenRepo mocks base method.
+func (m *MockOAuthUsecase) InjectTokenRepo(arg0 model.TokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectTokenRepo indicates an expected call of InjectTokenRepo.
+func (mr *MockOAuthUsecaseMockRecorder) InjectTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectTokenRepo", reflect.TypeOf((*MockOAuthUsecase)(nil).InjectTokenRepo), arg0)
+}
+
+// InjectUserUsecase mocks base method.
+func (m *MockOAuthUsecase) InjectUserUsecase(arg0 model.UserUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserUsecase indicates an expected call of InjectUserUsecase.
+func (mr *MockOAuthUsecaseMockRecorder) InjectUserUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserUsecase", reflect.TypeOf((*MockOAuthUsecase)(nil).InjectUserUsecase), arg0)
+}
+
+// OpenIDConfiguration mocks base method.
+func (m *MockOAuthUsecase) OpenIDConfiguration(arg0 context.Context) *model.OpenIDConfiguration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenIDConfiguration", arg0)
+	ret0, _ := ret[0].(*model.OpenIDConfiguration)
+	return ret0
+}
+
+// OpenIDConfiguration indicates an expected call of OpenIDConfiguration.
+func (mr *MockOAuthUsecaseMockRecorder) OpenIDConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenIDConfiguration", reflect.TypeOf((*MockOAuthUsecase)(nil).OpenIDConfiguration), arg0)
+}
+
+// Token mocks base method.
+func (m *MockOAuthUsecase) Token(arg0 context.Context, arg1 *model.OAuthTokenPayload) (*model.OAuthTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token", arg0, arg1)
+	ret0, _ := ret[0].(*model.OAuthTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockOAuthUsecaseMockRecorder) Token(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockOAuthUsecase)(nil).Token), arg0, arg1)
+}
+
+// UserInfo mocks base method.
+func (m *MockOAuthUsecase) UserInfo(arg0 context.Context, arg1 string) (*model.UserInfoClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserInfo", arg0, arg1)
+	ret0, _ := ret[0].(*model.UserInfoClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserInfo indicates an expected call of UserInfo.
+func (mr *MockOAuthUsecaseMockRecorder) UserInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInfo", reflect.TypeOf((*MockOAuthUsecase)(nil).UserInfo), arg0, arg1)
+}
+
+// ValidateAuthorizeRequest mocks base method.
+func (m *MockOAuthUsecase) ValidateAuthorizeRequest(arg0 context.Context, arg1 *model.AuthorizePayload) (*model.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAuthorizeRequest", arg0, arg1)
+	ret0, _ := ret[0].(*model.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAuthorizeRequest indicates an expected call of ValidateAuthorizeRequest.
+func (mr *MockOAuthUsecaseMockRecorder) ValidateAuthorizeRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAuthorizeRequest", reflect.TypeOf((*MockOAuthUsecase)(nil).ValidateAuthorizeRequest), arg0, arg1)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: PasswordPolicyUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockPasswordPolicyUsecase is a mock of PasswordPolicyUsecase interface.
+type MockPasswordPolicyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordPolicyUsecaseMockRecorder
+}
+
+// MockPasswordPolicyUsecaseMockRecorder is the mock recorder for MockPasswordPolicyUsecase.
+type MockPasswordPolicyUsecaseMockRecorder struct {
+	mock *MockPasswordPolicyUsecase
+}
+
+// NewMockPasswordPolicyUsecase creates a new mock instance.
+func NewMockPasswordPolicyUsecase(ctrl *gomock.Controller) *MockPasswordPolicyUsecase {
+	mock := &MockPasswordPolicyUsecase{ctrl: ctrl}
+	mock.recorder = &MockPasswordPolicyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordPolicyUsecase) EXPECT() *MockPasswordPolicyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// InjectBreachedPasswordList mocks base method.
+func (m *MockPasswordPolicyUsecase) InjectBreachedPasswordList(arg0 model.BreachedPasswordList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectBreachedPasswordList", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectBreachedPasswordList indicates an expected call of InjectBreachedPasswordList.
+func (mr *MockPasswordPolicyUsecaseMockRecorder) InjectBreachedPasswordList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectBreachedPasswordList", reflect.TypeOf((*MockPasswordPolicyUsecase)(nil).InjectBreachedPasswordList), arg0)
+}
+
+// InjectPasswordHistoryRepo mocks base method.
+func (m *MockPasswordPolicyUsecase) InjectPasswordHistoryRepo(arg0 model.PasswordHistoryRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPasswordHistoryRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPasswordHistoryRepo indicates an expected call of InjectPasswordHistoryRepo.
+func (mr *MockPasswordPolicyUsecaseMockRecorder) InjectPasswordHistoryRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPasswordHistoryRepo", reflect.TypeOf((*MockPasswordPolicyUsecase)(nil).InjectPasswordHistoryRepo), arg0)
+}
+
+// RecordPassword mocks base method.
+func (m *MockPasswordPolicyUsecase) RecordPassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPassword indicates an expected call of RecordPassword.
+func (mr *MockPasswordPolicyUsecaseMockRecorder) RecordPassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPassword", reflect.TypeOf((*MockPasswordPolicyUsecase)(nil).RecordPassword), arg0, arg1, arg2)
+}
+
+// Validate mocks base method.
+func (m *MockPasswordPolicyUsecase) Validate(arg0 context.Context, arg1 *model.User, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockPasswordPolicyUsecaseMockRecorder) Validate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockPasswordPolicyUsecase)(nil).Validate), arg0, arg1, arg2)
+}
@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: PasswordResetUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockPasswordResetUsecase is a mock of PasswordResetUsecase interface.
+type MockPasswordResetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetUsecaseMockRecorder
+}
+
+// MockPasswordResetUsecaseMockRecorder is the mock recorder for MockPasswordResetUsecase.
+type MockPasswordResetUsecaseMockRecorder struct {
+	mock *MockPasswordResetUsecase
+}
+
+// NewMockPasswordResetUsecase creates a new mock instance.
+func NewMockPasswordResetUsecase(ctrl *gomock.Controller) *MockPasswordResetUsecase {
+	mock := &MockPasswordResetUsecase{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetUsecase) EXPECT() *MockPasswordResetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// InjectMailer mocks base method.
+func (m *MockPasswordResetUsecase) InjectMailer(arg0 model.Mailer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectMailer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectMailer indicates an expected call of InjectMailer.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectMailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectMailer", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectMailer), arg0)
+}
+
+// InjectPasswordPolicyUsecase mocks base method.
+func (m *MockPasswordResetUsecase) InjectPasswordPolicyUsecase(arg0 model.PasswordPolicyUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPasswordPolicyUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPasswordPolicyUsecase indicates an expected call of InjectPasswordPolicyUsecase.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectPasswordPolicyUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPasswordPolicyUsecase", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectPasswordPolicyUsecase), arg0)
+}
+
+// InjectPasswordResetRepo mocks base method.
+func (m *MockPasswordResetUsecase) InjectPasswordResetRepo(arg0 model.PasswordResetRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPasswordResetRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPasswordResetRepo indicates an expected call of InjectPasswordResetRepo.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectPasswordResetRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPasswordResetRepo", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectPasswordResetRepo), arg0)
+}
+
+// InjectPersonalAccessTokenRepo mocks base method.
+func (m *MockPasswordResetUsecase) InjectPersonalAccessTokenRepo(arg0 model.PersonalAccessTokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPersonalAccessTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPersonalAccessTokenRepo indicates an expected call of InjectPersonalAccessTokenRepo.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectPersonalAccessTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPersonalAccessTokenRepo", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectPersonalAccessTokenRepo), arg0)
+}
+
+// InjectSecurityEventRepo mocks base method.
+func (m *MockPasswordResetUsecase) InjectSecurityEventRepo(arg0 model.SecurityEventRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectSecurityEventRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectSecurityEventRepo indicates an expected call of InjectSecurityEventRepo.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectSecurityEventRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSecurityEventRepo", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectSecurityEventRepo), arg0)
+}
+
+// InjectTokenRepo mocks base method.
+func (m *MockPasswordResetUsecase) InjectTokenRepo(arg0 model.TokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectTokenRepo indicates an expected call of InjectTokenRepo.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectTokenRepo", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectTokenRepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockPasswordResetUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockPasswordResetUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockPasswordResetUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockPasswordResetUsecase) RequestPasswordReset(arg0 context.Context, arg1 *model.RequestPasswordResetPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockPasswordResetUsecaseMockRecorder) RequestPasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockPasswordResetUsecase)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordResetUsecase) ResetPassword(arg0 context.Context, arg1 *model.ResetPasswordPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordResetUsecaseMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordResetUsecase)(nil).ResetPassword), arg0, arg1)
+}
@@ -65,6 +65,21 @@ func (mr *MockPermissionRepositoryMockRecorder) DeleteByID(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockPermissionRepository)(nil).DeleteByID), arg0, arg1)
 }
 
+// FindAll mocks base method.
+func (m *MockPermissionRepository) FindAll(arg0 context.Context, arg1 *model.PermissionFilter) ([]*model.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPermissionRepositoryMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPermissionRepository)(nil).FindAll), arg0, arg1)
+}
+
 // FindByID mocks base method.
 func (m *MockPermissionRepository) FindByID(arg0 context.Context, arg1 string) (*model.Permission, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +122,21 @@ func (mr *MockPermissionUsecaseMockRecorder) InjectPermissionRepo(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPermissionRepo", reflect.TypeOf((*MockPermissionUsecase)(nil).InjectPermissionRepo), arg0)
 }
 
+// List mocks base method.
+func (m *MockPermissionUsecase) List(arg0 context.Context, arg1 *model.ListPermissionsPayload) (*model.ListPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPermissionUsecaseMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPermissionUsecase)(nil).List), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockPermissionUsecase) Update(arg0 context.Context, arg1 *model.UpdatePermissionPayload) (*model.Permission, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: PersonalAccessTokenUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockPersonalAccessTokenUsecase is a mock of PersonalAccessTokenUsecase interface.
+type MockPersonalAccessTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersonalAccessTokenUsecaseMockRecorder
+}
+
+// MockPersonalAccessTokenUsecaseMockRecorder is the mock recorder for MockPersonalAccessTokenUsecase.
+type MockPersonalAccessTokenUsecaseMockRecorder struct {
+	mock *MockPersonalAccessTokenUsecase
+}
+
+// NewMockPersonalAccessTokenUsecase creates a new mock instance.
+func NewMockPersonalAccessTokenUsecase(ctrl *gomock.Controller) *MockPersonalAccessTokenUsecase {
+	mock := &MockPersonalAccessTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockPersonalAccessTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersonalAccessTokenUsecase) EXPECT() *MockPersonalAccessTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPersonalAccessTokenUsecase) Create(arg0 context.Context, arg1 *model.CreatePersonalAccessTokenPayload) (*model.CreatedPersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*model.CreatedPersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPersonalAccessTokenUsecaseMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPersonalAccessTokenUsecase)(nil).Create), arg0, arg1)
+}
+
+// FindByCurrentUser mocks base method.
+func (m *MockPersonalAccessTokenUsecase) FindByCurrentUser(arg0 context.Context) (model.PersonalAccessTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCurrentUser", arg0)
+	ret0, _ := ret[0].(model.PersonalAccessTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCurrentUser indicates an expected call of FindByCurrentUser.
+func (mr *MockPersonalAccessTokenUsecaseMockRecorder) FindByCurrentUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCurrentUser", reflect.TypeOf((*MockPersonalAccessTokenUsecase)(nil).FindByCurrentUser), arg0)
+}
+
+// InjectAuthUsecase mocks base method.
+func (m *MockPersonalAccessTokenUsecase) InjectAuthUsecase(arg0 model.AuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthUsecase indicates an expected call of InjectAuthUsecase.
+func (mr *MockPersonalAccessTokenUsecaseMockRecorder) InjectAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthUsecase", reflect.TypeOf((*MockPersonalAccessTokenUsecase)(nil).InjectAuthUsecase), arg0)
+}
+
+// InjectPersonalAccessTokenRepo mocks base method.
+func (m *MockPersonalAccessTokenUsecase) InjectPersonalAccessTokenRepo(arg0 model.PersonalAccessTokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPersonalAccessTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPersonalAccessTokenRepo indicates an expected call of InjectPersonalAccessTokenRepo.
+func (mr *MockPersonalAccessTokenUsecaseMockRecorder) InjectPersonalAccessTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPersonalAccessTokenRepo", reflect.TypeOf((*MockPersonalAccessTokenUsecase)(nil).InjectPersonalAccessTokenRepo), arg0)
+}
+
+// Revoke mocks base method.
+func (m *MockPersonalAccessTokenUsecase) Revoke(arg0 context.Context, arg1 *model.RevokePersonalAccessTokenPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockPersonalAccessTokenUsecaseMockRecorder) Revoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockPersonalAccessTokenUsecase)(nil).Revoke), arg0, arg1)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: RateLimitUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockRateLimitUsecase is a mock of RateLimitUsecase interface.
+type MockRateLimitUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitUsecaseMockRecorder
+}
+
+// MockRateLimitUsecaseMockRecorder is the mock recorder for MockRateLimitUsecase.
+type MockRateLimitUsecaseMockRecorder struct {
+	mock *MockRateLimitUsecase
+}
+
+// NewMockRateLimitUsecase creates a new mock instance.
+func NewMockRateLimitUsecase(ctrl *gomock.Controller) *MockRateLimitUsecase {
+	mock := &MockRateLimitUsecase{ctrl: ctrl}
+	mock.recorder = &MockRateLimitUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitUsecase) EXPECT() *MockRateLimitUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimitUsecase) Allow(arg0 context.Context, arg1 string) (*model.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", arg0, arg1)
+	ret0, _ := ret[0].(*model.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimitUsecaseMockRecorder) Allow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimitUsecase)(nil).Allow), arg0, arg1)
+}
+
+// AllowAddress mocks base method.
+func (m *MockRateLimitUsecase) AllowAddress(arg0 context.Context, arg1 string) (*model.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowAddress", arg0, arg1)
+	ret0, _ := ret[0].(*model.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowAddress indicates an expected call of AllowAddress.
+func (mr *MockRateLimitUsecaseMockRecorder) AllowAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowAddress", reflect.TypeOf((*MockRateLimitUsecase)(nil).AllowAddress), arg0, arg1)
+}
+
+// InjectRateLimitRepo mocks base method.
+func (m *MockRateLimitUsecase) InjectRateLimitRepo(arg0 model.RateLimitRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectRateLimitRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectRateLimitRepo indicates an expected call of InjectRateLimitRepo.
+func (mr *MockRateLimitUsecaseMockRecorder) InjectRateLimitRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectRateLimitRepo", reflect.TypeOf((*MockRateLimitUsecase)(nil).InjectRateLimitRepo), arg0)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: ServiceAccountGroupRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	redis "github.com/go-redis/redis/v8"
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+	gorm "gorm.io/gorm"
+)
+
+// MockServiceAccountGroupRepository is a mock of ServiceAccountGroupRepository interface.
+type MockServiceAccountGroupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountGroupRepositoryMockRecorder
+}
+
+// MockServiceAccountGroupRepositoryMockRecorder is the mock recorder for MockServiceAccountGroupRepository.
+type MockServiceAccountGroupRepositoryMockRecorder struct {
+	mock *MockServiceAccountGroupRepository
+}
+
+// NewMockServiceAccountGroupRepository creates a new mock instance.
+func NewMockServiceAccountGroupRepository(ctrl *gomock.Controller) *MockServiceAccountGroupRepository {
+	mock := &MockServiceAccountGroupRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountGroupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountGroupRepository) EXPECT() *MockServiceAccountGroupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockServiceAccountGroupRepository) Create(arg0 context.Context, arg1 *model.ServiceAccountGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceAccountGroupRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountGroupRepository)(nil).Create), arg0, arg1)
+}
+
+// DeleteByServiceAccountIDAndGroupID mocks base method.
+func (m *MockServiceAccountGroupRepository) DeleteByServiceAccountIDAndGroupID(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByServiceAccountIDAndGroupID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByServiceAccountIDAndGroupID indicates an expected call of DeleteByServiceAccountIDAndGroupID.
+func (mr *MockServiceAccountGroupRepositoryMockRecorder) DeleteByServiceAccountIDAndGroupID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByServiceAccountIDAndGroupID", reflect.TypeOf((*MockServiceAccountGroupRepository)(nil).DeleteByServiceAccountIDAndGroupID), arg0, arg1, arg2)
+}
+
+// FindByServiceAccountID mocks base method.
+func (m *MockServiceAccountGroupRepository) FindByServiceAccountID(arg0 context.Context, arg1 string) ([]*model.ServiceAccountGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByServiceAccountID", arg0, arg1)
+	ret0, _ := ret[0].([]*model.ServiceAccountGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByServiceAccountID indicates an expected call of FindByServiceAccountID.
+func (mr *MockServiceAccountGroupRepositoryMockRecorder) FindByServiceAccountID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByServiceAccountID", reflect.TypeOf((*MockServiceAccountGroupRepository)(nil).FindByServiceAccountID), arg0, arg1)
+}
+
+// FindByServiceAccountIDAndGroupID mocks base method.
+func (m *MockServiceAccountGroupRepository) FindByServiceAccountIDAndGroupID(arg0 context.Context, arg1, arg2 string) (*model.ServiceAccountGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByServiceAccountIDAndGroupID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.ServiceAccountGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByServiceAccountIDAndGroupID indicates an expected call of FindByServiceAccountIDAndGroupID.
+func (mr *MockServiceAccountGroupRepositoryMockRecorder) FindByServiceAccountIDAndGroupID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByServiceAccountIDAndGroupID", reflect.TypeOf((*MockServiceAccountGroupRepository)(nil).FindByServiceAccountIDAndGroupID), arg0, arg1, arg2)
+}
+
+// InjectDB mocks base method.
+func (m *MockServiceAccountGroupRepository) InjectDB(arg0 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectDB", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectDB indicates an expected call of InjectDB.
+func (mr *MockServiceAccountGroupRepositoryMockRecorder) InjectDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectDB", reflect.TypeOf((*MockServiceAccountGroupRepository)(nil).InjectDB), arg0)
+}
+
+// InjectRedisClient mocks base method.
+func (m *MockServiceAccountGroupRepository) InjectRedisClient(arg0 *redis.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectRedisClient", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectRedisClient indicates an expected call of InjectRedisClient.
+func (mr *MockServiceAccountGroupRepositoryMockRecorder) InjectRedisClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectRedisClient", reflect.TypeOf((*MockServiceAccountGroupRepository)(nil).InjectRedisClient), arg0)
+}
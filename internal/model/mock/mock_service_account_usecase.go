@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: ServiceAccountUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockServiceAccountUsecase is a mock of ServiceAccountUsecase interface.
+type MockServiceAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountUsecaseMockRecorder
+}
+
+// MockServiceAccountUsecaseMockRecorder is the mock recorder for MockServiceAccountUsecase.
+type MockServiceAccountUsecaseMockRecorder struct {
+	mock *MockServiceAccountUsecase
+}
+
+// NewMockServiceAccountUsecase creates a new mock instance.
+func NewMockServiceAccountUsecase(ctrl *gomock.Controller) *MockServiceAccountUsecase {
+	mock := &MockServiceAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountUsecase) EXPECT() *MockServiceAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddGroup mocks base method.
+func (m *MockServiceAccountUsecase) AddGroup(arg0 context.Context, arg1 *model.ServiceAccountGroupPayload) (*model.ServiceAccountGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroup", arg0, arg1)
+	ret0, _ := ret[0].(*model.ServiceAccountGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockServiceAccountUsecaseMockRecorder) AddGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroup", reflect.TypeOf((*MockServiceAccountUsecase)(nil).AddGroup), arg0, arg1)
+}
+
+// ClientCredentials mocks base method.
+func (m *MockServiceAccountUsecase) ClientCredentials(arg0 context.Context, arg1 *model.ClientCredentialsPayload) (*model.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*model.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClientCredentials indicates an expected call of ClientCredentials.
+func (mr *MockServiceAccountUsecaseMockRecorder) ClientCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientCredentials", reflect.TypeOf((*MockServiceAccountUsecase)(nil).ClientCredentials), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockServiceAccountUsecase) Create(arg0 context.Context, arg1 *model.CreateServiceAccountPayload) (*model.CreatedServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*model.CreatedServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceAccountUsecaseMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountUsecase)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockServiceAccountUsecase) Delete(arg0 context.Context, arg1 *model.DeleteServiceAccountPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceAccountUsecaseMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceAccountUsecase)(nil).Delete), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockServiceAccountUsecase) FindByID(arg0 context.Context, arg1 *model.FindServiceAccountByIDPayload) (*model.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockServiceAccountUsecaseMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockServiceAccountUsecase)(nil).FindByID), arg0, arg1)
+}
+
+// FindGroups mocks base method.
+func (m *MockServiceAccountUsecase) FindGroups(arg0 context.Context, arg1 *model.FindServiceAccountGroupsPayload) (model.ServiceAccountGroups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGroups", arg0, arg1)
+	ret0, _ := ret[0].(model.ServiceAccountGroups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGroups indicates an expected call of FindGroups.
+func (mr *MockServiceAccountUsecaseMockRecorder) FindGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGroups", reflect.TypeOf((*MockServiceAccountUsecase)(nil).FindGroups), arg0, arg1)
+}
+
+// InjectAuthUsecase mocks base method.
+func (m *MockServiceAccountUsecase) InjectAuthUsecase(arg0 model.AuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthUsecase indicates an expected call of InjectAuthUsecase.
+func (mr *MockServiceAccountUsecaseMockRecorder) InjectAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthUsecase", reflect.TypeOf((*MockServiceAccountUsecase)(nil).InjectAuthUsecase), arg0)
+}
+
+// InjectGroupPermissionRepo mocks base method.
+func (m *MockServiceAccountUsecase) InjectGroupPermissionRepo(arg0 model.GroupPermissionRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectGroupPermissionRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectGroupPermissionRepo indicates an expected call of InjectGroupPermissionRepo.
+func (mr *MockServiceAccountUsecaseMockRecorder) InjectGroupPermissionRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupPermissionRepo", reflect.TypeOf((*MockServiceAccountUsecase)(nil).InjectGroupPermissionRepo), arg0)
+}
+
+// InjectGroupRepo mocks base method.
+func (m *MockServiceAccountUsecase) InjectGroupRepo(arg0 model.GroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectGroupRepo indicates an expected call of InjectGroupRepo.
+func (mr *MockServiceAccountUsecaseMockRecorder) InjectGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupRepo", reflect.TypeOf((*MockServiceAccountUsecase)(nil).InjectGroupRepo), arg0)
+}
+
+// InjectServiceAccountGroupRepo mocks base method.
+func (m *MockServiceAccountUsecase) InjectServiceAccountGroupRepo(arg0 model.ServiceAccountGroupRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectServiceAccountGroupRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectServiceAccountGroupRepo indicates an expected call of InjectServiceAccountGroupRepo.
+func (mr *MockServiceAccountUsecaseMockRecorder) InjectServiceAccountGroupRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectServiceAccountGroupRepo", reflect.TypeOf((*MockServiceAccountUsecase)(nil).InjectServiceAccountGroupRepo), arg0)
+}
+
+// InjectServiceAccountRepo mocks base method.
+func (m *MockServiceAccountUsecase) InjectServiceAccountRepo(arg0 model.ServiceAccountRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectServiceAccountRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectServiceAccountRepo indicates an expected call of InjectServiceAccountRepo.
+func (mr *MockServiceAccountUsecaseMockRecorder) InjectServiceAccountRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectServiceAccountRepo", reflect.TypeOf((*MockServiceAccountUsecase)(nil).InjectServiceAccountRepo), arg0)
+}
+
+// InjectTokenRepo mocks base method.
+func (m *MockServiceAccountUsecase) InjectTokenRepo(arg0 model.TokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectTokenRepo indicates an expected call of InjectTokenRepo.
+func (mr *MockServiceAccountUsecaseMockRecorder) InjectTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectTokenRepo", reflect.TypeOf((*MockServiceAccountUsecase)(nil).InjectTokenRepo), arg0)
+}
+
+// RemoveGroup mocks base method.
+func (m *MockServiceAccountUsecase) RemoveGroup(arg0 context.Context, arg1 *model.ServiceAccountGroupPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroup indicates an expected call of RemoveGroup.
+func (mr *MockServiceAccountUsecaseMockRecorder) RemoveGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroup", reflect.TypeOf((*MockServiceAccountUsecase)(nil).RemoveGroup), arg0, arg1)
+}
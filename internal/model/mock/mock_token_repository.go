@@ -36,19 +36,49 @@ func (m *MockTokenRepository) EXPECT() *MockTokenRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimRefreshToken mocks base method.
+func (m *MockTokenRepository) ClaimRefreshToken(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRefreshToken indicates an expected call of ClaimRefreshToken.
+func (mr *MockTokenRepositoryMockRecorder) ClaimRefreshToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRefreshToken", reflect.TypeOf((*MockTokenRepository)(nil).ClaimRefreshToken), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
-func (m *MockTokenRepository) Create(arg0 context.Context, arg1, arg2 string, arg3 model.TokenType) (string, error) {
+func (m *MockTokenRepository) Create(arg0 context.Context, arg1, arg2, arg3 string, arg4 model.TokenType, arg5 *model.SessionMetadata) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTokenRepositoryMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockTokenRepositoryMockRecorder) Create(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTokenRepository)(nil).Create), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTokenRepository)(nil).Create), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// FindSession mocks base method.
+func (m *MockTokenRepository) FindSession(arg0 context.Context, arg1, arg2 string) (*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSession indicates an expected call of FindSession.
+func (mr *MockTokenRepositoryMockRecorder) FindSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSession", reflect.TypeOf((*MockTokenRepository)(nil).FindSession), arg0, arg1, arg2)
 }
 
 // InjectRedisClient mocks base method.
@@ -80,6 +110,21 @@ func (mr *MockTokenRepositoryMockRecorder) IsValidToken(arg0, arg1, arg2, arg3 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidToken", reflect.TypeOf((*MockTokenRepository)(nil).IsValidToken), arg0, arg1, arg2, arg3)
 }
 
+// ListSessions mocks base method.
+func (m *MockTokenRepository) ListSessions(arg0 context.Context, arg1 string) ([]*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockTokenRepositoryMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockTokenRepository)(nil).ListSessions), arg0, arg1)
+}
+
 // Revoke mocks base method.
 func (m *MockTokenRepository) Revoke(arg0 context.Context, arg1, arg2 string, arg3 model.TokenType) error {
 	m.ctrl.T.Helper()
@@ -93,3 +138,45 @@ func (mr *MockTokenRepositoryMockRecorder) Revoke(arg0, arg1, arg2, arg3 interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockTokenRepository)(nil).Revoke), arg0, arg1, arg2, arg3)
 }
+
+// RevokeAllSessions mocks base method.
+func (m *MockTokenRepository) RevokeAllSessions(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockTokenRepositoryMockRecorder) RevokeAllSessions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockTokenRepository)(nil).RevokeAllSessions), arg0, arg1, arg2)
+}
+
+// RevokeFamily mocks base method.
+func (m *MockTokenRepository) RevokeFamily(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockTokenRepositoryMockRecorder) RevokeFamily(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockTokenRepository)(nil).RevokeFamily), arg0, arg1, arg2)
+}
+
+// RevokeSession mocks base method.
+func (m *MockTokenRepository) RevokeSession(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockTokenRepositoryMockRecorder) RevokeSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockTokenRepository)(nil).RevokeSession), arg0, arg1, arg2)
+}
@@ -95,6 +95,36 @@ func (mr *MockUserGroupRepositoryMockRecorder) FindByUserIDAndGroupID(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAndGroupID", reflect.TypeOf((*MockUserGroupRepository)(nil).FindByUserIDAndGroupID), arg0, arg1, arg2)
 }
 
+// FindMembers mocks base method.
+func (m *MockUserGroupRepository) FindMembers(arg0 context.Context, arg1 *model.GroupMemberFilter) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembers", arg0, arg1)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembers indicates an expected call of FindMembers.
+func (mr *MockUserGroupRepositoryMockRecorder) FindMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembers", reflect.TypeOf((*MockUserGroupRepository)(nil).FindMembers), arg0, arg1)
+}
+
+// FindUsersWithPermission mocks base method.
+func (m *MockUserGroupRepository) FindUsersWithPermission(arg0 context.Context, arg1 *model.PermissionHolderFilter) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsersWithPermission", arg0, arg1)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsersWithPermission indicates an expected call of FindUsersWithPermission.
+func (mr *MockUserGroupRepositoryMockRecorder) FindUsersWithPermission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersWithPermission", reflect.TypeOf((*MockUserGroupRepository)(nil).FindUsersWithPermission), arg0, arg1)
+}
+
 // HasPermission mocks base method.
 func (m *MockUserGroupRepository) HasPermission(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +122,20 @@ func (mr *MockUserGroupUsecaseMockRecorder) InjectGroupRepo(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupRepo", reflect.TypeOf((*MockUserGroupUsecase)(nil).InjectGroupRepo), arg0)
 }
 
+// InjectPermissionRepo mocks base method.
+func (m *MockUserGroupUsecase) InjectPermissionRepo(arg0 model.PermissionRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPermissionRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPermissionRepo indicates an expected call of InjectPermissionRepo.
+func (mr *MockUserGroupUsecaseMockRecorder) InjectPermissionRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPermissionRepo", reflect.TypeOf((*MockUserGroupUsecase)(nil).InjectPermissionRepo), arg0)
+}
+
 // InjectUserGroupRepo mocks base method.
 func (m *MockUserGroupUsecase) InjectUserGroupRepo(arg0 model.UserGroupRepository) error {
 	m.ctrl.T.Helper()
@@ -149,3 +163,33 @@ func (mr *MockUserGroupUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockUserGroupUsecase)(nil).InjectUserRepo), arg0)
 }
+
+// ListGroupMembers mocks base method.
+func (m *MockUserGroupUsecase) ListGroupMembers(arg0 context.Context, arg1 *model.ListGroupMembersPayload) (*model.ListGroupMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupMembers", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListGroupMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupMembers indicates an expected call of ListGroupMembers.
+func (mr *MockUserGroupUsecaseMockRecorder) ListGroupMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupMembers", reflect.TypeOf((*MockUserGroupUsecase)(nil).ListGroupMembers), arg0, arg1)
+}
+
+// ListUsersWithPermission mocks base method.
+func (m *MockUserGroupUsecase) ListUsersWithPermission(arg0 context.Context, arg1 *model.ListUsersWithPermissionPayload) (*model.ListUsersWithPermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersWithPermission", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListUsersWithPermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersWithPermission indicates an expected call of ListUsersWithPermission.
+func (mr *MockUserGroupUsecaseMockRecorder) ListUsersWithPermission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersWithPermission", reflect.TypeOf((*MockUserGroupUsecase)(nil).ListUsersWithPermission), arg0, arg1)
+}
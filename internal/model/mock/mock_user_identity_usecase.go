@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: UserIdentityUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+	gorm "gorm.io/gorm"
+)
+
+// MockUserIdentityUsecase is a mock of UserIdentityUsecase interface.
+type MockUserIdentityUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserIdentityUsecaseMockRecorder
+}
+
+// MockUserIdentityUsecaseMockRecorder is the mock recorder for MockUserIdentityUsecase.
+type MockUserIdentityUsecaseMockRecorder struct {
+	mock *MockUserIdentityUsecase
+}
+
+// NewMockUserIdentityUsecase creates a new mock instance.
+func NewMockUserIdentityUsecase(ctrl *gomock.Controller) *MockUserIdentityUsecase {
+	mock := &MockUserIdentityUsecase{ctrl: ctrl}
+	mock.recorder = &MockUserIdentityUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserIdentityUsecase) EXPECT() *MockUserIdentityUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Callback mocks base method.
+func (m *MockUserIdentityUsecase) Callback(arg0 context.Context, arg1 *model.FederatedCallbackPayload) (*model.FederatedCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", arg0, arg1)
+	ret0, _ := ret[0].(*model.FederatedCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockUserIdentityUsecaseMockRecorder) Callback(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockUserIdentityUsecase)(nil).Callback), arg0, arg1)
+}
+
+// FindByCurrentUser mocks base method.
+func (m *MockUserIdentityUsecase) FindByCurrentUser(arg0 context.Context) (model.UserIdentities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCurrentUser", arg0)
+	ret0, _ := ret[0].(model.UserIdentities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCurrentUser indicates an expected call of FindByCurrentUser.
+func (mr *MockUserIdentityUsecaseMockRecorder) FindByCurrentUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCurrentUser", reflect.TypeOf((*MockUserIdentityUsecase)(nil).FindByCurrentUser), arg0)
+}
+
+// InjectDB mocks base method.
+func (m *MockUserIdentityUsecase) InjectDB(arg0 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectDB", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectDB indicates an expected call of InjectDB.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectDB", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectDB), arg0)
+}
+
+// InjectFederationStateRepo mocks base method.
+func (m *MockUserIdentityUsecase) InjectFederationStateRepo(arg0 model.FederationStateRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectFederationStateRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectFederationStateRepo indicates an expected call of InjectFederationStateRepo.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectFederationStateRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectFederationStateRepo", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectFederationStateRepo), arg0)
+}
+
+// InjectIdentityProviderRepo mocks base method.
+func (m *MockUserIdentityUsecase) InjectIdentityProviderRepo(arg0 model.IdentityProviderRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectIdentityProviderRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectIdentityProviderRepo indicates an expected call of InjectIdentityProviderRepo.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectIdentityProviderRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectIdentityProviderRepo", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectIdentityProviderRepo), arg0)
+}
+
+// InjectOAuthUsecase mocks base method.
+func (m *MockUserIdentityUsecase) InjectOAuthUsecase(arg0 model.OAuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectOAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectOAuthUsecase indicates an expected call of InjectOAuthUsecase.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectOAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectOAuthUsecase", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectOAuthUsecase), arg0)
+}
+
+// InjectUserIdentityRepo mocks base method.
+func (m *MockUserIdentityUsecase) InjectUserIdentityRepo(arg0 model.UserIdentityRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserIdentityRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserIdentityRepo indicates an expected call of InjectUserIdentityRepo.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectUserIdentityRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserIdentityRepo", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectUserIdentityRepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockUserIdentityUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectUserRepo), arg0)
+}
+
+// InjectUserUsecase mocks base method.
+func (m *MockUserIdentityUsecase) InjectUserUsecase(arg0 model.UserUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserUsecase indicates an expected call of InjectUserUsecase.
+func (mr *MockUserIdentityUsecaseMockRecorder) InjectUserUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserUsecase", reflect.TypeOf((*MockUserIdentityUsecase)(nil).InjectUserUsecase), arg0)
+}
+
+// Providers mocks base method.
+func (m *MockUserIdentityUsecase) Providers(arg0 context.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Providers", arg0)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Providers indicates an expected call of Providers.
+func (mr *MockUserIdentityUsecaseMockRecorder) Providers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Providers", reflect.TypeOf((*MockUserIdentityUsecase)(nil).Providers), arg0)
+}
+
+// StartLink mocks base method.
+func (m *MockUserIdentityUsecase) StartLink(arg0 context.Context, arg1 *model.LinkUserIdentityPayload) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLink", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLink indicates an expected call of StartLink.
+func (mr *MockUserIdentityUsecaseMockRecorder) StartLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLink", reflect.TypeOf((*MockUserIdentityUsecase)(nil).StartLink), arg0, arg1)
+}
+
+// StartLogin mocks base method.
+func (m *MockUserIdentityUsecase) StartLogin(arg0 context.Context, arg1 *model.StartFederatedLoginPayload) (*model.StartFederatedLoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLogin", arg0, arg1)
+	ret0, _ := ret[0].(*model.StartFederatedLoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLogin indicates an expected call of StartLogin.
+func (mr *MockUserIdentityUsecaseMockRecorder) StartLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLogin", reflect.TypeOf((*MockUserIdentityUsecase)(nil).StartLogin), arg0, arg1)
+}
+
+// Unlink mocks base method.
+func (m *MockUserIdentityUsecase) Unlink(arg0 context.Context, arg1 *model.UnlinkUserIdentityPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlink", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink.
+func (mr *MockUserIdentityUsecaseMockRecorder) Unlink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockUserIdentityUsecase)(nil).Unlink), arg0, arg1)
+}
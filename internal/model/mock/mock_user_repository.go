@@ -65,6 +65,21 @@ func (mr *MockUserRepositoryMockRecorder) DeleteByID(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockUserRepository)(nil).DeleteByID), arg0, arg1)
 }
 
+// FindAll mocks base method.
+func (m *MockUserRepository) FindAll(arg0 context.Context, arg1 *model.UserFilter) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockUserRepositoryMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), arg0, arg1)
+}
+
 // FindByEmail mocks base method.
 func (m *MockUserRepository) FindByEmail(arg0 context.Context, arg1 string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -138,17 +153,59 @@ func (mr *MockUserRepositoryMockRecorder) InjectRedisClient(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectRedisClient", reflect.TypeOf((*MockUserRepository)(nil).InjectRedisClient), arg0)
 }
 
-// UpdateByID mocks base method.
-func (m *MockUserRepository) UpdateByID(arg0 context.Context, arg1 string) (*model.User, error) {
+// MarkEmailVerified mocks base method.
+func (m *MockUserRepository) MarkEmailVerified(arg0 context.Context, arg1 *model.User) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateByID", arg0, arg1)
-	ret0, _ := ret[0].(*model.User)
+	ret := m.ctrl.Call(m, "MarkEmailVerified", arg0, arg1)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockUserRepositoryMockRecorder) MarkEmailVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockUserRepository)(nil).MarkEmailVerified), arg0, arg1)
+}
+
+// ResetEmailVerification mocks base method.
+func (m *MockUserRepository) ResetEmailVerification(arg0 context.Context, arg1 *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetEmailVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetEmailVerification indicates an expected call of ResetEmailVerification.
+func (mr *MockUserRepositoryMockRecorder) ResetEmailVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetEmailVerification", reflect.TypeOf((*MockUserRepository)(nil).ResetEmailVerification), arg0, arg1)
+}
+
+// UpdateByID mocks base method.
+func (m *MockUserRepository) UpdateByID(arg0 context.Context, arg1 *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
 // UpdateByID indicates an expected call of UpdateByID.
 func (mr *MockUserRepositoryMockRecorder) UpdateByID(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockUserRepository)(nil).UpdateByID), arg0, arg1)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockUserRepository) UpdateStatus(arg0 context.Context, arg1 *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockUserRepositoryMockRecorder) UpdateStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUserRepository)(nil).UpdateStatus), arg0, arg1)
+}
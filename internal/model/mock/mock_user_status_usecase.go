@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/krobus00/auth-service/internal/model (interfaces: UserStatusUsecase)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/krobus00/auth-service/internal/model"
+)
+
+// MockUserStatusUsecase is a mock of UserStatusUsecase interface.
+type MockUserStatusUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserStatusUsecaseMockRecorder
+}
+
+// MockUserStatusUsecaseMockRecorder is the mock recorder for MockUserStatusUsecase.
+type MockUserStatusUsecaseMockRecorder struct {
+	mock *MockUserStatusUsecase
+}
+
+// NewMockUserStatusUsecase creates a new mock instance.
+func NewMockUserStatusUsecase(ctrl *gomock.Controller) *MockUserStatusUsecase {
+	mock := &MockUserStatusUsecase{ctrl: ctrl}
+	mock.recorder = &MockUserStatusUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserStatusUsecase) EXPECT() *MockUserStatusUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ChangeStatus mocks base method.
+func (m *MockUserStatusUsecase) ChangeStatus(arg0 context.Context, arg1 *model.ChangeUserStatusPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockUserStatusUsecaseMockRecorder) ChangeStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockUserStatusUsecase)(nil).ChangeStatus), arg0, arg1)
+}
+
+// InjectAuthUsecase mocks base method.
+func (m *MockUserStatusUsecase) InjectAuthUsecase(arg0 model.AuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthUsecase indicates an expected call of InjectAuthUsecase.
+func (mr *MockUserStatusUsecaseMockRecorder) InjectAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthUsecase", reflect.TypeOf((*MockUserStatusUsecase)(nil).InjectAuthUsecase), arg0)
+}
+
+// InjectPersonalAccessTokenRepo mocks base method.
+func (m *MockUserStatusUsecase) InjectPersonalAccessTokenRepo(arg0 model.PersonalAccessTokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPersonalAccessTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPersonalAccessTokenRepo indicates an expected call of InjectPersonalAccessTokenRepo.
+func (mr *MockUserStatusUsecaseMockRecorder) InjectPersonalAccessTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPersonalAccessTokenRepo", reflect.TypeOf((*MockUserStatusUsecase)(nil).InjectPersonalAccessTokenRepo), arg0)
+}
+
+// InjectSecurityEventRepo mocks base method.
+func (m *MockUserStatusUsecase) InjectSecurityEventRepo(arg0 model.SecurityEventRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectSecurityEventRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectSecurityEventRepo indicates an expected call of InjectSecurityEventRepo.
+func (mr *MockUserStatusUsecaseMockRecorder) InjectSecurityEventRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSecurityEventRepo", reflect.TypeOf((*MockUserStatusUsecase)(nil).InjectSecurityEventRepo), arg0)
+}
+
+// InjectTokenRepo mocks base method.
+func (m *MockUserStatusUsecase) InjectTokenRepo(arg0 model.TokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectTokenRepo indicates an expected call of InjectTokenRepo.
+func (mr *MockUserStatusUsecaseMockRecorder) InjectTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectTokenRepo", reflect.TypeOf((*MockUserStatusUsecase)(nil).InjectTokenRepo), arg0)
+}
+
+// InjectUserRepo mocks base method.
+func (m *MockUserStatusUsecase) InjectUserRepo(arg0 model.UserRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectUserRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectUserRepo indicates an expected call of InjectUserRepo.
+func (mr *MockUserStatusUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockUserStatusUsecase)(nil).InjectUserRepo), arg0)
+}
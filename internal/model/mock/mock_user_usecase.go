@@ -36,6 +36,35 @@ func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Authenticate mocks base method.
+func (m *MockUserUsecase) Authenticate(arg0 context.Context, arg1 *model.UserLoginPayload) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockUserUsecaseMockRecorder) Authenticate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUserUsecase)(nil).Authenticate), arg0, arg1)
+}
+
+// ChangePassword mocks base method.
+func (m *MockUserUsecase) ChangePassword(arg0 context.Context, arg1 *model.ChangePasswordPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserUsecaseMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUsecase)(nil).ChangePassword), arg0, arg1)
+}
+
 // GetUserInfo mocks base method.
 func (m *MockUserUsecase) GetUserInfo(arg0 context.Context, arg1 *model.GetUserInfoPayload) (*model.UserInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +80,38 @@ func (mr *MockUserUsecaseMockRecorder) GetUserInfo(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockUserUsecase)(nil).GetUserInfo), arg0, arg1)
 }
 
+// InjectAuthUsecase mocks base method.
+func (m *MockUserUsecase) InjectAuthUsecase(arg0 model.AuthUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectAuthUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthUsecase indicates an expected call of InjectAuthUsecase.
+func (mr *MockUserUsecaseMockRecorder) InjectAuthUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthUsecase", reflect.TypeOf((*MockUserUsecase)(nil).InjectAuthUsecase), arg0)
+}
+
+// InjectAuthenticators mocks base method.
+func (m *MockUserUsecase) InjectAuthenticators(arg0 ...model.Authenticator) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InjectAuthenticators", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectAuthenticators indicates an expected call of InjectAuthenticators.
+func (mr *MockUserUsecaseMockRecorder) InjectAuthenticators(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectAuthenticators", reflect.TypeOf((*MockUserUsecase)(nil).InjectAuthenticators), arg0...)
+}
+
 // InjectDB mocks base method.
 func (m *MockUserUsecase) InjectDB(arg0 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -65,6 +126,20 @@ func (mr *MockUserUsecaseMockRecorder) InjectDB(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectDB", reflect.TypeOf((*MockUserUsecase)(nil).InjectDB), arg0)
 }
 
+// InjectEmailVerificationUsecase mocks base method.
+func (m *MockUserUsecase) InjectEmailVerificationUsecase(arg0 model.EmailVerificationUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectEmailVerificationUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectEmailVerificationUsecase indicates an expected call of InjectEmailVerificationUsecase.
+func (mr *MockUserUsecaseMockRecorder) InjectEmailVerificationUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectEmailVerificationUsecase", reflect.TypeOf((*MockUserUsecase)(nil).InjectEmailVerificationUsecase), arg0)
+}
+
 // InjectGroupRepo mocks base method.
 func (m *MockUserUsecase) InjectGroupRepo(arg0 model.GroupRepository) error {
 	m.ctrl.T.Helper()
@@ -79,6 +154,76 @@ func (mr *MockUserUsecaseMockRecorder) InjectGroupRepo(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectGroupRepo", reflect.TypeOf((*MockUserUsecase)(nil).InjectGroupRepo), arg0)
 }
 
+// InjectLoginAttemptUsecase mocks base method.
+func (m *MockUserUsecase) InjectLoginAttemptUsecase(arg0 model.LoginAttemptUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectLoginAttemptUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectLoginAttemptUsecase indicates an expected call of InjectLoginAttemptUsecase.
+func (mr *MockUserUsecaseMockRecorder) InjectLoginAttemptUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectLoginAttemptUsecase", reflect.TypeOf((*MockUserUsecase)(nil).InjectLoginAttemptUsecase), arg0)
+}
+
+// InjectMFAUsecase mocks base method.
+func (m *MockUserUsecase) InjectMFAUsecase(arg0 model.MFAUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectMFAUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectMFAUsecase indicates an expected call of InjectMFAUsecase.
+func (mr *MockUserUsecaseMockRecorder) InjectMFAUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectMFAUsecase", reflect.TypeOf((*MockUserUsecase)(nil).InjectMFAUsecase), arg0)
+}
+
+// InjectPasswordPolicyUsecase mocks base method.
+func (m *MockUserUsecase) InjectPasswordPolicyUsecase(arg0 model.PasswordPolicyUsecase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPasswordPolicyUsecase", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPasswordPolicyUsecase indicates an expected call of InjectPasswordPolicyUsecase.
+func (mr *MockUserUsecaseMockRecorder) InjectPasswordPolicyUsecase(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPasswordPolicyUsecase", reflect.TypeOf((*MockUserUsecase)(nil).InjectPasswordPolicyUsecase), arg0)
+}
+
+// InjectPersonalAccessTokenRepo mocks base method.
+func (m *MockUserUsecase) InjectPersonalAccessTokenRepo(arg0 model.PersonalAccessTokenRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectPersonalAccessTokenRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectPersonalAccessTokenRepo indicates an expected call of InjectPersonalAccessTokenRepo.
+func (mr *MockUserUsecaseMockRecorder) InjectPersonalAccessTokenRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectPersonalAccessTokenRepo", reflect.TypeOf((*MockUserUsecase)(nil).InjectPersonalAccessTokenRepo), arg0)
+}
+
+// InjectSecurityEventRepo mocks base method.
+func (m *MockUserUsecase) InjectSecurityEventRepo(arg0 model.SecurityEventRepository) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectSecurityEventRepo", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InjectSecurityEventRepo indicates an expected call of InjectSecurityEventRepo.
+func (mr *MockUserUsecaseMockRecorder) InjectSecurityEventRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSecurityEventRepo", reflect.TypeOf((*MockUserUsecase)(nil).InjectSecurityEventRepo), arg0)
+}
+
 // InjectTokenRepo mocks base method.
 func (m *MockUserUsecase) InjectTokenRepo(arg0 model.TokenRepository) error {
 	m.ctrl.T.Helper()
@@ -121,6 +266,36 @@ func (mr *MockUserUsecaseMockRecorder) InjectUserRepo(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectUserRepo", reflect.TypeOf((*MockUserUsecase)(nil).InjectUserRepo), arg0)
 }
 
+// IssueToken mocks base method.
+func (m *MockUserUsecase) IssueToken(arg0 context.Context, arg1 string) (*model.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueToken", arg0, arg1)
+	ret0, _ := ret[0].(*model.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueToken indicates an expected call of IssueToken.
+func (mr *MockUserUsecaseMockRecorder) IssueToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockUserUsecase)(nil).IssueToken), arg0, arg1)
+}
+
+// ListUsers mocks base method.
+func (m *MockUserUsecase) ListUsers(arg0 context.Context, arg1 *model.ListUsersPayload) (*model.ListUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserUsecaseMockRecorder) ListUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserUsecase)(nil).ListUsers), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockUserUsecase) Login(arg0 context.Context, arg1 *model.UserLoginPayload) (*model.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +325,21 @@ func (mr *MockUserUsecaseMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserUsecase)(nil).Logout), arg0, arg1)
 }
 
+// ProvisionUser mocks base method.
+func (m *MockUserUsecase) ProvisionUser(arg0 context.Context, arg1 *model.ProvisionUserPayload) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionUser", arg0, arg1)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionUser indicates an expected call of ProvisionUser.
+func (mr *MockUserUsecaseMockRecorder) ProvisionUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionUser", reflect.TypeOf((*MockUserUsecase)(nil).ProvisionUser), arg0, arg1)
+}
+
 // RefreshToken mocks base method.
 func (m *MockUserUsecase) RefreshToken(arg0 context.Context, arg1 *model.RefreshTokenPayload) (*model.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -179,3 +369,33 @@ func (mr *MockUserUsecaseMockRecorder) Register(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUserUsecase)(nil).Register), arg0, arg1)
 }
+
+// UpdateProfile mocks base method.
+func (m *MockUserUsecase) UpdateProfile(arg0 context.Context, arg1 *model.UpdateProfilePayload) (*model.UserInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", arg0, arg1)
+	ret0, _ := ret[0].(*model.UserInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserUsecaseMockRecorder) UpdateProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserUsecase)(nil).UpdateProfile), arg0, arg1)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserUsecase) UpdateUser(arg0 context.Context, arg1 *model.UpdateUserPayload) (*model.UserInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1)
+	ret0, _ := ret[0].(*model.UserInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserUsecaseMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserUsecase)(nil).UpdateUser), arg0, arg1)
+}
@@ -0,0 +1,244 @@
+//go:generate mockgen -destination=mock/mock_oauth_client_repository.go -package=mock github.com/krobus00/auth-service/internal/model OAuthClientRepository
+//go:generate mockgen -destination=mock/mock_authorization_code_repository.go -package=mock github.com/krobus00/auth-service/internal/model AuthorizationCodeRepository
+//go:generate mockgen -destination=mock/mock_oauth_usecase.go -package=mock github.com/krobus00/auth-service/internal/model OAuthUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+const (
+	OAuthClientIDPrefix     = "oc_"
+	OAuthClientSecretPrefix = "ocs_"
+
+	OAuthResponseTypeCode = "code"
+
+	OAuthGrantTypeAuthorizationCode = "authorization_code"
+	OAuthGrantTypeRefreshToken      = "refresh_token"
+
+	OAuthCodeChallengeMethodS256 = "S256"
+
+	OAuthTokenTypeBearer = "Bearer"
+)
+
+var (
+	ErrOAuthClientNotFound          = errors.New("oauth client not found")
+	ErrOAuthInvalidRequest          = errors.New("invalid request")
+	ErrOAuthInvalidClient           = errors.New("invalid client")
+	ErrOAuthInvalidRedirectURI      = errors.New("invalid redirect uri")
+	ErrOAuthInvalidGrant            = errors.New("invalid grant")
+	ErrOAuthUnsupportedGrantType    = errors.New("unsupported grant type")
+	ErrOAuthUnsupportedResponseType = errors.New("unsupported response type")
+	ErrOAuthInvalidScope            = errors.New("invalid scope")
+	ErrOAuthFormExpired             = errors.New("sign in form expired, try again")
+)
+
+// OAuthClient is an application allowed to request tokens on behalf of users.
+// Public clients (browser and mobile apps) have no secret and must use PKCE.
+type OAuthClient struct {
+	ID               string
+	ClientID         string
+	Name             string
+	ClientSecretHash string
+	RedirectURIs     pq.StringArray `gorm:"type:text[]"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (OAuthClient) TableName() string {
+	return "oauth_clients"
+}
+
+func (m *OAuthClient) IsPublic() bool {
+	return m.ClientSecretHash == ""
+}
+
+// HasRedirectURI report whether the redirect uri exactly match one of the registered uris.
+func (m *OAuthClient) HasRedirectURI(redirectURI string) bool {
+	for _, registered := range m.RedirectURIs {
+		if registered == redirectURI {
+			return true
+		}
+	}
+	return false
+}
+
+func NewOAuthClientCacheKeyByClientID(clientID string) string {
+	return fmt.Sprintf("oauth-clients:clientID:%s", clientID)
+}
+
+// AuthorizationCode is the short lived grant issued by /authorize and redeemed once at /token.
+type AuthorizationCode struct {
+	ClientID            string
+	UserID              string
+	RedirectURI         string
+	Scope               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+}
+
+func NewAuthorizationCodeCacheKey(codeHash string) string {
+	return fmt.Sprintf("oauth-authorization-codes:%s", codeHash)
+}
+
+func (m *OAuthClient) ToGRPCResponse() *pb.OAuthClient {
+	return &pb.OAuthClient{
+		Id:           m.ID,
+		ClientId:     m.ClientID,
+		Name:         m.Name,
+		RedirectUris: m.RedirectURIs,
+		Public:       m.IsPublic(),
+		CreatedAt:    m.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:    m.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// CreatedOAuthClient carry the plain client secret, it is only available right after creation.
+type CreatedOAuthClient struct {
+	*OAuthClient
+	ClientSecret string
+}
+
+func (m *CreatedOAuthClient) ToGRPCResponse() *pb.CreateOAuthClientResponse {
+	return &pb.CreateOAuthClientResponse{
+		OauthClient:  m.OAuthClient.ToGRPCResponse(),
+		ClientSecret: m.ClientSecret,
+	}
+}
+
+type CreateOAuthClientPayload struct {
+	Name         string
+	RedirectURIs []string
+	Public       bool
+}
+
+func (m *CreateOAuthClientPayload) ParseFromProto(req *pb.CreateOAuthClientRequest) {
+	m.Name = strings.TrimSpace(req.GetName())
+	m.RedirectURIs = req.GetRedirectUris()
+	m.Public = req.GetPublic()
+}
+
+type DeleteOAuthClientPayload struct {
+	ClientID string
+}
+
+func (m *DeleteOAuthClientPayload) ParseFromProto(req *pb.DeleteOAuthClientRequest) {
+	m.ClientID = req.GetClientId()
+}
+
+type AuthorizePayload struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+	Username            string
+	Password            string
+	// MFACode is only required from users with mfa enabled.
+	MFACode string
+}
+
+type AuthorizeResponse struct {
+	RedirectURI string
+	Code        string
+	State       string
+}
+
+// RedirectURL build the client callback carrying the authorization code.
+func (m *AuthorizeResponse) RedirectURL() string {
+	return BuildOAuthRedirectURL(m.RedirectURI, url.Values{
+		"code":  {m.Code},
+		"state": {m.State},
+	})
+}
+
+// BuildOAuthRedirectURL append the query parameters to the redirect uri, dropping empty values.
+func BuildOAuthRedirectURL(redirectURI string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	query := u.Query()
+	for key, values := range params {
+		if len(values) == 0 || values[0] == "" {
+			continue
+		}
+		query.Set(key, values[0])
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+type OAuthTokenPayload struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+}
+
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+}
+
+type OAuthClientRepository interface {
+	Create(ctx context.Context, client *OAuthClient) error
+	FindByClientID(ctx context.Context, clientID string) (*OAuthClient, error)
+	Delete(ctx context.Context, client *OAuthClient) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type AuthorizationCodeRepository interface {
+	Create(ctx context.Context, code string, data *AuthorizationCode, expiration time.Duration) error
+	// Consume atomically read and delete the code so it can be redeemed only once.
+	Consume(ctx context.Context, code string) (*AuthorizationCode, error)
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type OAuthUsecase interface {
+	ValidateAuthorizeRequest(ctx context.Context, payload *AuthorizePayload) (*OAuthClient, error)
+	Authorize(ctx context.Context, payload *AuthorizePayload) (*AuthorizeResponse, error)
+	// AuthorizeUser issue the authorization code for a user already authenticated by other means.
+	AuthorizeUser(ctx context.Context, payload *AuthorizePayload, userID string) (*AuthorizeResponse, error)
+	Token(ctx context.Context, payload *OAuthTokenPayload) (*OAuthTokenResponse, error)
+	UserInfo(ctx context.Context, accessToken string) (*UserInfoClaims, error)
+	OpenIDConfiguration(ctx context.Context) *OpenIDConfiguration
+
+	CreateClient(ctx context.Context, payload *CreateOAuthClientPayload) (*CreatedOAuthClient, error)
+	DeleteClient(ctx context.Context, payload *DeleteOAuthClientPayload) error
+
+	// DI
+	InjectAuthUsecase(usecase AuthUsecase) error
+	InjectUserUsecase(usecase UserUsecase) error
+	InjectMFAUsecase(usecase MFAUsecase) error
+	InjectOAuthClientRepo(repo OAuthClientRepository) error
+	InjectAuthorizationCodeRepo(repo AuthorizationCodeRepository) error
+	InjectTokenRepo(repo TokenRepository) error
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	OIDCScopeOpenID  = "openid"
+	OIDCScopeProfile = "profile"
+	OIDCScopeEmail   = "email"
+)
+
+var (
+	ErrOAuthInvalidToken      = errors.New("invalid access token")
+	ErrOAuthInsufficientScope = errors.New("insufficient scope")
+)
+
+// HasScope report whether the space separated scope contain the wanted scope.
+func HasScope(scope string, want string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+// UserInfoClaims is the standard claims about the user released for the granted scope.
+type UserInfoClaims struct {
+	Subject           string `json:"sub"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+}
+
+// NewUserInfoClaims release the profile claims with the profile scope and the email with the email scope.
+func NewUserInfoClaims(user *UserInfoResponse, scope string) *UserInfoClaims {
+	claims := &UserInfoClaims{
+		Subject: user.ID,
+	}
+	if HasScope(scope, OIDCScopeProfile) {
+		claims.Name = user.FullName
+		claims.PreferredUsername = user.Username
+	}
+	if HasScope(scope, OIDCScopeEmail) {
+		claims.Email = user.Email
+	}
+	return claims
+}
+
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+}
+
+// OpenIDConfiguration is the provider metadata served at /.well-known/openid-configuration.
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
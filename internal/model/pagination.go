@@ -0,0 +1,62 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/goccy/go-json"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// PageCursor is the position of the last item of a page, Key is the value of the sort
+// column and ID break the ties between equal keys.
+type PageCursor struct {
+	Key string `json:"k"`
+	ID  string `json:"id"`
+}
+
+// PageSize clamp the requested page size, zero or less use the default.
+func PageSize(size int) int {
+	switch {
+	case size <= 0:
+		return DefaultPageSize
+	case size > MaxPageSize:
+		return MaxPageSize
+	default:
+		return size
+	}
+}
+
+// EncodePageToken turn the cursor into the opaque token handed to the client.
+func EncodePageToken(cursor *PageCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken read back a token made by EncodePageToken, an empty token is the first page.
+func DecodePageToken(token string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	cursor := new(PageCursor)
+	err = json.Unmarshal(data, cursor)
+	if err != nil || cursor.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
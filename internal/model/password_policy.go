@@ -0,0 +1,95 @@
+//go:generate mockgen -destination=mock/mock_password_history_repository.go -package=mock github.com/krobus00/auth-service/internal/model PasswordHistoryRepository
+//go:generate mockgen -destination=mock/mock_password_policy_usecase.go -package=mock github.com/krobus00/auth-service/internal/model PasswordPolicyUsecase
+//go:generate mockgen -destination=mock/mock_breached_password_list.go -package=mock github.com/krobus00/auth-service/internal/model BreachedPasswordList
+
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"gorm.io/gorm"
+)
+
+type PasswordPolicyRule string
+
+const (
+	PasswordRuleMinLength         PasswordPolicyRule = "MIN_LENGTH"
+	PasswordRuleUppercase         PasswordPolicyRule = "UPPERCASE"
+	PasswordRuleLowercase         PasswordPolicyRule = "LOWERCASE"
+	PasswordRuleDigit             PasswordPolicyRule = "DIGIT"
+	PasswordRuleSymbol            PasswordPolicyRule = "SYMBOL"
+	PasswordRuleBreached          PasswordPolicyRule = "BREACHED"
+	PasswordRuleSimilarToIdentity PasswordPolicyRule = "SIMILAR_TO_IDENTITY"
+	PasswordRuleReused            PasswordPolicyRule = "REUSED"
+)
+
+type PasswordPolicyViolation struct {
+	Rule        PasswordPolicyRule
+	Description string
+}
+
+// PasswordPolicyError list every rule a password failed, not only the first one.
+type PasswordPolicyError struct {
+	Violations []*PasswordPolicyViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		descriptions = append(descriptions, violation.Description)
+	}
+	return "password doesn't meet the policy: " + strings.Join(descriptions, ", ")
+}
+
+func (e *PasswordPolicyError) ToProto() *pb.PasswordPolicyError {
+	res := &pb.PasswordPolicyError{
+		Violations: make([]*pb.PasswordPolicyViolation, 0, len(e.Violations)),
+	}
+	for _, violation := range e.Violations {
+		res.Violations = append(res.Violations, &pb.PasswordPolicyViolation{
+			Rule:        string(violation.Rule),
+			Description: violation.Description,
+		})
+	}
+	return res
+}
+
+// PasswordHistory keep the hash of every password a user set, so recent ones can't be reused.
+type PasswordHistory struct {
+	ID        string
+	UserID    string
+	Password  string
+	CreatedAt time.Time
+}
+
+func (PasswordHistory) TableName() string {
+	return "password_history"
+}
+
+type BreachedPasswordList interface {
+	Contains(password string) bool
+}
+
+type PasswordHistoryRepository interface {
+	Create(ctx context.Context, history *PasswordHistory) error
+	// FindLastByUserID return the latest limit entries of the user, newest first.
+	FindLastByUserID(ctx context.Context, userID string, limit int) ([]*PasswordHistory, error)
+
+	// DI
+	InjectDB(db *gorm.DB) error
+}
+
+type PasswordPolicyUsecase interface {
+	// Validate check password against every rule and return a *PasswordPolicyError listing the
+	// failed ones. A user being registered has no ID yet and skip the reuse check.
+	Validate(ctx context.Context, user *User, password string) error
+	// RecordPassword remember a newly set password hash for the reuse check.
+	RecordPassword(ctx context.Context, userID string, hashedPassword string) error
+
+	// DI
+	InjectPasswordHistoryRepo(repo PasswordHistoryRepository) error
+	InjectBreachedPasswordList(list BreachedPasswordList) error
+}
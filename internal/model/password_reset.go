@@ -0,0 +1,80 @@
+//go:generate mockgen -destination=mock/mock_password_reset_repository.go -package=mock github.com/krobus00/auth-service/internal/model PasswordResetRepository
+//go:generate mockgen -destination=mock/mock_password_reset_usecase.go -package=mock github.com/krobus00/auth-service/internal/model PasswordResetUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+)
+
+const PasswordResetTokenPrefix = "pwr_"
+
+var (
+	ErrPasswordRequired          = errors.New("password is required")
+	ErrPasswordResetTokenInvalid = errors.New("invalid or expired password reset token")
+)
+
+// PasswordReset is kept for a pending reset token. PasswordFingerprint is the hash of the
+// password hash at request time, so any password change void the outstanding tokens.
+type PasswordReset struct {
+	UserID              string
+	PasswordFingerprint string
+}
+
+func NewPasswordResetCacheKey(tokenHash string) string {
+	return fmt.Sprintf("password-resets:%s", tokenHash)
+}
+
+// Usecase payload
+
+type RequestPasswordResetPayload struct {
+	Email string
+}
+
+func (m *RequestPasswordResetPayload) ParseFromProto(req *pb.RequestPasswordResetRequest) {
+	m.Email = strings.TrimSpace(req.GetEmail())
+}
+
+type ResetPasswordPayload struct {
+	Token       string
+	NewPassword string
+}
+
+func (m *ResetPasswordPayload) ParseFromProto(req *pb.ResetPasswordRequest) {
+	m.Token = strings.TrimSpace(req.GetToken())
+	m.NewPassword = req.GetNewPassword()
+}
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token string, data *PasswordReset, expiration time.Duration) error
+	// Find return the reset without using the token up.
+	Find(ctx context.Context, token string) (*PasswordReset, error)
+	// Consume return and delete the reset, so a token can be used only once.
+	Consume(ctx context.Context, token string) (*PasswordReset, error)
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type PasswordResetUsecase interface {
+	// RequestPasswordReset mail a reset link when the email belong to a local account, the
+	// answer is the same either way so it can't be used to find registered emails.
+	RequestPasswordReset(ctx context.Context, payload *RequestPasswordResetPayload) error
+	ResetPassword(ctx context.Context, payload *ResetPasswordPayload) error
+
+	// DI
+	InjectUserRepo(repo UserRepository) error
+	InjectTokenRepo(repo TokenRepository) error
+	InjectPersonalAccessTokenRepo(repo PersonalAccessTokenRepository) error
+	InjectPasswordResetRepo(repo PasswordResetRepository) error
+	InjectSecurityEventRepo(repo SecurityEventRepository) error
+	InjectMailer(mailer Mailer) error
+	InjectPasswordPolicyUsecase(usecase PasswordPolicyUsecase) error
+}
@@ -85,10 +85,48 @@ func (m *DeletePermissionByIDPayload) ParseFromProto(req *pb.DeletePermissionReq
 	m.ID = req.GetId()
 }
 
+// PermissionFilter select a page of permissions sorted by name then id.
+type PermissionFilter struct {
+	NamePrefix string
+	// After is the last permission of the previous page, nil start from the first permission.
+	After *PageCursor
+	Limit int
+}
+
+type ListPermissionsPayload struct {
+	NamePrefix string
+	PageSize   int
+	PageToken  string
+}
+
+func (m *ListPermissionsPayload) ParseFromProto(req *pb.ListPermissionsRequest) {
+	m.NamePrefix = req.GetNamePrefix()
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+}
+
+type ListPermissionsResponse struct {
+	Permissions   []*Permission
+	NextPageToken string
+}
+
+func (m *ListPermissionsResponse) ToGRPCResponse() *pb.ListPermissionsResponse {
+	permissions := make([]*pb.Permission, 0, len(m.Permissions))
+	for _, permission := range m.Permissions {
+		permissions = append(permissions, permission.ToGRPCResponse())
+	}
+	return &pb.ListPermissionsResponse{
+		Permissions:   permissions,
+		NextPageToken: m.NextPageToken,
+	}
+}
+
 type PermissionRepository interface {
 	Create(ctx context.Context, permission *Permission) error
 	FindByID(ctx context.Context, id string) (*Permission, error)
 	FindByName(ctx context.Context, name string) (*Permission, error)
+	// FindAll return up to filter.Limit permissions after the cursor of the filter.
+	FindAll(ctx context.Context, filter *PermissionFilter) ([]*Permission, error)
 	Update(ctx context.Context, permission *Permission) error
 	DeleteByID(ctx context.Context, id string) error
 
@@ -101,6 +139,7 @@ type PermissionUsecase interface {
 	Create(ctx context.Context, payload *CreatePermissionPayload) (*Permission, error)
 	FindByID(ctx context.Context, payload *FindPermissionByIDPayload) (*Permission, error)
 	FindByName(ctx context.Context, payload *FindPermissionByNamePayload) (*Permission, error)
+	List(ctx context.Context, payload *ListPermissionsPayload) (*ListPermissionsResponse, error)
 	Update(ctx context.Context, payload *UpdatePermissionPayload) (*Permission, error)
 	DeleteByID(ctx context.Context, payload *DeletePermissionByIDPayload) error
 
@@ -0,0 +1,133 @@
+//go:generate mockgen -destination=mock/mock_personal_access_token_repository.go -package=mock github.com/krobus00/auth-service/internal/model PersonalAccessTokenRepository
+//go:generate mockgen -destination=mock/mock_personal_access_token_usecase.go -package=mock github.com/krobus00/auth-service/internal/model PersonalAccessTokenUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+const PersonalAccessTokenPrefix = "pat_"
+
+var (
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	ErrInvalidPersonalAccessToken  = errors.New("invalid personal access token")
+)
+
+type PersonalAccessToken struct {
+	ID        string
+	UserID    string
+	Name      string
+	TokenHash string
+	Scopes    pq.StringArray `gorm:"type:text[]"`
+	ExpiredAt time.Time
+	CreatedAt time.Time
+}
+
+func (PersonalAccessToken) TableName() string {
+	return "personal_access_tokens"
+}
+
+type PersonalAccessTokens []*PersonalAccessToken
+
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+func NewPersonalAccessTokenCacheKeyByTokenHash(tokenHash string) string {
+	return fmt.Sprintf("personal-access-token:hash:%s", tokenHash)
+}
+
+func (m *PersonalAccessToken) ToGRPCResponse() *pb.PersonalAccessToken {
+	return &pb.PersonalAccessToken{
+		Id:        m.ID,
+		Name:      m.Name,
+		Scopes:    m.Scopes,
+		ExpiredAt: m.ExpiredAt.UTC().Format(time.RFC3339Nano),
+		CreatedAt: m.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func (m PersonalAccessTokens) ToGRPCResponse() *pb.ListPersonalAccessTokensResponse {
+	res := make([]*pb.PersonalAccessToken, 0)
+	for _, token := range m {
+		res = append(res, token.ToGRPCResponse())
+	}
+	return &pb.ListPersonalAccessTokensResponse{
+		PersonalAccessTokens: res,
+	}
+}
+
+// CreatedPersonalAccessToken carry the plain token, it is only available right after creation.
+type CreatedPersonalAccessToken struct {
+	*PersonalAccessToken
+	Token string
+}
+
+func (m *CreatedPersonalAccessToken) ToGRPCResponse() *pb.CreatePersonalAccessTokenResponse {
+	return &pb.CreatePersonalAccessTokenResponse{
+		PersonalAccessToken: m.PersonalAccessToken.ToGRPCResponse(),
+		Token:               m.Token,
+	}
+}
+
+type CreatePersonalAccessTokenPayload struct {
+	Name      string
+	Scopes    []string
+	ExpiredAt time.Time
+}
+
+func (m *CreatePersonalAccessTokenPayload) ParseFromProto(req *pb.CreatePersonalAccessTokenRequest) error {
+	m.Name = strings.TrimSpace(req.GetName())
+	m.Scopes = req.GetScopes()
+	if req.GetExpiredAt() == "" {
+		return nil
+	}
+	expiredAt, err := time.Parse(time.RFC3339, req.GetExpiredAt())
+	if err != nil {
+		return ErrInvalidPersonalAccessToken
+	}
+	m.ExpiredAt = expiredAt
+	return nil
+}
+
+type RevokePersonalAccessTokenPayload struct {
+	ID string
+}
+
+func (m *RevokePersonalAccessTokenPayload) ParseFromProto(req *pb.RevokePersonalAccessTokenRequest) {
+	m.ID = req.GetId()
+}
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *PersonalAccessToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+	FindByUserID(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+	FindByUserIDAndID(ctx context.Context, userID string, id string) (*PersonalAccessToken, error)
+	Delete(ctx context.Context, token *PersonalAccessToken) error
+	// DeleteByUserID revoke every personal access token of the user.
+	DeleteByUserID(ctx context.Context, userID string) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type PersonalAccessTokenUsecase interface {
+	Create(ctx context.Context, payload *CreatePersonalAccessTokenPayload) (*CreatedPersonalAccessToken, error)
+	FindByCurrentUser(ctx context.Context) (PersonalAccessTokens, error)
+	Revoke(ctx context.Context, payload *RevokePersonalAccessTokenPayload) error
+
+	// DI
+	InjectAuthUsecase(usecase AuthUsecase) error
+	InjectPersonalAccessTokenRepo(repo PersonalAccessTokenRepository) error
+}
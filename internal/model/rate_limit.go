@@ -0,0 +1,76 @@
+//go:generate mockgen -destination=mock/mock_rate_limit_repository.go -package=mock github.com/krobus00/auth-service/internal/model RateLimitRepository
+//go:generate mockgen -destination=mock/mock_rate_limit_usecase.go -package=mock github.com/krobus00/auth-service/internal/model RateLimitUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+var (
+	ErrRateLimited = errors.New("too many requests, retry later")
+)
+
+type RateLimitKey string
+
+const (
+	RateLimitKeyIP     RateLimitKey = "ip"
+	RateLimitKeyUser   RateLimitKey = "user"
+	RateLimitKeyClient RateLimitKey = "client"
+
+	// RateLimitAnyMethod is the method of a rule counting every rpc.
+	RateLimitAnyMethod = "*"
+)
+
+// RateLimit is a token bucket holding up to Burst requests, refilled with Requests every Period.
+type RateLimit struct {
+	Requests int64
+	Period   time.Duration
+	Burst    int64
+}
+
+// RatePerSecond is how many requests are refilled every second.
+func (m *RateLimit) RatePerSecond() float64 {
+	return float64(m.Requests) / m.Period.Seconds()
+}
+
+// RateLimitRule apply a RateLimit to every call of an rpc, with one bucket per Key.
+type RateLimitRule struct {
+	Method string
+	Key    RateLimitKey
+	RateLimit
+}
+
+type RateLimitResult struct {
+	Allowed bool
+	// RetryAfter is how long until the next request is allowed, zero when Allowed.
+	RetryAfter time.Duration
+}
+
+func NewRateLimitCacheKey(bucket string) string {
+	return fmt.Sprintf("rate-limits:%s", bucket)
+}
+
+type RateLimitRepository interface {
+	// Take remove one request from the bucket, the bucket start full.
+	Take(ctx context.Context, bucket string, limit *RateLimit) (*RateLimitResult, error)
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type RateLimitUsecase interface {
+	// AllowAddress take one request from the address buckets of method, it is checked before the caller is
+	// authenticated. Methods without a rule are always allowed.
+	AllowAddress(ctx context.Context, method string) (*RateLimitResult, error)
+	// Allow take one request from the user and client buckets of the caller for method, methods without a rule are always allowed.
+	Allow(ctx context.Context, method string) (*RateLimitResult, error)
+
+	// DI
+	InjectRateLimitRepo(repo RateLimitRepository) error
+}
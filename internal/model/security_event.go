@@ -0,0 +1,44 @@
+//go:generate mockgen -destination=mock/mock_security_event_repository.go -package=mock github.com/krobus00/auth-service/internal/model SecurityEventRepository
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SecurityEventType string
+
+const (
+	SecurityEventRefreshTokenReuse SecurityEventType = "REFRESH_TOKEN_REUSE"
+	SecurityEventMFAEnabled        SecurityEventType = "MFA_ENABLED"
+	SecurityEventMFADisabled       SecurityEventType = "MFA_DISABLED"
+	SecurityEventMFARecoveryUsed   SecurityEventType = "MFA_RECOVERY_CODE_USED"
+	SecurityEventEmailVerified     SecurityEventType = "EMAIL_VERIFIED"
+	SecurityEventPasswordReset     SecurityEventType = "PASSWORD_RESET"
+	SecurityEventPasswordChanged   SecurityEventType = "PASSWORD_CHANGED"
+	SecurityEventAccountLocked     SecurityEventType = "ACCOUNT_LOCKED"
+	SecurityEventAccountUnlocked   SecurityEventType = "ACCOUNT_UNLOCKED"
+	SecurityEventStatusChanged     SecurityEventType = "STATUS_CHANGED"
+)
+
+type SecurityEvent struct {
+	ID        string
+	UserID    string
+	EventType SecurityEventType
+	Detail    string
+	CreatedAt time.Time
+}
+
+func (SecurityEvent) TableName() string {
+	return "security_events"
+}
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *SecurityEvent) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+}
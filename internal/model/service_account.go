@@ -0,0 +1,207 @@
+//go:generate mockgen -destination=mock/mock_service_account_repository.go -package=mock github.com/krobus00/auth-service/internal/model ServiceAccountRepository
+//go:generate mockgen -destination=mock/mock_service_account_group_repository.go -package=mock github.com/krobus00/auth-service/internal/model ServiceAccountGroupRepository
+//go:generate mockgen -destination=mock/mock_service_account_usecase.go -package=mock github.com/krobus00/auth-service/internal/model ServiceAccountUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"gorm.io/gorm"
+)
+
+const (
+	ServiceAccountClientIDPrefix     = "sa_"
+	ServiceAccountClientSecretPrefix = "sas_"
+)
+
+var (
+	ErrServiceAccountNotFound          = errors.New("service account not found")
+	ErrServiceAccountGroupNotFound     = errors.New("service account group not found")
+	ErrServiceAccountGroupAlreadyExist = errors.New("service account group already exist")
+	ErrInvalidServiceAccount           = errors.New("invalid service account")
+	ErrInvalidClientCredentials        = errors.New("invalid client credentials")
+)
+
+// ServiceAccount is a non human principal, it authenticate with a client id and secret.
+type ServiceAccount struct {
+	ID               string
+	Name             string
+	ClientID         string
+	ClientSecretHash string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (ServiceAccount) TableName() string {
+	return "service_accounts"
+}
+
+type ServiceAccountGroup struct {
+	ServiceAccountID string
+	GroupID          string
+}
+
+func (ServiceAccountGroup) TableName() string {
+	return "service_account_groups"
+}
+
+type ServiceAccountGroups []*ServiceAccountGroup
+
+func NewServiceAccountCacheKeyByID(id string) string {
+	return fmt.Sprintf("service-accounts:id:%s", id)
+}
+
+func NewServiceAccountCacheKeyByClientID(clientID string) string {
+	return fmt.Sprintf("service-accounts:clientID:%s", clientID)
+}
+
+func GetServiceAccountCacheKeys(id string, clientID string) []string {
+	return []string{
+		NewServiceAccountCacheKeyByID(id),
+		NewServiceAccountCacheKeyByClientID(clientID),
+	}
+}
+
+func NewServiceAccountGroupCacheKeyByServiceAccountID(serviceAccountID string) string {
+	return fmt.Sprintf("service-account-groups:serviceAccountID:%s", serviceAccountID)
+}
+
+func (m *ServiceAccount) ToGRPCResponse() *pb.ServiceAccount {
+	return &pb.ServiceAccount{
+		Id:        m.ID,
+		Name:      m.Name,
+		ClientId:  m.ClientID,
+		CreatedAt: m.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt: m.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// CreatedServiceAccount carry the plain client secret, it is only available right after creation.
+type CreatedServiceAccount struct {
+	*ServiceAccount
+	ClientSecret string
+}
+
+func (m *CreatedServiceAccount) ToGRPCResponse() *pb.CreateServiceAccountResponse {
+	return &pb.CreateServiceAccountResponse{
+		ServiceAccount: m.ServiceAccount.ToGRPCResponse(),
+		ClientSecret:   m.ClientSecret,
+	}
+}
+
+func (m *ServiceAccountGroup) ToGRPCResponse() *pb.ServiceAccountGroup {
+	return &pb.ServiceAccountGroup{
+		ServiceAccountId: m.ServiceAccountID,
+		GroupId:          m.GroupID,
+	}
+}
+
+func (m ServiceAccountGroups) ToGRPCResponse() *pb.FindAllServiceAccountGroupsResponse {
+	res := make([]*pb.ServiceAccountGroup, 0)
+	for _, serviceAccountGroup := range m {
+		res = append(res, serviceAccountGroup.ToGRPCResponse())
+	}
+	return &pb.FindAllServiceAccountGroupsResponse{
+		ServiceAccountGroups: res,
+	}
+}
+
+type CreateServiceAccountPayload struct {
+	Name string
+}
+
+func (m *CreateServiceAccountPayload) ParseFromProto(req *pb.CreateServiceAccountRequest) {
+	m.Name = strings.TrimSpace(req.GetName())
+}
+
+type FindServiceAccountByIDPayload struct {
+	ID string
+}
+
+func (m *FindServiceAccountByIDPayload) ParseFromProto(req *pb.FindServiceAccountByIDRequest) {
+	m.ID = req.GetId()
+}
+
+type DeleteServiceAccountPayload struct {
+	ID string
+}
+
+func (m *DeleteServiceAccountPayload) ParseFromProto(req *pb.DeleteServiceAccountRequest) {
+	m.ID = req.GetId()
+}
+
+type ClientCredentialsPayload struct {
+	ClientID     string
+	ClientSecret string
+}
+
+func (m *ClientCredentialsPayload) ParseFromProto(req *pb.ClientCredentialsRequest) {
+	m.ClientID = req.GetClientId()
+	m.ClientSecret = req.GetClientSecret()
+}
+
+type ServiceAccountGroupPayload struct {
+	ServiceAccountID string
+	GroupID          string
+}
+
+func (m *ServiceAccountGroupPayload) ParseFromProto(req *pb.ServiceAccountGroupRequest) {
+	m.ServiceAccountID = req.GetServiceAccountId()
+	m.GroupID = req.GetGroupId()
+}
+
+type FindServiceAccountGroupsPayload struct {
+	ServiceAccountID string
+}
+
+func (m *FindServiceAccountGroupsPayload) ParseFromProto(req *pb.FindAllServiceAccountGroupsRequest) {
+	m.ServiceAccountID = req.GetServiceAccountId()
+}
+
+type ServiceAccountRepository interface {
+	Create(ctx context.Context, serviceAccount *ServiceAccount) error
+	FindByID(ctx context.Context, id string) (*ServiceAccount, error)
+	FindByClientID(ctx context.Context, clientID string) (*ServiceAccount, error)
+	Delete(ctx context.Context, serviceAccount *ServiceAccount) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type ServiceAccountGroupRepository interface {
+	Create(ctx context.Context, data *ServiceAccountGroup) error
+	FindByServiceAccountIDAndGroupID(ctx context.Context, serviceAccountID, groupID string) (*ServiceAccountGroup, error)
+	FindByServiceAccountID(ctx context.Context, serviceAccountID string) ([]*ServiceAccountGroup, error)
+	DeleteByServiceAccountIDAndGroupID(ctx context.Context, serviceAccountID, groupID string) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type ServiceAccountUsecase interface {
+	Create(ctx context.Context, payload *CreateServiceAccountPayload) (*CreatedServiceAccount, error)
+	FindByID(ctx context.Context, payload *FindServiceAccountByIDPayload) (*ServiceAccount, error)
+	Delete(ctx context.Context, payload *DeleteServiceAccountPayload) error
+	ClientCredentials(ctx context.Context, payload *ClientCredentialsPayload) (*AuthResponse, error)
+
+	AddGroup(ctx context.Context, payload *ServiceAccountGroupPayload) (*ServiceAccountGroup, error)
+	RemoveGroup(ctx context.Context, payload *ServiceAccountGroupPayload) error
+	FindGroups(ctx context.Context, payload *FindServiceAccountGroupsPayload) (ServiceAccountGroups, error)
+
+	// DI
+	InjectAuthUsecase(usecase AuthUsecase) error
+	InjectServiceAccountRepo(repo ServiceAccountRepository) error
+	InjectServiceAccountGroupRepo(repo ServiceAccountGroupRepository) error
+	InjectGroupRepo(repo GroupRepository) error
+	InjectGroupPermissionRepo(repo GroupPermissionRepository) error
+	InjectTokenRepo(repo TokenRepository) error
+}
@@ -0,0 +1,117 @@
+//go:generate mockgen -destination=mock/mock_session_usecase.go -package=mock github.com/krobus00/auth-service/internal/model SessionUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/krobus00/auth-service/pb/auth"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// Session is a refresh token family, it start on login and survive token rotation.
+type Session struct {
+	SessionID string
+	TokenID   string
+	ExpiredAt time.Time
+	SessionMetadata
+}
+
+// SessionMetadata describe the client a session was issued to.
+type SessionMetadata struct {
+	IPAddress  string
+	UserAgent  string
+	ClientName string
+	// ClientID is the OAuth client the session was issued to, empty for first party logins.
+	ClientID string
+	DeviceID string
+	// Scope is the space separated OAuth scope granted to the client, empty for first party logins.
+	Scope           string
+	CreatedAt       time.Time
+	LastRefreshedAt time.Time
+}
+
+type Sessions []*Session
+
+func SessionIndexCacheKey(userID string) string {
+	return fmt.Sprintf("sessions:%s", userID)
+}
+
+func SessionCacheKey(userID string, sessionID string) string {
+	return fmt.Sprintf("session:%s:%s", userID, sessionID)
+}
+
+func (m *Session) ToGRPCResponse() *pb.Session {
+	return &pb.Session{
+		SessionId:       m.SessionID,
+		TokenId:         m.TokenID,
+		ExpiredAt:       m.ExpiredAt.UTC().Format(time.RFC3339Nano),
+		IpAddress:       m.IPAddress,
+		UserAgent:       m.UserAgent,
+		ClientName:      m.ClientName,
+		DeviceId:        m.DeviceID,
+		CreatedAt:       formatSessionTime(m.CreatedAt),
+		LastRefreshedAt: formatSessionTime(m.LastRefreshedAt),
+	}
+}
+
+func formatSessionTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func (m Sessions) ToGRPCResponse() *pb.ListSessionsResponse {
+	res := make([]*pb.Session, 0)
+	for _, session := range m {
+		res = append(res, session.ToGRPCResponse())
+	}
+	return &pb.ListSessionsResponse{
+		Sessions: res,
+	}
+}
+
+type ListSessionsPayload struct {
+	UserID string
+}
+
+func (m *ListSessionsPayload) ParseFromProto(req *pb.ListSessionsRequest) {
+	m.UserID = req.GetUserId()
+}
+
+type RevokeSessionPayload struct {
+	UserID  string
+	TokenID string
+}
+
+func (m *RevokeSessionPayload) ParseFromProto(req *pb.RevokeSessionRequest) {
+	m.UserID = req.GetUserId()
+	m.TokenID = req.GetTokenId()
+}
+
+type RevokeAllSessionsPayload struct {
+	UserID        string
+	ExceptCurrent bool
+}
+
+func (m *RevokeAllSessionsPayload) ParseFromProto(req *pb.RevokeAllSessionsRequest) {
+	m.UserID = req.GetUserId()
+	m.ExceptCurrent = req.GetExceptCurrent()
+}
+
+type SessionUsecase interface {
+	ListSessions(ctx context.Context, payload *ListSessionsPayload) (Sessions, error)
+	RevokeSession(ctx context.Context, payload *RevokeSessionPayload) error
+	RevokeAllSessions(ctx context.Context, payload *RevokeAllSessionsPayload) error
+
+	// DI
+	InjectAuthUsecase(usecase AuthUsecase) error
+	InjectTokenRepo(repo TokenRepository) error
+}
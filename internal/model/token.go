@@ -15,17 +15,50 @@ type TokenType int
 const (
 	AccessToken TokenType = iota
 	RefreshToken
+	EmailVerificationToken
 )
 
 var (
 	ErrTokenInvalid     = errors.New("invalid token")
+	ErrTokenExpired     = errors.New("token expired")
+	ErrTokenMalformed   = errors.New("malformed token")
+	ErrTokenRevoked     = errors.New("token revoked")
 	ErrInvalidTokenType = errors.New("invalid token type")
+	ErrTokenReused      = errors.New("refresh token reused")
 )
 
+func (t TokenType) String() string {
+	switch t {
+	case AccessToken:
+		return "access"
+	case RefreshToken:
+		return "refresh"
+	case EmailVerificationToken:
+		return "email_verification"
+	default:
+		return "unknown"
+	}
+}
+
 type TokenRepository interface {
-	Create(ctx context.Context, userID string, tokenID string, tokenType TokenType) (string, error)
+	// Create mint a token as the latest member of a refresh token family and record the client it is
+	// issued to on the family session, a new family use the id of its first token.
+	Create(ctx context.Context, userID string, familyID string, tokenID string, tokenType TokenType, metadata *SessionMetadata) (string, error)
 	IsValidToken(ctx context.Context, userID string, tokenID string, tokenType TokenType) (bool, error)
 	Revoke(ctx context.Context, userID string, tokenID string, tokenType TokenType) error
+	// ClaimRefreshToken atomically consume a refresh token so it can be rotated exactly once.
+	// Presenting an already rotated token return its family id with ErrTokenReused.
+	ClaimRefreshToken(ctx context.Context, userID string, tokenID string) (string, error)
+	// RevokeFamily revoke every token pair issued in the family.
+	RevokeFamily(ctx context.Context, userID string, familyID string) error
+	// ListSessions return every live token family of the user, most recently refreshed first.
+	ListSessions(ctx context.Context, userID string) ([]*Session, error)
+	// FindSession return the session the token belong to, nil when the token family is gone.
+	FindSession(ctx context.Context, userID string, tokenID string) (*Session, error)
+	// RevokeSession revoke the token family the token belong to.
+	RevokeSession(ctx context.Context, userID string, tokenID string) error
+	// RevokeAllSessions revoke every token family of the user except the one exceptTokenID belong to.
+	RevokeAllSessions(ctx context.Context, userID string, exceptTokenID string) error
 
 	// DI
 	InjectRedisClient(client *goredis.Client) error
@@ -38,3 +71,15 @@ func RefreshTokenCacheKey(userID string, tokenID string) string {
 func AccessTokenCacheKey(userID string, tokenID string) string {
 	return fmt.Sprintf("access-token:%s:%s", userID, tokenID)
 }
+
+func RefreshTokenFamilyCacheKey(userID string, familyID string) string {
+	return fmt.Sprintf("refresh-token-family:%s:%s", userID, familyID)
+}
+
+func RefreshTokenFamilyIDCacheKey(userID string, tokenID string) string {
+	return fmt.Sprintf("refresh-token-family-id:%s:%s", userID, tokenID)
+}
+
+func RotatedRefreshTokenCacheKey(userID string, tokenID string) string {
+	return fmt.Sprintf("rotated-refresh-token:%s:%s", userID, tokenID)
+}
@@ -6,6 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
@@ -14,18 +17,107 @@ import (
 )
 
 var (
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrFullNameRequired  = errors.New("full name is required")
+	ErrInvalidUserFilter = errors.New("invalid user filter")
+	ErrInvalidUsername   = errors.New("invalid username")
+	ErrInvalidEmail      = errors.New("invalid email")
 )
 
+// maxIdentifierLength is the size of the username and email columns.
+const maxIdentifierLength = 255
+
+// usernamePattern has no @ so a login identifier is never both a username and an email.
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// NormalizeUsername trim the username and check it only use letters, digits, dots, underscores and dashes.
+func NormalizeUsername(username string) (string, error) {
+	username = strings.TrimSpace(username)
+	if len(username) > maxIdentifierLength || !usernamePattern.MatchString(username) {
+		return "", ErrInvalidUsername
+	}
+	return username, nil
+}
+
+// NormalizeEmail trim and lower case the email, it must be a bare address without a display name.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || len(email) > maxIdentifierLength {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
+
 type User struct {
-	ID        string
-	FullName  string
-	Username  string
-	Email     string
-	Password  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	ID       string
+	FullName string
+	Username string
+	Email    string
+	Password string
+	// DirectoryManaged users authenticate against the directory, their local password is never used.
+	DirectoryManaged bool
+	// EmailVerifiedAt is nil until the user proved the email address belong to them.
+	EmailVerifiedAt *time.Time
+	Status          UserStatus
+	// StatusReason explain the last status change made by an admin.
+	StatusReason string
+	// SuspendedUntil end a suspension on its own, nil suspend the user until reactivated.
+	SuspendedUntil *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+func (m *User) IsEmailVerified() bool {
+	return m.EmailVerifiedAt != nil
+}
+
+// CurrentStatus is the status in effect at now, a suspension past its end is active again.
+// Users written before the status existed are active.
+func (m *User) CurrentStatus(now time.Time) UserStatus {
+	switch {
+	case m.Status == "":
+		return UserStatusActive
+	case m.Status == UserStatusSuspended && m.SuspendedUntil != nil && !now.Before(*m.SuspendedUntil):
+		return UserStatusActive
+	default:
+		return m.Status
+	}
+}
+
+// StatusError is the error refusing a new session to the user, nil when the user is active.
+func (m *User) StatusError() error {
+	switch m.CurrentStatus(time.Now()) {
+	case UserStatusActive:
+		return nil
+	case UserStatusPending:
+		return ErrAccountPending
+	case UserStatusSuspended:
+		return ErrAccountSuspended
+	case UserStatusDeactivated:
+		return ErrAccountDeactivated
+	default:
+		return ErrUserNotFound
+	}
+}
+
+// ToUserInfoResponse expose the user without its password hash.
+func (m *User) ToUserInfoResponse() *UserInfoResponse {
+	return &UserInfoResponse{
+		ID:               m.ID,
+		FullName:         m.FullName,
+		Username:         m.Username,
+		Email:            m.Email,
+		DirectoryManaged: m.DirectoryManaged,
+		EmailVerified:    m.IsEmailVerified(),
+		Status:           m.CurrentStatus(time.Now()),
+		StatusReason:     m.StatusReason,
+		SuspendedUntil:   m.SuspendedUntil,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+		DeletedAt:        m.DeletedAt,
+	}
 }
 
 func NewUserCacheKeyByID(id string) string {
@@ -74,53 +166,196 @@ func (m *UserLoginPayload) ParseFromProto(req *pb.LoginRequest) {
 	m.Password = req.GetPassword()
 }
 
+// ProvisionUserPayload describe a user created on first sign in through an external identity.
+type ProvisionUserPayload struct {
+	FullName         string
+	Username         string
+	Email            string
+	DirectoryManaged bool
+}
+
 type GetUserInfoPayload struct {
 	ID string
 }
 
+// AuthResponse carry either the token pair or, when the user has mfa enabled, the mfa challenge token.
+// A registration waiting for its email verification carry neither.
 type AuthResponse struct {
 	AccessToken  string
 	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
+
+	EmailVerificationRequired bool
 }
 
 func (m *AuthResponse) ToGRPCResponse() *pb.AuthResponse {
 	return &pb.AuthResponse{
-		AccessToken:  m.AccessToken,
-		RefreshToken: m.RefreshToken,
+		AccessToken:               m.AccessToken,
+		RefreshToken:              m.RefreshToken,
+		MfaRequired:               m.MFARequired,
+		MfaToken:                  m.MFAToken,
+		EmailVerificationRequired: m.EmailVerificationRequired,
 	}
 }
 
 type UserInfoResponse struct {
-	ID        string
-	FullName  string
-	Username  string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	ID               string
+	FullName         string
+	Username         string
+	Email            string
+	DirectoryManaged bool
+	EmailVerified    bool
+	Status           UserStatus
+	StatusReason     string
+	SuspendedUntil   *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
 }
 
 func (m *UserInfoResponse) ToGRPCResponse() *pb.User {
 	createdAt := m.CreatedAt.Format(time.RFC3339Nano)
 	updatedAt := m.UpdatedAt.Format(time.RFC3339Nano)
+	suspendedUntil := ""
+	if m.SuspendedUntil != nil {
+		suspendedUntil = m.SuspendedUntil.Format(time.RFC3339Nano)
+	}
 	return &pb.User{
-		Id:        m.ID,
-		FullName:  m.FullName,
-		Username:  m.Username,
-		Email:     m.Email,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		Id:               m.ID,
+		FullName:         m.FullName,
+		Username:         m.Username,
+		Email:            m.Email,
+		DirectoryManaged: m.DirectoryManaged,
+		EmailVerified:    m.EmailVerified,
+		Status:           m.Status.String(),
+		StatusReason:     m.StatusReason,
+		SuspendedUntil:   suspendedUntil,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
 	}
 }
 
 type RefreshTokenPayload struct {
-	UserID  string
-	TokenID string
+	RefreshToken string
 }
 
 func (m *RefreshTokenPayload) ParseFromProto(req *pb.RefreshTokenRequest) {
-	m.UserID = req.GetSessionUserId()
-	m.TokenID = req.GetTokenId()
+	m.RefreshToken = req.GetRefreshToken()
+}
+
+type ChangePasswordPayload struct {
+	CurrentPassword     string
+	NewPassword         string
+	RevokeOtherSessions bool
+}
+
+func (m *ChangePasswordPayload) ParseFromProto(req *pb.ChangePasswordRequest) {
+	m.CurrentPassword = req.GetCurrentPassword()
+	m.NewPassword = req.GetNewPassword()
+	m.RevokeOtherSessions = req.GetRevokeOtherSessions()
+}
+
+type UpdateProfilePayload struct {
+	FullName string
+}
+
+func (m *UpdateProfilePayload) ParseFromProto(req *pb.UpdateProfileRequest) {
+	m.FullName = req.GetFullName()
+}
+
+// UpdateUserPayload change the profile of any user, empty fields are left unchanged.
+type UpdateUserPayload struct {
+	UserID   string
+	FullName string
+	Username string
+	Email    string
+}
+
+func (m *UpdateUserPayload) ParseFromProto(req *pb.UpdateUserRequest) {
+	m.UserID = req.GetUserId()
+	m.FullName = req.GetFullName()
+	m.Username = req.GetUsername()
+	m.Email = req.GetEmail()
+}
+
+// UserFilter select a page of users sorted by creation time then id.
+type UserFilter struct {
+	GroupID       string
+	Status        UserStatus
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	EmailDomain   string
+	Search        string
+	PrefixSearch  bool
+	// IncludeDeleted is implied by a DELETED status.
+	IncludeDeleted bool
+	// After is the last user of the previous page, nil start from the first user.
+	After *PageCursor
+	Limit int
+}
+
+type ListUsersPayload struct {
+	Filter    UserFilter
+	PageSize  int
+	PageToken string
+}
+
+func (m *ListUsersPayload) ParseFromProto(req *pb.ListUsersRequest) error {
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+	m.Filter = UserFilter{
+		GroupID:        req.GetGroupId(),
+		Status:         UserStatus(req.GetStatus()),
+		EmailDomain:    strings.TrimPrefix(req.GetEmailDomain(), "@"),
+		Search:         req.GetSearch(),
+		PrefixSearch:   req.GetPrefixSearch(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+	}
+	if m.Filter.Status != "" && !m.Filter.Status.IsValid() {
+		return ErrInvalidUserFilter
+	}
+
+	var err error
+	m.Filter.CreatedAfter, err = parseOptionalTime(req.GetCreatedAfter())
+	if err != nil {
+		return ErrInvalidUserFilter
+	}
+	m.Filter.CreatedBefore, err = parseOptionalTime(req.GetCreatedBefore())
+	if err != nil {
+		return ErrInvalidUserFilter
+	}
+	if m.Filter.CreatedAfter != nil && m.Filter.CreatedBefore != nil && !m.Filter.CreatedAfter.Before(*m.Filter.CreatedBefore) {
+		return ErrInvalidUserFilter
+	}
+	return nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+type ListUsersResponse struct {
+	Users         []*UserInfoResponse
+	NextPageToken string
+}
+
+func (m *ListUsersResponse) ToGRPCResponse() *pb.ListUsersResponse {
+	users := make([]*pb.User, 0, len(m.Users))
+	for _, user := range m.Users {
+		users = append(users, user.ToGRPCResponse())
+	}
+	return &pb.ListUsersResponse{
+		Users:         users,
+		NextPageToken: m.NextPageToken,
+	}
 }
 
 type UserLogoutPayload struct {
@@ -128,18 +363,25 @@ type UserLogoutPayload struct {
 	TokenID string
 }
 
-func (m *UserLogoutPayload) ParseFromProto(req *pb.LogoutRequest) {
-	m.UserID = req.GetSessionUserId()
-	m.TokenID = req.GetTokenId()
-}
-
 type UserRepository interface {
 	Create(ctx context.Context, user *User) error
 	FindByID(ctx context.Context, id string) (*User, error)
 	FindByUsername(ctx context.Context, username string) (*User, error)
 	FindByEmail(ctx context.Context, email string) (*User, error)
-	UpdateByID(ctx context.Context, id string) (*User, error)
+	// FindAll return up to filter.Limit users after the cursor of the filter.
+	FindAll(ctx context.Context, filter *UserFilter) ([]*User, error)
+	// UpdateByID write the profile and password of the user and bump its update time,
+	// the cache of its previous username and email is invalidated too.
+	UpdateByID(ctx context.Context, user *User) error
+	// UpdateStatus write the status of the user, with its reason and suspension end.
+	UpdateStatus(ctx context.Context, user *User) error
+	// DeleteByID soft delete the user, the row is kept for the audit trail.
 	DeleteByID(ctx context.Context, id string) error
+	// MarkEmailVerified set the verification time of the user, it report false when the
+	// user no longer own the email address.
+	MarkEmailVerified(ctx context.Context, user *User) (bool, error)
+	// ResetEmailVerification clear the verification time of the user, the email has to be verified again.
+	ResetEmailVerification(ctx context.Context, user *User) error
 
 	// DI
 	InjectDB(db *gorm.DB) error
@@ -149,14 +391,31 @@ type UserRepository interface {
 type UserUsecase interface {
 	Register(ctx context.Context, payload *UserRegistrationPayload) (*AuthResponse, error)
 	Login(ctx context.Context, payload *UserLoginPayload) (*AuthResponse, error)
+	Authenticate(ctx context.Context, payload *UserLoginPayload) (*User, error)
+	IssueToken(ctx context.Context, userID string) (*AuthResponse, error)
+	ProvisionUser(ctx context.Context, payload *ProvisionUserPayload) (*User, error)
 	GetUserInfo(ctx context.Context, payload *GetUserInfoPayload) (*UserInfoResponse, error)
 	RefreshToken(ctx context.Context, payload *RefreshTokenPayload) (*AuthResponse, error)
 	Logout(ctx context.Context, payload *UserLogoutPayload) error
+	ChangePassword(ctx context.Context, payload *ChangePasswordPayload) error
+	// UpdateProfile change the profile of the current user.
+	UpdateProfile(ctx context.Context, payload *UpdateProfilePayload) (*UserInfoResponse, error)
+	// UpdateUser change the profile, username and email of any user, a new email has to be verified again.
+	UpdateUser(ctx context.Context, payload *UpdateUserPayload) (*UserInfoResponse, error)
+	ListUsers(ctx context.Context, payload *ListUsersPayload) (*ListUsersResponse, error)
 
 	// DI
 	InjectDB(db *gorm.DB) error
+	InjectAuthUsecase(usecase AuthUsecase) error
 	InjectTokenRepo(repo TokenRepository) error
+	InjectPersonalAccessTokenRepo(repo PersonalAccessTokenRepository) error
 	InjectUserRepo(repo UserRepository) error
 	InjectGroupRepo(repo GroupRepository) error
 	InjectUserGroupRepo(repo UserGroupRepository) error
+	InjectSecurityEventRepo(repo SecurityEventRepository) error
+	InjectAuthenticators(authenticators ...Authenticator) error
+	InjectMFAUsecase(usecase MFAUsecase) error
+	InjectEmailVerificationUsecase(usecase EmailVerificationUsecase) error
+	InjectPasswordPolicyUsecase(usecase PasswordPolicyUsecase) error
+	InjectLoginAttemptUsecase(usecase LoginAttemptUsecase) error
 }
@@ -97,6 +97,70 @@ func (m *FindUserGroupsByUserIDPayload) ParseFromProto(req *pb.FindAllUserGroups
 	m.UserID = req.GetUserId()
 }
 
+// GroupMemberFilter select a page of the users of a group sorted by username then id.
+type GroupMemberFilter struct {
+	GroupID string
+	// After is the last user of the previous page, nil start from the first user.
+	After *PageCursor
+	Limit int
+}
+
+type ListGroupMembersPayload struct {
+	GroupID   string
+	PageSize  int
+	PageToken string
+}
+
+func (m *ListGroupMembersPayload) ParseFromProto(req *pb.ListGroupMembersRequest) {
+	m.GroupID = req.GetGroupId()
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+}
+
+type ListGroupMembersResponse struct {
+	Users         []*UserInfoResponse
+	NextPageToken string
+}
+
+func (m *ListGroupMembersResponse) ToGRPCResponse() *pb.ListGroupMembersResponse {
+	users := make([]*pb.User, 0, len(m.Users))
+	for _, user := range m.Users {
+		users = append(users, user.ToGRPCResponse())
+	}
+	return &pb.ListGroupMembersResponse{
+		Users:         users,
+		NextPageToken: m.NextPageToken,
+	}
+}
+
+type ListUsersWithPermissionPayload struct {
+	PermissionID string
+	PageSize     int
+	PageToken    string
+}
+
+func (m *ListUsersWithPermissionPayload) ParseFromProto(req *pb.ListUsersWithPermissionRequest) {
+	m.PermissionID = req.GetPermissionId()
+	m.PageSize = int(req.GetPageSize())
+	m.PageToken = req.GetPageToken()
+}
+
+type ListUsersWithPermissionResponse struct {
+	Users         []*UserInfoResponse
+	NextPageToken string
+}
+
+func (m *ListUsersWithPermissionResponse) ToGRPCResponse() *pb.ListUsersWithPermissionResponse {
+	users := make([]*pb.User, 0, len(m.Users))
+	for _, user := range m.Users {
+		users = append(users, user.ToGRPCResponse())
+	}
+	return &pb.ListUsersWithPermissionResponse{
+		Users:         users,
+		NextPageToken: m.NextPageToken,
+	}
+}
+
 func GetUserGroupCacheKeys(userID string, groupID string) []string {
 	return []string{
 		NewUserGroupCacheKeyByUserID(userID),
@@ -112,6 +176,13 @@ type UserGroupRepository interface {
 	DeleteByUserIDAndGroupID(ctx context.Context, userID, groupID string) error
 	FindByUserID(ctx context.Context, userID string) ([]*UserGroup, error)
 
+	// FindMembers return up to filter.Limit users of the group after the cursor of the filter,
+	// the deleted users are left out.
+	FindMembers(ctx context.Context, filter *GroupMemberFilter) ([]*User, error)
+	// FindUsersWithPermission return up to filter.Limit users granted any of filter.PermissionNames through
+	// one of their groups, sorted by username then id, the deleted users are left out.
+	FindUsersWithPermission(ctx context.Context, filter *PermissionHolderFilter) ([]*User, error)
+
 	HasPermission(ctx context.Context, groupID string, permission string) (bool, error)
 
 	// DI
@@ -124,10 +195,13 @@ type UserGroupUsecase interface {
 	FindByUserIDAndGroupID(ctx context.Context, payload *FindUserGroupPayload) (*UserGroup, error)
 	DeleteByUserIDAndGroupID(ctx context.Context, payload *DeleteUserGroupPayload) error
 	FindByUserID(ctx context.Context, payload *FindUserGroupsByUserIDPayload) (UserGroups, error)
+	ListGroupMembers(ctx context.Context, payload *ListGroupMembersPayload) (*ListGroupMembersResponse, error)
+	ListUsersWithPermission(ctx context.Context, payload *ListUsersWithPermissionPayload) (*ListUsersWithPermissionResponse, error)
 
 	// DI
 	InjectAuthUsecase(usecase AuthUsecase) error
 	InjectUserGroupRepo(repo UserGroupRepository) error
 	InjectUserRepo(repo UserRepository) error
 	InjectGroupRepo(repo GroupRepository) error
+	InjectPermissionRepo(repo PermissionRepository) error
 }
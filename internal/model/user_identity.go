@@ -0,0 +1,205 @@
+//go:generate mockgen -destination=mock/mock_user_identity_repository.go -package=mock github.com/krobus00/auth-service/internal/model UserIdentityRepository
+//go:generate mockgen -destination=mock/mock_federation_state_repository.go -package=mock github.com/krobus00/auth-service/internal/model FederationStateRepository
+//go:generate mockgen -destination=mock/mock_identity_provider_repository.go -package=mock github.com/krobus00/auth-service/internal/model IdentityProviderRepository
+//go:generate mockgen -destination=mock/mock_user_identity_usecase.go -package=mock github.com/krobus00/auth-service/internal/model UserIdentityUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"gorm.io/gorm"
+)
+
+const (
+	// FederatedLoginPath and FederatedCallbackPath are served by the http transport,
+	// the callback is registered at the upstream provider as <oauth.issuer>/federated/callback.
+	FederatedLoginPath    = "/federated/login"
+	FederatedCallbackPath = "/federated/callback"
+)
+
+var (
+	ErrIdentityProviderNotFound   = errors.New("identity provider not found")
+	ErrIdentityProviderExchange   = errors.New("identity provider rejected the sign in")
+	ErrUserIdentityNotFound       = errors.New("user identity not found")
+	ErrUserIdentityAlreadyLinked  = errors.New("user identity already linked")
+	ErrUserIdentityRequired       = errors.New("user identity is the only way to sign in")
+	ErrFederationStateInvalid     = errors.New("invalid or expired federation state")
+	ErrExternalEmailNotVerified   = errors.New("identity provider did not release a verified email")
+	ErrExternalIdentityIncomplete = errors.New("identity provider did not release a subject")
+)
+
+// UserIdentity link an account at an upstream identity provider to a local user.
+type UserIdentity struct {
+	ID        string
+	UserID    string
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+type UserIdentities []*UserIdentity
+
+func NewUserIdentityCacheKeyByProviderAndSubject(provider string, subject string) string {
+	return fmt.Sprintf("user-identities:provider:%s:subject:%s", provider, subject)
+}
+
+func GetUserIdentityCacheKeys(identity *UserIdentity) []string {
+	return []string{
+		NewUserIdentityCacheKeyByProviderAndSubject(identity.Provider, identity.Subject),
+	}
+}
+
+func (m *UserIdentity) ToGRPCResponse() *pb.UserIdentity {
+	return &pb.UserIdentity{
+		Id:        m.ID,
+		UserId:    m.UserID,
+		Provider:  m.Provider,
+		Subject:   m.Subject,
+		Email:     m.Email,
+		CreatedAt: m.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt: m.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func (m UserIdentities) ToGRPCResponse() *pb.FindAllUserIdentitiesResponse {
+	res := make([]*pb.UserIdentity, 0)
+	for _, identity := range m {
+		res = append(res, identity.ToGRPCResponse())
+	}
+	return &pb.FindAllUserIdentitiesResponse{
+		UserIdentities: res,
+	}
+}
+
+// ExternalIdentity is the user released by an upstream identity provider.
+type ExternalIdentity struct {
+	Provider          string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// FederationState is kept while the user sign in at the upstream provider.
+// It carry the pending authorize request on login and the local user on link.
+type FederationState struct {
+	Provider     string
+	CodeVerifier string
+	UserID       string
+	Authorize    *AuthorizePayload
+	// BindingHash tie a login to the user agent that started it, the callback must present
+	// the binding kept in its cookie. A link is already tied to the account that started it.
+	BindingHash string
+}
+
+func NewFederationStateCacheKey(stateHash string) string {
+	return fmt.Sprintf("federation-states:%s", stateHash)
+}
+
+// Usecase payload
+
+type StartFederatedLoginPayload struct {
+	Provider  string
+	Authorize *AuthorizePayload
+}
+
+// StartFederatedLoginResponse carry the upstream url and the binding the user agent keep
+// until the callback.
+type StartFederatedLoginResponse struct {
+	AuthorizationURL string
+	Binding          string
+}
+
+type LinkUserIdentityPayload struct {
+	Provider string
+}
+
+func (m *LinkUserIdentityPayload) ParseFromProto(req *pb.LinkUserIdentityRequest) {
+	m.Provider = strings.TrimSpace(req.GetProvider())
+}
+
+type UnlinkUserIdentityPayload struct {
+	Provider string
+}
+
+func (m *UnlinkUserIdentityPayload) ParseFromProto(req *pb.UnlinkUserIdentityRequest) {
+	m.Provider = strings.TrimSpace(req.GetProvider())
+}
+
+type FederatedCallbackPayload struct {
+	State   string
+	Code    string
+	Error   string
+	Binding string
+}
+
+// FederatedCallbackResponse hold the authorization code to hand back to the client on login,
+// or the linked identity on link.
+type FederatedCallbackResponse struct {
+	Authorize *AuthorizeResponse
+	Identity  *UserIdentity
+}
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity *UserIdentity) error
+	FindByProviderAndSubject(ctx context.Context, provider string, subject string) (*UserIdentity, error)
+	FindByUserID(ctx context.Context, userID string) (UserIdentities, error)
+	Delete(ctx context.Context, identity *UserIdentity) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectRedisClient(client *goredis.Client) error
+}
+
+type FederationStateRepository interface {
+	Create(ctx context.Context, state string, data *FederationState, expiration time.Duration) error
+	// Consume atomically read and delete the state so a callback can be completed only once.
+	Consume(ctx context.Context, state string) (*FederationState, error)
+
+	// DI
+	InjectRedisClient(client *goredis.Client) error
+}
+
+// IdentityProviderRepository talk to the configured upstream OpenID Connect providers.
+type IdentityProviderRepository interface {
+	Providers() []string
+	AuthorizationURL(ctx context.Context, provider string, state string, codeChallenge string) (string, error)
+	// Exchange redeem the authorization code and return the user released by the provider.
+	Exchange(ctx context.Context, provider string, code string, codeVerifier string) (*ExternalIdentity, error)
+
+	// DI
+	InjectHTTPClient(client *http.Client) error
+}
+
+type UserIdentityUsecase interface {
+	Providers(ctx context.Context) []string
+	StartLogin(ctx context.Context, payload *StartFederatedLoginPayload) (*StartFederatedLoginResponse, error)
+	StartLink(ctx context.Context, payload *LinkUserIdentityPayload) (string, error)
+	Callback(ctx context.Context, payload *FederatedCallbackPayload) (*FederatedCallbackResponse, error)
+	FindByCurrentUser(ctx context.Context) (UserIdentities, error)
+	Unlink(ctx context.Context, payload *UnlinkUserIdentityPayload) error
+
+	// DI
+	InjectDB(db *gorm.DB) error
+	InjectOAuthUsecase(usecase OAuthUsecase) error
+	InjectUserUsecase(usecase UserUsecase) error
+	InjectUserRepo(repo UserRepository) error
+	InjectUserIdentityRepo(repo UserIdentityRepository) error
+	InjectFederationStateRepo(repo FederationStateRepository) error
+	InjectIdentityProviderRepo(repo IdentityProviderRepository) error
+}
@@ -0,0 +1,118 @@
+//go:generate mockgen -destination=mock/mock_user_status_usecase.go -package=mock github.com/krobus00/auth-service/internal/model UserStatusUsecase
+
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/krobus00/auth-service/pb/auth"
+)
+
+var (
+	ErrAccountPending              = errors.New("account is pending activation")
+	ErrAccountSuspended            = errors.New("account is suspended")
+	ErrAccountDeactivated          = errors.New("account is deactivated")
+	ErrInvalidUserStatusTransition = errors.New("invalid user status transition")
+	ErrUserStatusReasonRequired    = errors.New("reason is required")
+	ErrInvalidSuspensionEnd        = errors.New("suspension end must be a future RFC3339 time")
+	ErrChangeOwnStatus             = errors.New("users can't change their own status")
+)
+
+type UserStatus string
+
+const (
+	// UserStatusPending users exist but can't sign in until they are activated.
+	UserStatusPending     UserStatus = "PENDING"
+	UserStatusActive      UserStatus = "ACTIVE"
+	UserStatusSuspended   UserStatus = "SUSPENDED"
+	UserStatusDeactivated UserStatus = "DEACTIVATED"
+	// UserStatusDeleted is final, the user is soft deleted.
+	UserStatusDeleted UserStatus = "DELETED"
+)
+
+func (s UserStatus) String() string {
+	return string(s)
+}
+
+// userStatusTransitions list the statuses each status can change to, a suspension can be
+// changed to extend or shorten it.
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusPending:     {UserStatusActive, UserStatusDeleted},
+	UserStatusActive:      {UserStatusSuspended, UserStatusDeactivated, UserStatusDeleted},
+	UserStatusSuspended:   {UserStatusActive, UserStatusSuspended, UserStatusDeactivated, UserStatusDeleted},
+	UserStatusDeactivated: {UserStatusActive, UserStatusDeleted},
+}
+
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusPending, UserStatusActive, UserStatusSuspended, UserStatusDeactivated, UserStatusDeleted:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s UserStatus) CanChangeTo(next UserStatus) bool {
+	for _, status := range userStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Usecase payload
+
+type ChangeUserStatusPayload struct {
+	UserID string
+	Status UserStatus
+	Reason string
+	// SuspendedUntil only apply to suspensions, nil suspend until reactivated.
+	SuspendedUntil *time.Time
+}
+
+func (m *ChangeUserStatusPayload) ParseSuspendFromProto(req *pb.SuspendUserRequest) error {
+	m.UserID = req.GetUserId()
+	m.Status = UserStatusSuspended
+	m.Reason = req.GetReason()
+	if req.GetSuspendedUntil() == "" {
+		return nil
+	}
+	suspendedUntil, err := time.Parse(time.RFC3339, req.GetSuspendedUntil())
+	if err != nil {
+		return ErrInvalidSuspensionEnd
+	}
+	m.SuspendedUntil = &suspendedUntil
+	return nil
+}
+
+func (m *ChangeUserStatusPayload) ParseDeactivateFromProto(req *pb.DeactivateUserRequest) {
+	m.UserID = req.GetUserId()
+	m.Status = UserStatusDeactivated
+	m.Reason = req.GetReason()
+}
+
+func (m *ChangeUserStatusPayload) ParseReactivateFromProto(req *pb.ReactivateUserRequest) {
+	m.UserID = req.GetUserId()
+	m.Status = UserStatusActive
+}
+
+func (m *ChangeUserStatusPayload) ParseDeleteFromProto(req *pb.DeleteUserRequest) {
+	m.UserID = req.GetUserId()
+	m.Status = UserStatusDeleted
+	m.Reason = req.GetReason()
+}
+
+type UserStatusUsecase interface {
+	// ChangeStatus move the user to another status and revoke every session of the user.
+	ChangeStatus(ctx context.Context, payload *ChangeUserStatusPayload) error
+
+	// DI
+	InjectAuthUsecase(usecase AuthUsecase) error
+	InjectUserRepo(repo UserRepository) error
+	InjectTokenRepo(repo TokenRepository) error
+	InjectPersonalAccessTokenRepo(repo PersonalAccessTokenRepository) error
+	InjectSecurityEventRepo(repo SecurityEventRepository) error
+}
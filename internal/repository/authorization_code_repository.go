@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+type authorizationCodeRepository struct {
+	redisClient *goredis.Client
+}
+
+func NewAuthorizationCodeRepository() model.AuthorizationCodeRepository {
+	return new(authorizationCodeRepository)
+}
+
+func (r *authorizationCodeRepository) Create(ctx context.Context, code string, data *model.AuthorizationCode, expiration time.Duration) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"clientID": data.ClientID,
+		"userID":   data.UserID,
+	})
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	// only the hash is stored so a leaked cache can't be used to redeem codes
+	err = r.redisClient.Set(ctx, model.NewAuthorizationCodeCacheKey(utils.HashSecret(code)), value, expiration).Err()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *authorizationCodeRepository) Consume(ctx context.Context, code string) (*model.AuthorizationCode, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	value, err := r.redisClient.GetDel(ctx, model.NewAuthorizationCodeCacheKey(utils.HashSecret(code))).Bytes()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	data := new(model.AuthorizationCode)
+	err = json.Unmarshal(value, data)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newAuthorizationCodeRepoMock(t *testing.T) (model.AuthorizationCodeRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	authorizationCodeRepo := NewAuthorizationCodeRepository()
+	err = authorizationCodeRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return authorizationCodeRepo, miniRedis
+}
+
+func Test_authorizationCodeRepository_Consume(t *testing.T) {
+	var (
+		code              = "code"
+		authorizationCode = &model.AuthorizationCode{
+			ClientID:            model.OAuthClientIDPrefix + utils.GenerateUUID(),
+			UserID:              utils.GenerateUUID(),
+			RedirectURI:         "https://dashboard.example.com/callback",
+			Scope:               "openid",
+			CodeChallenge:       utils.NewCodeChallenge("verifier"),
+			CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+		}
+	)
+	tests := []struct {
+		name        string
+		createCode  string
+		consumeCode string
+		expired     bool
+		want        *model.AuthorizationCode
+		wantErr     bool
+	}{
+		{
+			name:        "success",
+			createCode:  code,
+			consumeCode: code,
+			want:        authorizationCode,
+		},
+		{
+			name:        "unknown code",
+			createCode:  code,
+			consumeCode: "other-code",
+			want:        nil,
+		},
+		{
+			name:        "expired code",
+			createCode:  code,
+			consumeCode: code,
+			expired:     true,
+			want:        nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newAuthorizationCodeRepoMock(t)
+
+			err := r.Create(context.TODO(), tt.createCode, authorizationCode, time.Minute)
+			utils.ContinueOrFatal(err)
+			if redisMock.Exists(model.NewAuthorizationCodeCacheKey(tt.createCode)) {
+				t.Errorf("authorizationCodeRepository.Create() stored the plain code")
+			}
+			if tt.expired {
+				redisMock.FastForward(2 * time.Minute)
+			}
+
+			got, err := r.Consume(context.TODO(), tt.consumeCode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authorizationCodeRepository.Consume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("authorizationCodeRepository.Consume() = %v, want %v", got, tt.want)
+			}
+
+			// codes are single use
+			got, err = r.Consume(context.TODO(), tt.consumeCode)
+			if err != nil || got != nil {
+				t.Errorf("authorizationCodeRepository.Consume() second call = %v, %v, want nil", got, err)
+			}
+		})
+	}
+}
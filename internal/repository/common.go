@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/goccy/go-json"
 	"github.com/krobus00/auth-service/internal/config"
@@ -67,3 +68,10 @@ func HGet(ctx context.Context, redisClient *redis.Client, bucketCacheKey string,
 	}
 	return cachedData, nil
 }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike make the wildcards of a user input match literally in a LIKE pattern.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+type directoryRepository struct{}
+
+func NewDirectoryRepository() model.DirectoryRepository {
+	return new(directoryRepository)
+}
+
+func (r *directoryRepository) Authenticate(ctx context.Context, username string, password string) (*model.DirectoryEntry, error) {
+	_, _, fn := utils.Trace()
+	_, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"username": username,
+	})
+
+	// an empty password is an unauthenticated bind which most servers accept
+	if username == "" || password == "" {
+		return nil, model.ErrWrongUsernameOrPassword
+	}
+
+	conn, err := r.dial()
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, model.ErrDirectoryUnavailable
+	}
+	defer conn.Close()
+
+	if config.LDAPBindDN() != "" {
+		err = conn.Bind(config.LDAPBindDN(), config.LDAPBindPassword())
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, model.ErrDirectoryUnavailable
+		}
+	}
+
+	groupAttribute := config.LDAPGroupAttribute()
+	res, err := conn.Search(ldap.NewSearchRequest(
+		config.LDAPBaseDN(),
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		int(config.LDAPTimeout().Seconds()),
+		false,
+		fmt.Sprintf(config.LDAPUserFilter(), ldap.EscapeFilter(username)),
+		[]string{config.LDAPUsernameAttribute(), config.LDAPEmailAttribute(), config.LDAPNameAttribute(), groupAttribute},
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		logger.Error(err.Error())
+		return nil, model.ErrDirectoryUnavailable
+	}
+	if res == nil || len(res.Entries) != 1 {
+		return nil, model.ErrWrongUsernameOrPassword
+	}
+	entry := res.Entries[0]
+
+	err = conn.Bind(entry.DN, password)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return nil, model.ErrWrongUsernameOrPassword
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, model.ErrDirectoryUnavailable
+	}
+
+	return &model.DirectoryEntry{
+		DN:       entry.DN,
+		Username: entry.GetAttributeValue(config.LDAPUsernameAttribute()),
+		Email:    strings.ToLower(entry.GetAttributeValue(config.LDAPEmailAttribute())),
+		FullName: entry.GetAttributeValue(config.LDAPNameAttribute()),
+		Groups:   entry.GetAttributeValues(groupAttribute),
+	}, nil
+}
+
+func (r *directoryRepository) dial() (*ldap.Conn, error) {
+	ldapURL, err := url.Parse(config.LDAPURL())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := ldap.DialURL(ldapURL.String(), ldap.DialWithDialer(&net.Dialer{Timeout: config.LDAPTimeout()}))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(config.LDAPTimeout())
+
+	if config.LDAPStartTLS() {
+		err = conn.StartTLS(&tls.Config{
+			ServerName: ldapURL.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		})
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
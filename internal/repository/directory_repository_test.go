@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/spf13/viper"
+)
+
+const (
+	testDirectoryBindDN       = "cn=auth-service,ou=services,dc=example,dc=com"
+	testDirectoryBindPassword = "service-password"
+)
+
+type testDirectoryEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+// testDirectoryServer is an in process LDAP server answering simple binds and
+// searches on the uid attribute, enough for the bind then search then bind flow.
+type testDirectoryServer struct {
+	listener net.Listener
+	entries  []*testDirectoryEntry
+}
+
+func newTestDirectoryServer(t *testing.T, entries ...*testDirectoryEntry) *testDirectoryServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testDirectoryServer{
+		listener: listener,
+		entries:  entries,
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go s.serve()
+
+	viper.Set("ldap.url", fmt.Sprintf("ldap://%s", listener.Addr()))
+	viper.Set("ldap.bind_dn", testDirectoryBindDN)
+	viper.Set("ldap.bind_password", testDirectoryBindPassword)
+	viper.Set("ldap.base_dn", "ou=people,dc=example,dc=com")
+	t.Cleanup(func() {
+		viper.Set("ldap.url", nil)
+		viper.Set("ldap.bind_dn", nil)
+		viper.Set("ldap.bind_password", nil)
+		viper.Set("ldap.base_dn", nil)
+	})
+
+	return s
+}
+
+func (s *testDirectoryServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testDirectoryServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			resultCode := ldap.LDAPResultInvalidCredentials
+			if s.bind(op.Children[1].Data.String(), op.Children[2].Data.String()) {
+				resultCode = ldap.LDAPResultSuccess
+			}
+			_, _ = conn.Write(newTestLDAPResult(messageID, ldap.ApplicationBindResponse, resultCode).Bytes())
+		case ldap.ApplicationSearchRequest:
+			filter, _ := ldap.DecompileFilter(op.Children[6])
+			for _, entry := range s.entries {
+				for _, uid := range entry.attributes["uid"] {
+					if filter == fmt.Sprintf("(uid=%s)", ldap.EscapeFilter(uid)) {
+						_, _ = conn.Write(newTestLDAPEntry(messageID, entry).Bytes())
+					}
+				}
+			}
+			_, _ = conn.Write(newTestLDAPResult(messageID, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess).Bytes())
+		default:
+			return
+		}
+	}
+}
+
+func (s *testDirectoryServer) bind(dn string, password string) bool {
+	if dn == testDirectoryBindDN {
+		return password == testDirectoryBindPassword
+	}
+	for _, entry := range s.entries {
+		if entry.dn == dn {
+			return entry.password == password
+		}
+	}
+	return false
+}
+
+func newTestLDAPMessage(messageID int64, op *ber.Packet) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(op)
+	return packet
+}
+
+func newTestLDAPResult(messageID int64, tag ber.Tag, resultCode int) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, resultCode, "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return newTestLDAPMessage(messageID, op)
+}
+
+func newTestLDAPEntry(messageID int64, entry *testDirectoryEntry) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "Object Name"))
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range entry.attributes {
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(vals)
+		attributes.AppendChild(attribute)
+	}
+	op.AppendChild(attributes)
+	return newTestLDAPMessage(messageID, op)
+}
+
+func Test_directoryRepository_Authenticate(t *testing.T) {
+	jane := &testDirectoryEntry{
+		dn:       "uid=jane,ou=people,dc=example,dc=com",
+		password: "directory-password",
+		attributes: map[string][]string{
+			"uid":      {"jane"},
+			"mail":     {"Jane@Example.com"},
+			"cn":       {"Jane Doe"},
+			"memberOf": {"cn=engineering,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"},
+		},
+	}
+	tests := []struct {
+		name         string
+		username     string
+		password     string
+		serverClosed bool
+		want         *model.DirectoryEntry
+		wantErr      error
+	}{
+		{
+			name:     "success",
+			username: "jane",
+			password: "directory-password",
+			want: &model.DirectoryEntry{
+				DN:       jane.dn,
+				Username: "jane",
+				Email:    "jane@example.com",
+				FullName: "Jane Doe",
+				Groups:   []string{"cn=engineering,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"},
+			},
+		},
+		{
+			name:     "wrong password",
+			username: "jane",
+			password: "wrong",
+			wantErr:  model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:     "empty password",
+			username: "jane",
+			password: "",
+			wantErr:  model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:     "unknown user",
+			username: "john",
+			password: "directory-password",
+			wantErr:  model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:     "filter injection",
+			username: "*",
+			password: "directory-password",
+			wantErr:  model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:         "directory unavailable",
+			username:     "jane",
+			password:     "directory-password",
+			serverClosed: true,
+			wantErr:      model.ErrDirectoryUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestDirectoryServer(t, jane)
+			if tt.serverClosed {
+				_ = server.listener.Close()
+			}
+
+			r := NewDirectoryRepository()
+			got, err := r.Authenticate(context.TODO(), tt.username, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("directoryRepository.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("directoryRepository.Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
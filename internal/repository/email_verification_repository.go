@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+type emailVerificationRepository struct {
+	redisClient *goredis.Client
+}
+
+func NewEmailVerificationRepository() model.EmailVerificationRepository {
+	return new(emailVerificationRepository)
+}
+
+func (r *emailVerificationRepository) Create(ctx context.Context, tokenID string, data *model.EmailVerification, expiration time.Duration) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID": data.UserID,
+	})
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = r.redisClient.Set(ctx, model.NewEmailVerificationCacheKey(tokenID), value, expiration).Err()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *emailVerificationRepository) Consume(ctx context.Context, tokenID string) (*model.EmailVerification, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	value, err := r.redisClient.GetDel(ctx, model.NewEmailVerificationCacheKey(tokenID)).Bytes()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	data := new(model.EmailVerification)
+	err = json.Unmarshal(value, data)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"errors"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+func (r *emailVerificationRepository) InjectRedisClient(client *goredis.Client) error {
+	if client == nil {
+		return errors.New("invalid redis client")
+	}
+	r.redisClient = client
+	return nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newEmailVerificationRepoMock(t *testing.T) (model.EmailVerificationRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	emailVerificationRepo := NewEmailVerificationRepository()
+	err = emailVerificationRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return emailVerificationRepo, miniRedis
+}
+
+func Test_emailVerificationRepository_Consume(t *testing.T) {
+	var (
+		tokenID      = utils.GenerateUUID()
+		verification = &model.EmailVerification{
+			UserID: utils.GenerateUUID(),
+			Email:  "user@example.com",
+		}
+	)
+	tests := []struct {
+		name         string
+		consumeToken string
+		expired      bool
+		consumeTwice bool
+		want         *model.EmailVerification
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			consumeToken: tokenID,
+			want:         verification,
+		},
+		{
+			name:         "unknown token",
+			consumeToken: utils.GenerateUUID(),
+			want:         nil,
+		},
+		{
+			name:         "expired token",
+			consumeToken: tokenID,
+			expired:      true,
+			want:         nil,
+		},
+		{
+			name:         "token already used",
+			consumeToken: tokenID,
+			consumeTwice: true,
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newEmailVerificationRepoMock(t)
+
+			err := r.Create(context.TODO(), tokenID, verification, time.Hour)
+			utils.ContinueOrFatal(err)
+			if tt.expired {
+				redisMock.FastForward(2 * time.Hour)
+			}
+			if tt.consumeTwice {
+				_, err = r.Consume(context.TODO(), tt.consumeToken)
+				utils.ContinueOrFatal(err)
+			}
+
+			got, err := r.Consume(context.TODO(), tt.consumeToken)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("emailVerificationRepository.Consume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("emailVerificationRepository.Consume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+type federationStateRepository struct {
+	redisClient *goredis.Client
+}
+
+func NewFederationStateRepository() model.FederationStateRepository {
+	return new(federationStateRepository)
+}
+
+func (r *federationStateRepository) Create(ctx context.Context, state string, data *model.FederationState, expiration time.Duration) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"provider": data.Provider,
+		"userID":   data.UserID,
+	})
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = r.redisClient.Set(ctx, model.NewFederationStateCacheKey(utils.HashSecret(state)), value, expiration).Err()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *federationStateRepository) Consume(ctx context.Context, state string) (*model.FederationState, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	value, err := r.redisClient.GetDel(ctx, model.NewFederationStateCacheKey(utils.HashSecret(state))).Bytes()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	data := new(model.FederationState)
+	err = json.Unmarshal(value, data)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newFederationStateRepoMock(t *testing.T) (model.FederationStateRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	federationStateRepo := NewFederationStateRepository()
+	err = federationStateRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return federationStateRepo, miniRedis
+}
+
+func Test_federationStateRepository_Consume(t *testing.T) {
+	var (
+		state           = "state"
+		federationState = &model.FederationState{
+			Provider:     "corporate",
+			CodeVerifier: "verifier",
+			Authorize: &model.AuthorizePayload{
+				ClientID:    model.OAuthClientIDPrefix + utils.GenerateUUID(),
+				RedirectURI: "https://dashboard.example.com/callback",
+				State:       "client-state",
+			},
+		}
+	)
+	tests := []struct {
+		name         string
+		createState  string
+		consumeState string
+		expired      bool
+		want         *model.FederationState
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			createState:  state,
+			consumeState: state,
+			want:         federationState,
+		},
+		{
+			name:         "unknown state",
+			createState:  state,
+			consumeState: "other-state",
+			want:         nil,
+		},
+		{
+			name:         "expired state",
+			createState:  state,
+			consumeState: state,
+			expired:      true,
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newFederationStateRepoMock(t)
+
+			err := r.Create(context.TODO(), tt.createState, federationState, time.Minute)
+			utils.ContinueOrFatal(err)
+			if redisMock.Exists(model.NewFederationStateCacheKey(tt.createState)) {
+				t.Errorf("federationStateRepository.Create() stored the plain state")
+			}
+			if tt.expired {
+				redisMock.FastForward(2 * time.Minute)
+			}
+
+			got, err := r.Consume(context.TODO(), tt.consumeState)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("federationStateRepository.Consume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("federationStateRepository.Consume() = %v, want %v", got, tt.want)
+			}
+
+			// states are single use
+			got, err = r.Consume(context.TODO(), tt.consumeState)
+			if err != nil || got != nil {
+				t.Errorf("federationStateRepository.Consume() second call = %v, %v, want nil", got, err)
+			}
+		})
+	}
+}
@@ -117,3 +117,60 @@ func (r *groupPermissionRepo) DeleteByGroupIDAndPermissionID(ctx context.Context
 
 	return nil
 }
+
+func (r *groupPermissionRepo) FindPermissions(ctx context.Context, filter *model.GroupPermissionFilter) ([]*model.Permission, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"groupID": filter.GroupID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	query := db.WithContext(ctx).Model(&model.Permission{}).
+		Joins("JOIN group_permissions ON group_permissions.permission_id = permissions.id").
+		Where("group_permissions.group_id = ?", filter.GroupID)
+
+	if filter.After != nil {
+		query = query.Where("(permissions.name, permissions.id) > (?, ?)", filter.After.Key, filter.After.ID)
+	}
+
+	permissions := make([]*model.Permission, 0)
+	err := query.Order("permissions.name, permissions.id").Limit(filter.Limit).Find(&permissions).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (r *groupPermissionRepo) FindGroupsWithPermission(ctx context.Context, filter *model.PermissionHolderFilter) ([]*model.Group, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"permissions": filter.PermissionNames,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	// a group holding several of the permissions is listed once
+	query := db.WithContext(ctx).Model(&model.Group{}).
+		Where("EXISTS (SELECT 1 FROM group_permissions JOIN permissions ON permissions.id = group_permissions.permission_id "+
+			"WHERE group_permissions.group_id = groups.id AND permissions.name IN ?)", filter.PermissionNames)
+
+	if filter.After != nil {
+		query = query.Where("(groups.name, groups.id) > (?, ?)", filter.After.Key, filter.After.ID)
+	}
+
+	groups := make([]*model.Group, 0)
+	err := query.Order("groups.name, groups.id").Limit(filter.Limit).Find(&groups).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return groups, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
@@ -280,3 +281,117 @@ func Test_groupPermissionRepo_DeleteByGroupIDAndPermissionID(t *testing.T) {
 		})
 	}
 }
+
+func Test_groupPermissionRepo_FindPermissions(t *testing.T) {
+	ownerID := utils.GenerateUUID()
+	itemID := utils.GenerateUUID()
+	cursorID := utils.GenerateUUID()
+	tests := []struct {
+		name      string
+		filter    *model.GroupPermissionFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      []*model.Permission
+		wantErr   bool
+	}{
+		{
+			name:      "success first page",
+			filter:    &model.GroupPermissionFilter{GroupID: ownerID, Limit: 21},
+			wantQuery: `^SELECT .+ FROM "permissions" JOIN group_permissions ON group_permissions.permission_id = permissions.id WHERE group_permissions.group_id = \$1 ORDER BY permissions.name, permissions.id LIMIT 21$`,
+			wantArgs:  []driver.Value{ownerID},
+			want:      []*model.Permission{{ID: itemID, Name: "USER_READ"}},
+		},
+		{
+			name:      "success after the cursor",
+			filter:    &model.GroupPermissionFilter{GroupID: ownerID, After: &model.PageCursor{Key: "a", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT .+ WHERE group_permissions.group_id = \$1 AND \(permissions.name, permissions.id\) > \(\$2, \$3\) ORDER BY permissions.name, permissions.id LIMIT 3$`,
+			wantArgs:  []driver.Value{ownerID, "a", cursorID},
+			want:      []*model.Permission{{ID: itemID, Name: "USER_READ"}},
+		},
+		{
+			name:      "db error",
+			filter:    &model.GroupPermissionFilter{GroupID: ownerID, Limit: 21},
+			wantQuery: `^SELECT .+ FROM "permissions"`,
+			wantArgs:  []driver.Value{ownerID},
+			mockErr:   errors.New("db error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newGroupPermissionRepoMock(t)
+
+			dbMock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(itemID, "USER_READ")).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindPermissions(context.TODO(), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("groupPermissionRepo.FindPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("groupPermissionRepo.FindPermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_groupPermissionRepo_FindGroupsWithPermission(t *testing.T) {
+	permissions := []string{"USER_READ", "USER_ALL", "FULL_ACCESS"}
+	itemID := utils.GenerateUUID()
+	cursorID := utils.GenerateUUID()
+	tests := []struct {
+		name      string
+		filter    *model.PermissionHolderFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      []*model.Group
+		wantErr   bool
+	}{
+		{
+			name:      "success first page",
+			filter:    &model.PermissionHolderFilter{PermissionNames: permissions, Limit: 21},
+			wantQuery: `^SELECT \* FROM "groups" WHERE EXISTS \(SELECT 1 FROM group_permissions JOIN permissions ON permissions.id = group_permissions.permission_id WHERE group_permissions.group_id = groups.id AND permissions.name IN \(\$1,\$2,\$3\)\) ORDER BY groups.name, groups.id LIMIT 21$`,
+			wantArgs:  []driver.Value{"USER_READ", "USER_ALL", "FULL_ACCESS"},
+			want:      []*model.Group{{ID: itemID, Name: "SUPER_USER"}},
+		},
+		{
+			name:      "success after the cursor",
+			filter:    &model.PermissionHolderFilter{PermissionNames: permissions, After: &model.PageCursor{Key: "a", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT .+ permissions.name IN \(\$1,\$2,\$3\)\)\) AND \(groups.name, groups.id\) > \(\$4, \$5\) ORDER BY groups.name, groups.id LIMIT 3$`,
+			wantArgs:  []driver.Value{"USER_READ", "USER_ALL", "FULL_ACCESS", "a", cursorID},
+			want:      []*model.Group{{ID: itemID, Name: "SUPER_USER"}},
+		},
+		{
+			name:      "db error",
+			filter:    &model.PermissionHolderFilter{PermissionNames: permissions, Limit: 21},
+			wantQuery: `^SELECT .+ FROM "groups"`,
+			wantArgs:  []driver.Value{"USER_READ", "USER_ALL", "FULL_ACCESS"},
+			mockErr:   errors.New("db error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newGroupPermissionRepoMock(t)
+
+			dbMock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(itemID, "SUPER_USER")).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindGroupsWithPermission(context.TODO(), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("groupPermissionRepo.FindGroupsWithPermission() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("groupPermissionRepo.FindGroupsWithPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -130,6 +130,40 @@ func (r *groupRepository) FindByName(ctx context.Context, name string) (*model.G
 	return group, nil
 }
 
+func (r *groupRepository) FindAll(ctx context.Context, filter *model.GroupFilter) ([]*model.GroupSummary, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"namePrefix": filter.NamePrefix,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	query := db.WithContext(ctx).Table("groups").
+		Select("groups.id, groups.name, "+
+			"(SELECT COUNT(*) FROM user_groups JOIN users ON users.id = user_groups.user_id "+
+			"WHERE user_groups.group_id = groups.id AND users.status <> ?) AS member_count, "+
+			"(SELECT COUNT(*) FROM group_permissions WHERE group_permissions.group_id = groups.id) AS permission_count",
+			model.UserStatusDeleted)
+
+	if filter.NamePrefix != "" {
+		query = query.Where("groups.name LIKE ?", escapeLike(filter.NamePrefix)+"%")
+	}
+	if filter.After != nil {
+		query = query.Where("(groups.name, groups.id) > (?, ?)", filter.After.Key, filter.After.ID)
+	}
+
+	groups := make([]*model.GroupSummary, 0)
+	err := query.Order("groups.name, groups.id").Limit(filter.Limit).Find(&groups).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return groups, nil
+}
+
 func (r *groupRepository) Update(ctx context.Context, group *model.Group) error {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
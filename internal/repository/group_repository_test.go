@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
@@ -343,6 +344,76 @@ func Test_groupRepository_FindByName(t *testing.T) {
 	}
 }
 
+func Test_groupRepository_FindAll(t *testing.T) {
+	groupID := utils.GenerateUUID()
+	cursorID := utils.GenerateUUID()
+	tests := []struct {
+		name      string
+		filter    *model.GroupFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      []*model.GroupSummary
+		wantErr   bool
+	}{
+		{
+			name:      "success name prefix",
+			filter:    &model.GroupFilter{NamePrefix: "SUPER_", Limit: 21},
+			wantQuery: `^SELECT groups.id, groups.name, \(SELECT COUNT\(\*\) FROM user_groups JOIN users ON users.id = user_groups.user_id WHERE user_groups.group_id = groups.id AND users.status <> \$1\) AS member_count, .+ AS permission_count FROM "groups" WHERE groups.name LIKE \$2 ORDER BY groups.name, groups.id LIMIT 21$`,
+			wantArgs:  []driver.Value{model.UserStatusDeleted, `SUPER\_%`},
+			want: []*model.GroupSummary{
+				{Group: model.Group{ID: groupID, Name: "SUPER_USER"}, MemberCount: 2, PermissionCount: 30},
+			},
+		},
+		{
+			name:      "success after the cursor",
+			filter:    &model.GroupFilter{After: &model.PageCursor{Key: "DEFAULT", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT .+ FROM "groups" WHERE \(groups.name, groups.id\) > \(\$2, \$3\) ORDER BY groups.name, groups.id LIMIT 3$`,
+			wantArgs:  []driver.Value{model.UserStatusDeleted, "DEFAULT", cursorID},
+			want: []*model.GroupSummary{
+				{Group: model.Group{ID: groupID, Name: "SUPER_USER"}, MemberCount: 2, PermissionCount: 30},
+			},
+		},
+		{
+			name:      "success name prefix after the cursor",
+			filter:    &model.GroupFilter{NamePrefix: "SUPER_", After: &model.PageCursor{Key: "SUPER_ADMIN", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT .+ FROM "groups" WHERE groups.name LIKE \$2 AND \(groups.name, groups.id\) > \(\$3, \$4\) ORDER BY groups.name, groups.id LIMIT 3$`,
+			wantArgs:  []driver.Value{model.UserStatusDeleted, `SUPER\_%`, "SUPER_ADMIN", cursorID},
+			want: []*model.GroupSummary{
+				{Group: model.Group{ID: groupID, Name: "SUPER_USER"}, MemberCount: 2, PermissionCount: 30},
+			},
+		},
+		{
+			name:      "db error",
+			filter:    &model.GroupFilter{Limit: 21},
+			wantQuery: `^SELECT .+ FROM "groups"`,
+			wantArgs:  []driver.Value{model.UserStatusDeleted},
+			mockErr:   errors.New("db error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newGroupRepoMock(t)
+
+			dbMock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "member_count", "permission_count"}).
+					AddRow(groupID, "SUPER_USER", 2, 30)).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindAll(context.TODO(), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("groupRepository.FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("groupRepository.FindAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_groupRepository_Update(t *testing.T) {
 	type args struct {
 		group *model.Group
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxIdentityProviderResponseSize cap the body read from an upstream provider.
+const maxIdentityProviderResponseSize = 1 << 20
+
+var defaultIdentityProviderScopes = []string{model.OIDCScopeOpenID, model.OIDCScopeEmail, model.OIDCScopeProfile}
+
+type identityProviderRepository struct {
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery map[string]*model.OpenIDConfiguration
+}
+
+func NewIdentityProviderRepository() model.IdentityProviderRepository {
+	return &identityProviderRepository{
+		discovery: make(map[string]*model.OpenIDConfiguration),
+	}
+}
+
+type identityProviderTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+}
+
+type identityProviderUserInfo struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+func (r *identityProviderRepository) Providers() []string {
+	providers := config.IdentityProviders()
+	names := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		names = append(names, provider.Name)
+	}
+	return names
+}
+
+func (r *identityProviderRepository) AuthorizationURL(ctx context.Context, provider string, state string, codeChallenge string) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	idp, err := findIdentityProvider(provider)
+	if err != nil {
+		return "", err
+	}
+
+	discovery, err := r.discover(ctx, idp)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		log.WithField("provider", provider).Error(err.Error())
+		return "", model.ErrIdentityProviderExchange
+	}
+
+	scopes := idp.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultIdentityProviderScopes
+	}
+
+	query := authorizationURL.Query()
+	query.Set("response_type", model.OAuthResponseTypeCode)
+	query.Set("client_id", idp.ClientID)
+	query.Set("redirect_uri", federatedCallbackURL())
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", model.OAuthCodeChallengeMethodS256)
+	authorizationURL.RawQuery = query.Encode()
+
+	return authorizationURL.String(), nil
+}
+
+func (r *identityProviderRepository) Exchange(ctx context.Context, provider string, code string, codeVerifier string) (*model.ExternalIdentity, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"provider": provider,
+	})
+
+	idp, err := findIdentityProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	discovery, err := r.discover(ctx, idp)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", model.OAuthGrantTypeAuthorizationCode)
+	form.Set("code", code)
+	form.Set("redirect_uri", federatedCallbackURL())
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(idp.ClientID), url.QueryEscape(idp.ClientSecret))
+
+	tokenResponse := new(identityProviderTokenResponse)
+	err = r.doJSON(req, tokenResponse)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, model.ErrIdentityProviderExchange
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, model.ErrIdentityProviderExchange
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, discovery.UserInfoEndpoint, nil)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+tokenResponse.AccessToken)
+
+	userInfo := new(identityProviderUserInfo)
+	err = r.doJSON(req, userInfo)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, model.ErrIdentityProviderExchange
+	}
+	if userInfo.Subject == "" {
+		return nil, model.ErrExternalIdentityIncomplete
+	}
+
+	return &model.ExternalIdentity{
+		Provider:          idp.Name,
+		Subject:           userInfo.Subject,
+		Email:             strings.ToLower(userInfo.Email),
+		EmailVerified:     userInfo.Email != "" && (userInfo.EmailVerified || idp.TrustEmail),
+		Name:              userInfo.Name,
+		PreferredUsername: userInfo.PreferredUsername,
+	}, nil
+}
+
+// discover fetch the provider metadata once and keep it for the lifetime of the process.
+func (r *identityProviderRepository) discover(ctx context.Context, idp *config.IdentityProvider) (*model.OpenIDConfiguration, error) {
+	issuer := strings.TrimSuffix(idp.Issuer, "/")
+
+	r.mu.Lock()
+	discovery, ok := r.discovery[issuer]
+	r.mu.Unlock()
+	if ok {
+		return discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	discovery = new(model.OpenIDConfiguration)
+	err = r.doJSON(req, discovery)
+	if err != nil {
+		log.WithField("issuer", issuer).Error(err.Error())
+		return nil, model.ErrIdentityProviderExchange
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.UserInfoEndpoint == "" {
+		log.WithField("issuer", issuer).Error("incomplete openid configuration")
+		return nil, model.ErrIdentityProviderExchange
+	}
+
+	r.mu.Lock()
+	r.discovery[issuer] = discovery
+	r.mu.Unlock()
+
+	return discovery, nil
+}
+
+func (r *identityProviderRepository) doJSON(req *http.Request, dest interface{}) error {
+	req.Header.Set("Accept", "application/json")
+
+	res, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxIdentityProviderResponseSize))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("%s %s: unexpected status %d", req.Method, req.URL.Redacted(), res.StatusCode)
+	}
+
+	return json.Unmarshal(body, dest)
+}
+
+func findIdentityProvider(name string) (*config.IdentityProvider, error) {
+	for _, provider := range config.IdentityProviders() {
+		if provider.Name == name {
+			return &provider, nil
+		}
+	}
+	return nil, model.ErrIdentityProviderNotFound
+}
+
+func federatedCallbackURL() string {
+	return config.OAuthIssuer() + model.FederatedCallbackPath
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	testIdentityProviderCode         = "upstream-code"
+	testIdentityProviderAccessToken  = "upstream-access-token"
+	testIdentityProviderClientID     = "auth-service"
+	testIdentityProviderClientSecret = "upstream-secret"
+)
+
+func newIdentityProviderServer(t *testing.T, userInfo map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, &model.OpenIDConfiguration{
+			Issuer:                server.URL,
+			AuthorizationEndpoint: server.URL + "/authorize",
+			TokenEndpoint:         server.URL + "/token",
+			UserInfoEndpoint:      server.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != testIdentityProviderClientID || clientSecret != testIdentityProviderClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.PostFormValue("code") != testIdentityProviderCode || r.PostFormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, map[string]string{"access_token": testIdentityProviderAccessToken, "token_type": "Bearer"})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testIdentityProviderAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, userInfo)
+	})
+
+	viper.Set("identity_providers", []map[string]interface{}{
+		{
+			"name":          "corporate",
+			"issuer":        server.URL,
+			"client_id":     testIdentityProviderClientID,
+			"client_secret": testIdentityProviderClientSecret,
+		},
+	})
+	t.Cleanup(func() { viper.Set("identity_providers", nil) })
+
+	return server
+}
+
+func newIdentityProviderRepoMock(t *testing.T) model.IdentityProviderRepository {
+	identityProviderRepo := NewIdentityProviderRepository()
+	err := identityProviderRepo.InjectHTTPClient(http.DefaultClient)
+	utils.ContinueOrFatal(err)
+	return identityProviderRepo
+}
+
+func Test_identityProviderRepository_AuthorizationURL(t *testing.T) {
+	server := newIdentityProviderServer(t, nil)
+	viper.Set("oauth.issuer", "https://auth.example.com")
+	defer viper.Set("oauth.issuer", nil)
+
+	tests := []struct {
+		name     string
+		provider string
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			provider: "corporate",
+		},
+		{
+			name:     "unknown provider",
+			provider: "other",
+			wantErr:  model.ErrIdentityProviderNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newIdentityProviderRepoMock(t)
+			got, err := r.AuthorizationURL(context.TODO(), tt.provider, "state", "challenge")
+			if err != tt.wantErr {
+				t.Errorf("identityProviderRepository.AuthorizationURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			authorizationURL, err := url.Parse(got)
+			utils.ContinueOrFatal(err)
+			want := url.Values{
+				"response_type":         {"code"},
+				"client_id":             {testIdentityProviderClientID},
+				"redirect_uri":          {"https://auth.example.com/federated/callback"},
+				"scope":                 {"openid email profile"},
+				"state":                 {"state"},
+				"code_challenge":        {"challenge"},
+				"code_challenge_method": {"S256"},
+			}
+			if authorizationURL.Host != server.Listener.Addr().String() || !reflect.DeepEqual(authorizationURL.Query(), want) {
+				t.Errorf("identityProviderRepository.AuthorizationURL() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_identityProviderRepository_Exchange(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		userInfo map[string]interface{}
+		want     *model.ExternalIdentity
+		wantErr  error
+	}{
+		{
+			name: "success",
+			code: testIdentityProviderCode,
+			userInfo: map[string]interface{}{
+				"sub":                "248289761001",
+				"email":              "Jane@example.com",
+				"email_verified":     true,
+				"name":               "Jane Doe",
+				"preferred_username": "jane",
+			},
+			want: &model.ExternalIdentity{
+				Provider:          "corporate",
+				Subject:           "248289761001",
+				Email:             "jane@example.com",
+				EmailVerified:     true,
+				Name:              "Jane Doe",
+				PreferredUsername: "jane",
+			},
+		},
+		{
+			name: "unverified email",
+			code: testIdentityProviderCode,
+			userInfo: map[string]interface{}{
+				"sub":   "248289761001",
+				"email": "jane@example.com",
+			},
+			want: &model.ExternalIdentity{
+				Provider: "corporate",
+				Subject:  "248289761001",
+				Email:    "jane@example.com",
+			},
+		},
+		{
+			name: "missing subject",
+			code: testIdentityProviderCode,
+			userInfo: map[string]interface{}{
+				"email": "jane@example.com",
+			},
+			wantErr: model.ErrExternalIdentityIncomplete,
+		},
+		{
+			name:    "rejected code",
+			code:    "other-code",
+			wantErr: model.ErrIdentityProviderExchange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newIdentityProviderServer(t, tt.userInfo)
+			r := newIdentityProviderRepoMock(t)
+
+			got, err := r.Exchange(context.TODO(), "corporate", tt.code, "verifier")
+			if err != tt.wantErr {
+				t.Errorf("identityProviderRepository.Exchange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("identityProviderRepository.Exchange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+type loginAttemptRepository struct {
+	redisClient *goredis.Client
+}
+
+func NewLoginAttemptRepository() model.LoginAttemptRepository {
+	return new(loginAttemptRepository)
+}
+
+func (r *loginAttemptRepository) IncrementFailures(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"subject": subject,
+	})
+
+	cacheKey := model.NewLoginFailuresCacheKey(subject)
+
+	var incr *goredis.IntCmd
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		incr = pipe.Incr(ctx, cacheKey)
+		pipe.Expire(ctx, cacheKey, window)
+		return nil
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+func (r *loginAttemptRepository) Block(ctx context.Context, subject string, block *model.LoginBlock) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"subject": subject,
+	})
+
+	expiration := time.Until(block.Until)
+	if expiration <= 0 {
+		return nil
+	}
+
+	value, err := json.Marshal(block)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = r.redisClient.Set(ctx, model.NewLoginBlockCacheKey(subject), value, expiration).Err()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *loginAttemptRepository) FindBlock(ctx context.Context, subject string) (*model.LoginBlock, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"subject": subject,
+	})
+
+	value, err := r.redisClient.Get(ctx, model.NewLoginBlockCacheKey(subject)).Bytes()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	block := new(model.LoginBlock)
+	err = json.Unmarshal(value, block)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return block, nil
+}
+
+func (r *loginAttemptRepository) Reset(ctx context.Context, subject string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	err := r.redisClient.Del(ctx, model.NewLoginFailuresCacheKey(subject), model.NewLoginBlockCacheKey(subject)).Err()
+	if err != nil {
+		log.WithField("subject", subject).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newLoginAttemptRepoMock(t *testing.T) (model.LoginAttemptRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	loginAttemptRepo := NewLoginAttemptRepository()
+	err = loginAttemptRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return loginAttemptRepo, miniRedis
+}
+
+func Test_loginAttemptRepository_IncrementFailures(t *testing.T) {
+	subject := model.NewLoginAttemptUserSubject("User1")
+	tests := []struct {
+		name      string
+		failures  int
+		fastFwd   time.Duration
+		wantCount int64
+	}{
+		{
+			name:      "first failure",
+			failures:  1,
+			wantCount: 1,
+		},
+		{
+			name:      "failures add up",
+			failures:  3,
+			wantCount: 3,
+		},
+		{
+			name:      "failures forgotten after the window",
+			failures:  3,
+			fastFwd:   20 * time.Minute,
+			wantCount: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newLoginAttemptRepoMock(t)
+
+			for i := 0; i < tt.failures-1; i++ {
+				_, err := r.IncrementFailures(context.TODO(), subject, 15*time.Minute)
+				utils.ContinueOrFatal(err)
+			}
+			redisMock.FastForward(tt.fastFwd)
+
+			got, err := r.IncrementFailures(context.TODO(), subject, 15*time.Minute)
+			if err != nil {
+				t.Errorf("loginAttemptRepository.IncrementFailures() error = %v", err)
+				return
+			}
+			if got != tt.wantCount {
+				t.Errorf("loginAttemptRepository.IncrementFailures() = %v, want %v", got, tt.wantCount)
+			}
+			if ttl := redisMock.TTL(model.NewLoginFailuresCacheKey(subject)); ttl != 15*time.Minute {
+				t.Errorf("loginAttemptRepository.IncrementFailures() ttl = %v, want %v", ttl, 15*time.Minute)
+			}
+		})
+	}
+}
+
+func Test_loginAttemptRepository_Block(t *testing.T) {
+	subject := model.NewLoginAttemptIPSubject("10.0.0.1")
+	tests := []struct {
+		name    string
+		block   *model.LoginBlock
+		fastFwd time.Duration
+		want    bool
+	}{
+		{
+			name:  "locked",
+			block: &model.LoginBlock{Locked: true, Until: time.Now().Add(15 * time.Minute)},
+			want:  true,
+		},
+		{
+			name:  "back-off",
+			block: &model.LoginBlock{Until: time.Now().Add(2 * time.Second)},
+			want:  true,
+		},
+		{
+			name:    "unlocked after the cool-down",
+			block:   &model.LoginBlock{Locked: true, Until: time.Now().Add(15 * time.Minute)},
+			fastFwd: 16 * time.Minute,
+			want:    false,
+		},
+		{
+			name:  "block already over",
+			block: &model.LoginBlock{Locked: true, Until: time.Now().Add(-time.Second)},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newLoginAttemptRepoMock(t)
+
+			err := r.Block(context.TODO(), subject, tt.block)
+			if err != nil {
+				t.Errorf("loginAttemptRepository.Block() error = %v", err)
+				return
+			}
+			redisMock.FastForward(tt.fastFwd)
+
+			got, err := r.FindBlock(context.TODO(), subject)
+			if err != nil {
+				t.Errorf("loginAttemptRepository.FindBlock() error = %v", err)
+				return
+			}
+			if (got != nil) != tt.want {
+				t.Errorf("loginAttemptRepository.FindBlock() = %v, want block %v", got, tt.want)
+				return
+			}
+			if got != nil && (got.Locked != tt.block.Locked || !got.Until.Equal(tt.block.Until)) {
+				t.Errorf("loginAttemptRepository.FindBlock() = %v, want %v", got, tt.block)
+			}
+		})
+	}
+}
+
+func Test_loginAttemptRepository_Reset(t *testing.T) {
+	r, redisMock := newLoginAttemptRepoMock(t)
+	subject := model.NewLoginAttemptUserSubject("user1")
+
+	_, err := r.IncrementFailures(context.TODO(), subject, 15*time.Minute)
+	utils.ContinueOrFatal(err)
+	err = r.Block(context.TODO(), subject, &model.LoginBlock{Locked: true, Until: time.Now().Add(15 * time.Minute)})
+	utils.ContinueOrFatal(err)
+
+	err = r.Reset(context.TODO(), subject)
+	if err != nil {
+		t.Errorf("loginAttemptRepository.Reset() error = %v", err)
+		return
+	}
+	if redisMock.Exists(model.NewLoginFailuresCacheKey(subject)) || redisMock.Exists(model.NewLoginBlockCacheKey(subject)) {
+		t.Errorf("loginAttemptRepository.Reset() kept the failures or the block")
+	}
+}
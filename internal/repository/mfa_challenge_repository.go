@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// incrementChallengeAttemptsScript count one more attempt on the challenge in a single step, so
+// concurrent guesses can't read the same count. An expired challenge is not recreated, 0 is returned.
+var incrementChallengeAttemptsScript = goredis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+return redis.call("HINCRBY", KEYS[1], "attempts", 1)
+`)
+
+type mfaChallengeRepository struct {
+	redisClient *goredis.Client
+}
+
+func NewMFAChallengeRepository() model.MFAChallengeRepository {
+	return new(mfaChallengeRepository)
+}
+
+func (r *mfaChallengeRepository) Create(ctx context.Context, token string, data *model.MFAChallenge, expiration time.Duration) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID": data.UserID,
+	})
+
+	cacheKey := model.NewMFAChallengeCacheKey(utils.HashSecret(token))
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.HSet(ctx, cacheKey, "user_id", data.UserID, "attempts", data.Attempts)
+		pipe.Expire(ctx, cacheKey, expiration)
+		return nil
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *mfaChallengeRepository) Find(ctx context.Context, token string) (*model.MFAChallenge, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	cachedData, err := r.redisClient.HGetAll(ctx, model.NewMFAChallengeCacheKey(utils.HashSecret(token))).Result()
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+	if len(cachedData) == 0 {
+		return nil, nil
+	}
+
+	attempts, _ := strconv.Atoi(cachedData["attempts"])
+	return &model.MFAChallenge{
+		UserID:   cachedData["user_id"],
+		Attempts: attempts,
+	}, nil
+}
+
+func (r *mfaChallengeRepository) IncrementAttempts(ctx context.Context, token string) (int64, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	attempts, err := incrementChallengeAttemptsScript.Run(ctx, r.redisClient,
+		[]string{model.NewMFAChallengeCacheKey(utils.HashSecret(token))},
+	).Int64()
+	if err != nil {
+		log.Error(err.Error())
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+func (r *mfaChallengeRepository) Delete(ctx context.Context, token string) (bool, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	deleted, err := r.redisClient.Del(ctx, model.NewMFAChallengeCacheKey(utils.HashSecret(token))).Result()
+	if err != nil {
+		log.Error(err.Error())
+		return false, err
+	}
+
+	return deleted > 0, nil
+}
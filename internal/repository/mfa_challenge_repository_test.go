@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newMFAChallengeRepoMock(t *testing.T) (model.MFAChallengeRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	mfaChallengeRepo := NewMFAChallengeRepository()
+	err = mfaChallengeRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return mfaChallengeRepo, miniRedis
+}
+
+func Test_mfaChallengeRepository_Find(t *testing.T) {
+	var (
+		token     = model.MFATokenPrefix + "token"
+		challenge = &model.MFAChallenge{
+			UserID: utils.GenerateUUID(),
+		}
+	)
+	tests := []struct {
+		name      string
+		findToken string
+		expired   bool
+		want      *model.MFAChallenge
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			findToken: token,
+			want:      challenge,
+		},
+		{
+			name:      "unknown token",
+			findToken: model.MFATokenPrefix + "other-token",
+			want:      nil,
+		},
+		{
+			name:      "expired token",
+			findToken: token,
+			expired:   true,
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newMFAChallengeRepoMock(t)
+
+			err := r.Create(context.TODO(), token, challenge, time.Minute)
+			utils.ContinueOrFatal(err)
+			if redisMock.Exists(model.NewMFAChallengeCacheKey(token)) {
+				t.Errorf("mfaChallengeRepository.Create() stored the plain token")
+			}
+			if tt.expired {
+				redisMock.FastForward(2 * time.Minute)
+			}
+
+			got, err := r.Find(context.TODO(), tt.findToken)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("mfaChallengeRepository.Find() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mfaChallengeRepository.Find() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_mfaChallengeRepository_IncrementAttemptsAndDelete(t *testing.T) {
+	var (
+		token     = model.MFATokenPrefix + "token"
+		challenge = &model.MFAChallenge{
+			UserID: utils.GenerateUUID(),
+		}
+	)
+	r, redisMock := newMFAChallengeRepoMock(t)
+
+	err := r.Create(context.TODO(), token, challenge, time.Minute)
+	utils.ContinueOrFatal(err)
+
+	// a wrong code doesn't extend the challenge
+	redisMock.FastForward(30 * time.Second)
+	attempts, err := r.IncrementAttempts(context.TODO(), token)
+	if err != nil || attempts != 1 {
+		t.Fatalf("mfaChallengeRepository.IncrementAttempts() = %v, %v, want 1", attempts, err)
+	}
+	got, err := r.Find(context.TODO(), token)
+	if err != nil || got == nil || got.UserID != challenge.UserID || got.Attempts != 1 {
+		t.Fatalf("mfaChallengeRepository.Find() after increment = %v, %v", got, err)
+	}
+	if ttl := redisMock.TTL(model.NewMFAChallengeCacheKey(utils.HashSecret(token))); ttl > 30*time.Second {
+		t.Errorf("mfaChallengeRepository.IncrementAttempts() reset the expiration to %s", ttl)
+	}
+
+	// challenges are single use
+	deleted, err := r.Delete(context.TODO(), token)
+	if err != nil || !deleted {
+		t.Fatalf("mfaChallengeRepository.Delete() = %v, %v, want true", deleted, err)
+	}
+	deleted, err = r.Delete(context.TODO(), token)
+	if err != nil || deleted {
+		t.Errorf("mfaChallengeRepository.Delete() second call = %v, %v, want false", deleted, err)
+	}
+
+	// a deleted challenge is not recreated
+	attempts, err = r.IncrementAttempts(context.TODO(), token)
+	if err != nil || attempts != 0 {
+		t.Fatalf("mfaChallengeRepository.IncrementAttempts() after delete = %v, %v, want 0", attempts, err)
+	}
+	if redisMock.Exists(model.NewMFAChallengeCacheKey(utils.HashSecret(token))) {
+		t.Errorf("mfaChallengeRepository.IncrementAttempts() recreated a deleted challenge")
+	}
+}
+
+func Test_mfaChallengeRepository_IncrementAttempts_concurrent(t *testing.T) {
+	const guesses = 20
+	token := model.MFATokenPrefix + "token"
+	r, _ := newMFAChallengeRepoMock(t)
+
+	err := r.Create(context.TODO(), token, &model.MFAChallenge{UserID: utils.GenerateUUID()}, time.Minute)
+	utils.ContinueOrFatal(err)
+
+	// every concurrent guess must be given its own attempt number
+	var wg sync.WaitGroup
+	results := make(chan int64, guesses)
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attempts, err := r.IncrementAttempts(context.TODO(), token)
+			if err != nil {
+				t.Errorf("mfaChallengeRepository.IncrementAttempts() error = %v", err)
+			}
+			results <- attempts
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[int64]bool)
+	for attempts := range results {
+		if attempts < 1 || attempts > guesses || seen[attempts] {
+			t.Errorf("mfaChallengeRepository.IncrementAttempts() returned attempt %d twice or out of range", attempts)
+		}
+		seen[attempts] = true
+	}
+}
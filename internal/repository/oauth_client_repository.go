@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type oauthClientRepository struct {
+	db          *gorm.DB
+	redisClient *goredis.Client
+}
+
+func NewOAuthClientRepository() model.OAuthClientRepository {
+	return new(oauthClientRepository)
+}
+
+func (r *oauthClientRepository) Create(ctx context.Context, client *model.OAuthClient) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":       client.ID,
+		"clientID": client.ClientID,
+		"name":     client.Name,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(client).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, []string{model.NewOAuthClientCacheKeyByClientID(client.ClientID)})
+
+	return nil
+}
+
+func (r *oauthClientRepository) FindByClientID(ctx context.Context, clientID string) (*model.OAuthClient, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": clientID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	client := new(model.OAuthClient)
+	cacheKey := model.NewOAuthClientCacheKeyByClientID(clientID)
+
+	cachedData, err := Get(ctx, r.redisClient, cacheKey)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	err = json.Unmarshal(cachedData, &client)
+	if err == nil {
+		return client, nil
+	}
+
+	client = new(model.OAuthClient)
+
+	err = db.WithContext(ctx).Where("client_id = ?", clientID).First(client).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = SetWithExpiry(ctx, r.redisClient, cacheKey, nil)
+			if err != nil {
+				logger.Error(err.Error())
+			}
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = SetWithExpiry(ctx, r.redisClient, cacheKey, client)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	return client, nil
+}
+
+func (r *oauthClientRepository) Delete(ctx context.Context, client *model.OAuthClient) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":       client.ID,
+		"clientID": client.ClientID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Where("id = ?", client.ID).Delete(&model.OAuthClient{}).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = DeleteByKeys(ctx, r.redisClient, []string{model.NewOAuthClientCacheKeyByClientID(client.ClientID)})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+func newOAuthClientRepoMock(t *testing.T) (model.OAuthClientRepository, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	dbConn, dbMock := utils.NewDBMock()
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	oauthClientRepo := NewOAuthClientRepository()
+	err = oauthClientRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = oauthClientRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return oauthClientRepo, dbMock, miniRedis
+}
+
+func Test_oauthClientRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newOAuthClientRepoMock(t)
+			client := &model.OAuthClient{
+				ID:               utils.GenerateUUID(),
+				ClientID:         model.OAuthClientIDPrefix + utils.GenerateUUID(),
+				Name:             "dashboard",
+				ClientSecretHash: utils.HashSecret("secret"),
+				RedirectURIs:     []string{"https://dashboard.example.com/callback"},
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			}
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"oauth_clients\"").
+				WithArgs(
+					client.ID,
+					client.ClientID,
+					client.Name,
+					client.ClientSecretHash,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), client); (err != nil) != tt.wantErr {
+				t.Errorf("oauthClientRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_oauthClientRepository_FindByClientID(t *testing.T) {
+	var (
+		clientID = model.OAuthClientIDPrefix + utils.GenerateUUID()
+		client   = &model.OAuthClient{
+			ID:               utils.GenerateUUID(),
+			ClientID:         clientID,
+			Name:             "dashboard",
+			ClientSecretHash: utils.HashSecret("secret"),
+		}
+	)
+	type mockSelect struct {
+		client *model.OAuthClient
+		err    error
+	}
+	tests := []struct {
+		name       string
+		mockSelect *mockSelect
+		mockCache  *model.OAuthClient
+		want       *model.OAuthClient
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			mockSelect: &mockSelect{
+				client: client,
+			},
+			want: client,
+		},
+		{
+			name:      "success found in cache",
+			mockCache: client,
+			want:      client,
+		},
+		{
+			name: "not found",
+			mockSelect: &mockSelect{
+				err: gorm.ErrRecordNotFound,
+			},
+			want: nil,
+		},
+		{
+			name: "db error",
+			mockSelect: &mockSelect{
+				err: errors.New("db error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newOAuthClientRepoMock(t)
+			cacheKey := model.NewOAuthClientCacheKeyByClientID(clientID)
+			if tt.mockSelect != nil {
+				row := sqlmock.NewRows([]string{"id", "client_id", "name", "client_secret_hash"})
+				if tt.mockSelect.client != nil {
+					client := tt.mockSelect.client
+					row.AddRow(client.ID, client.ClientID, client.Name, client.ClientSecretHash)
+				}
+
+				dbMock.ExpectQuery("^SELECT .+ FROM \"oauth_clients\"").
+					WithArgs(clientID).
+					WillReturnRows(row).
+					WillReturnError(tt.mockSelect.err)
+			}
+			if tt.mockCache != nil {
+				cacheData, err := json.Marshal(tt.mockCache)
+				utils.ContinueOrFatal(err)
+				_ = redisMock.Set(cacheKey, string(cacheData))
+			}
+			got, err := r.FindByClientID(context.TODO(), clientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("oauthClientRepository.FindByClientID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && (got.ID != tt.want.ID || got.ClientSecretHash != tt.want.ClientSecretHash)) {
+				t.Errorf("oauthClientRepository.FindByClientID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
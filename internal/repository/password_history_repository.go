@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type passwordHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordHistoryRepository() model.PasswordHistoryRepository {
+	return new(passwordHistoryRepository)
+}
+
+func (r *passwordHistoryRepository) Create(ctx context.Context, history *model.PasswordHistory) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":     history.ID,
+		"userID": history.UserID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(history).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *passwordHistoryRepository) FindLastByUserID(ctx context.Context, userID string, limit int) ([]*model.PasswordHistory, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+		"limit":  limit,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	histories := make([]*model.PasswordHistory, 0)
+
+	err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&histories).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return histories, nil
+}
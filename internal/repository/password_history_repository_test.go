@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func newPasswordHistoryRepoMock() (model.PasswordHistoryRepository, sqlmock.Sqlmock) {
+	dbConn, dbMock := utils.NewDBMock()
+	passwordHistoryRepo := NewPasswordHistoryRepository()
+	err := passwordHistoryRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+
+	return passwordHistoryRepo, dbMock
+}
+
+func Test_passwordHistoryRepository_Create(t *testing.T) {
+	type args struct {
+		history *model.PasswordHistory
+	}
+	tests := []struct {
+		name    string
+		args    args
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				history: &model.PasswordHistory{
+					ID:       utils.GenerateUUID(),
+					UserID:   utils.GenerateUUID(),
+					Password: "hashed-password",
+				},
+			},
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name: "db error",
+			args: args{
+				history: &model.PasswordHistory{
+					ID:       utils.GenerateUUID(),
+					UserID:   utils.GenerateUUID(),
+					Password: "hashed-password",
+				},
+			},
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock := newPasswordHistoryRepoMock()
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"password_history\"").
+				WithArgs(
+					tt.args.history.ID,
+					tt.args.history.UserID,
+					tt.args.history.Password,
+					sqlmock.AnyArg(),
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), tt.args.history); (err != nil) != tt.wantErr {
+				t.Errorf("passwordHistoryRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_passwordHistoryRepository_FindLastByUserID(t *testing.T) {
+	var (
+		userID    = utils.GenerateUUID()
+		histories = []*model.PasswordHistory{
+			{ID: utils.GenerateUUID(), UserID: userID, Password: "newest-hashed-password"},
+			{ID: utils.GenerateUUID(), UserID: userID, Password: "older-hashed-password"},
+		}
+	)
+	tests := []struct {
+		name       string
+		mockResult []*model.PasswordHistory
+		mockErr    error
+		want       []*model.PasswordHistory
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			mockResult: histories,
+			want:       histories,
+		},
+		{
+			name:       "success without history",
+			mockResult: []*model.PasswordHistory{},
+			want:       []*model.PasswordHistory{},
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock := newPasswordHistoryRepoMock()
+
+			row := sqlmock.NewRows([]string{"id", "user_id", "password"})
+			for _, history := range tt.mockResult {
+				row.AddRow(history.ID, history.UserID, history.Password)
+			}
+			dbMock.ExpectQuery("^SELECT .+ FROM \"password_history\" WHERE user_id = .+ ORDER BY created_at DESC LIMIT 2").
+				WithArgs(userID).
+				WillReturnRows(row).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindLastByUserID(context.TODO(), userID, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("passwordHistoryRepository.FindLastByUserID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("passwordHistoryRepository.FindLastByUserID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
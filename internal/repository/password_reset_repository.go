@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+type passwordResetRepository struct {
+	redisClient *goredis.Client
+}
+
+func NewPasswordResetRepository() model.PasswordResetRepository {
+	return new(passwordResetRepository)
+}
+
+func (r *passwordResetRepository) Create(ctx context.Context, token string, data *model.PasswordReset, expiration time.Duration) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID": data.UserID,
+	})
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = r.redisClient.Set(ctx, model.NewPasswordResetCacheKey(utils.HashSecret(token)), value, expiration).Err()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *passwordResetRepository) Find(ctx context.Context, token string) (*model.PasswordReset, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	value, err := r.redisClient.Get(ctx, model.NewPasswordResetCacheKey(utils.HashSecret(token))).Bytes()
+	return decodePasswordReset(value, err)
+}
+
+func (r *passwordResetRepository) Consume(ctx context.Context, token string) (*model.PasswordReset, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	value, err := r.redisClient.GetDel(ctx, model.NewPasswordResetCacheKey(utils.HashSecret(token))).Bytes()
+	return decodePasswordReset(value, err)
+}
+
+func decodePasswordReset(value []byte, err error) (*model.PasswordReset, error) {
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	data := new(model.PasswordReset)
+	err = json.Unmarshal(value, data)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	return data, nil
+}
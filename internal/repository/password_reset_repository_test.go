@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newPasswordResetRepoMock(t *testing.T) (model.PasswordResetRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	passwordResetRepo := NewPasswordResetRepository()
+	err = passwordResetRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return passwordResetRepo, miniRedis
+}
+
+func Test_passwordResetRepository_Consume(t *testing.T) {
+	var (
+		token = model.PasswordResetTokenPrefix + "token"
+		reset = &model.PasswordReset{
+			UserID:              utils.GenerateUUID(),
+			PasswordFingerprint: utils.HashSecret("hashed-password"),
+		}
+	)
+	tests := []struct {
+		name         string
+		consumeToken string
+		expired      bool
+		consumeTwice bool
+		want         *model.PasswordReset
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			consumeToken: token,
+			want:         reset,
+		},
+		{
+			name:         "unknown token",
+			consumeToken: model.PasswordResetTokenPrefix + "other-token",
+			want:         nil,
+		},
+		{
+			name:         "expired token",
+			consumeToken: token,
+			expired:      true,
+			want:         nil,
+		},
+		{
+			name:         "token already used",
+			consumeToken: token,
+			consumeTwice: true,
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newPasswordResetRepoMock(t)
+
+			err := r.Create(context.TODO(), token, reset, time.Hour)
+			utils.ContinueOrFatal(err)
+			if redisMock.Exists(model.NewPasswordResetCacheKey(token)) {
+				t.Errorf("passwordResetRepository.Create() stored the plain token")
+			}
+			if tt.expired {
+				redisMock.FastForward(2 * time.Hour)
+			}
+			if tt.consumeTwice {
+				_, err = r.Consume(context.TODO(), tt.consumeToken)
+				utils.ContinueOrFatal(err)
+			}
+
+			got, err := r.Consume(context.TODO(), tt.consumeToken)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("passwordResetRepository.Consume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("passwordResetRepository.Consume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_passwordResetRepository_Find(t *testing.T) {
+	var (
+		token = model.PasswordResetTokenPrefix + "token"
+		reset = &model.PasswordReset{
+			UserID:              utils.GenerateUUID(),
+			PasswordFingerprint: utils.HashSecret("hashed-password"),
+		}
+	)
+	r, _ := newPasswordResetRepoMock(t)
+
+	err := r.Create(context.TODO(), token, reset, time.Hour)
+	utils.ContinueOrFatal(err)
+
+	got, err := r.Find(context.TODO(), model.PasswordResetTokenPrefix+"other-token")
+	if err != nil || got != nil {
+		t.Errorf("passwordResetRepository.Find() unknown token = %v, %v, want nil", got, err)
+	}
+
+	// finding the reset doesn't use the token up
+	for i := 0; i < 2; i++ {
+		got, err = r.Find(context.TODO(), token)
+		if err != nil || !reflect.DeepEqual(got, reset) {
+			t.Errorf("passwordResetRepository.Find() = %v, %v, want %v", got, err, reset)
+		}
+	}
+	got, err = r.Consume(context.TODO(), token)
+	if err != nil || !reflect.DeepEqual(got, reset) {
+		t.Errorf("passwordResetRepository.Consume() after find = %v, %v, want %v", got, err, reset)
+	}
+}
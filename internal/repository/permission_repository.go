@@ -134,6 +134,35 @@ func (r *permissionRepository) FindByName(ctx context.Context, name string) (*mo
 	return permission, nil
 }
 
+func (r *permissionRepository) FindAll(ctx context.Context, filter *model.PermissionFilter) ([]*model.Permission, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"namePrefix": filter.NamePrefix,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	query := db.WithContext(ctx).Model(&model.Permission{})
+
+	if filter.NamePrefix != "" {
+		query = query.Where("name LIKE ?", escapeLike(filter.NamePrefix)+"%")
+	}
+	if filter.After != nil {
+		query = query.Where("(name, id) > (?, ?)", filter.After.Key, filter.After.ID)
+	}
+
+	permissions := make([]*model.Permission, 0)
+	err := query.Order("name, id").Limit(filter.Limit).Find(&permissions).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
 func (r *permissionRepository) Update(ctx context.Context, permission *model.Permission) error {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
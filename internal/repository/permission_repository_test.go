@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
@@ -343,6 +344,68 @@ func Test_permissionRepository_FindByName(t *testing.T) {
 	}
 }
 
+func Test_permissionRepository_FindAll(t *testing.T) {
+	permissionID := utils.GenerateUUID()
+	cursorID := utils.GenerateUUID()
+	tests := []struct {
+		name      string
+		filter    *model.PermissionFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      []*model.Permission
+		wantErr   bool
+	}{
+		{
+			name:      "success name prefix",
+			filter:    &model.PermissionFilter{NamePrefix: "USER_", Limit: 21},
+			wantQuery: `^SELECT \* FROM "permissions" WHERE name LIKE \$1 ORDER BY name, id LIMIT 21$`,
+			wantArgs:  []driver.Value{`USER\_%`},
+			want:      []*model.Permission{{ID: permissionID, Name: "USER_READ"}},
+		},
+		{
+			name:      "success after the cursor",
+			filter:    &model.PermissionFilter{After: &model.PageCursor{Key: "GROUP_READ", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT \* FROM "permissions" WHERE \(name, id\) > \(\$1, \$2\) ORDER BY name, id LIMIT 3$`,
+			wantArgs:  []driver.Value{"GROUP_READ", cursorID},
+			want:      []*model.Permission{{ID: permissionID, Name: "USER_READ"}},
+		},
+		{
+			name:      "success name prefix after the cursor",
+			filter:    &model.PermissionFilter{NamePrefix: "USER_", After: &model.PageCursor{Key: "USER_ALL", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT \* FROM "permissions" WHERE name LIKE \$1 AND \(name, id\) > \(\$2, \$3\) ORDER BY name, id LIMIT 3$`,
+			wantArgs:  []driver.Value{`USER\_%`, "USER_ALL", cursorID},
+			want:      []*model.Permission{{ID: permissionID, Name: "USER_READ"}},
+		},
+		{
+			name:      "db error",
+			filter:    &model.PermissionFilter{Limit: 21},
+			wantQuery: `^SELECT \* FROM "permissions"`,
+			mockErr:   errors.New("db error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newPermissionRepoMock(t)
+
+			dbMock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(permissionID, "USER_READ")).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindAll(context.TODO(), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("permissionRepository.FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("permissionRepository.FindAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_permissionRepository_Update(t *testing.T) {
 	type args struct {
 		permission *model.Permission
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type personalAccessTokenRepository struct {
+	db          *gorm.DB
+	redisClient *goredis.Client
+}
+
+func NewPersonalAccessTokenRepository() model.PersonalAccessTokenRepository {
+	return new(personalAccessTokenRepository)
+}
+
+func (r *personalAccessTokenRepository) Create(ctx context.Context, token *model.PersonalAccessToken) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":     token.ID,
+		"userID": token.UserID,
+		"name":   token.Name,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(token).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, []string{model.NewPersonalAccessTokenCacheKeyByTokenHash(token.TokenHash)})
+
+	return nil
+}
+
+func (r *personalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"tokenHash": tokenHash,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	token := new(model.PersonalAccessToken)
+	cacheKey := model.NewPersonalAccessTokenCacheKeyByTokenHash(tokenHash)
+
+	cachedData, err := Get(ctx, r.redisClient, cacheKey)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	err = json.Unmarshal(cachedData, &token)
+	if err == nil {
+		return token, nil
+	}
+
+	token = new(model.PersonalAccessToken)
+
+	err = db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = SetWithExpiry(ctx, r.redisClient, cacheKey, nil)
+			if err != nil {
+				logger.Error(err.Error())
+			}
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = SetWithExpiry(ctx, r.redisClient, cacheKey, token)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	return token, nil
+}
+
+func (r *personalAccessTokenRepository) FindByUserID(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	tokens := make([]*model.PersonalAccessToken, 0)
+
+	err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (r *personalAccessTokenRepository) FindByUserIDAndID(ctx context.Context, userID string, id string) (*model.PersonalAccessToken, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+		"id":     id,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	token := new(model.PersonalAccessToken)
+
+	err := db.WithContext(ctx).Where("user_id = ? AND id = ?", userID, id).First(token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (r *personalAccessTokenRepository) Delete(ctx context.Context, token *model.PersonalAccessToken) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":     token.ID,
+		"userID": token.UserID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Where("id = ?", token.ID).Delete(&model.PersonalAccessToken{}).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = DeleteByKeys(ctx, r.redisClient, []string{model.NewPersonalAccessTokenCacheKeyByTokenHash(token.TokenHash)})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *personalAccessTokenRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	tokens := make([]*model.PersonalAccessToken, 0)
+
+	err := db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("user_id = ?", userID).
+		Delete(&tokens).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	cacheKeys := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		cacheKeys = append(cacheKeys, model.NewPersonalAccessTokenCacheKeyByTokenHash(token.TokenHash))
+	}
+	err = DeleteByKeys(ctx, r.redisClient, cacheKeys)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/lib/pq"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+func newPersonalAccessTokenRepoMock(t *testing.T) (model.PersonalAccessTokenRepository, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	dbConn, dbMock := utils.NewDBMock()
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	personalAccessTokenRepo := NewPersonalAccessTokenRepository()
+	err = personalAccessTokenRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = personalAccessTokenRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return personalAccessTokenRepo, dbMock, miniRedis
+}
+
+func Test_personalAccessTokenRepository_Create(t *testing.T) {
+	type args struct {
+		token *model.PersonalAccessToken
+	}
+	tests := []struct {
+		name    string
+		args    args
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				token: &model.PersonalAccessToken{
+					ID:        utils.GenerateUUID(),
+					UserID:    utils.GenerateUUID(),
+					Name:      "ci",
+					TokenHash: utils.HashSecret("pat_secret"),
+					Scopes:    pq.StringArray{"GROUP_READ"},
+					ExpiredAt: time.Now().Add(time.Hour),
+					CreatedAt: time.Now(),
+				},
+			},
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name: "db error",
+			args: args{
+				token: &model.PersonalAccessToken{
+					ID:        utils.GenerateUUID(),
+					UserID:    utils.GenerateUUID(),
+					Name:      "ci",
+					TokenHash: utils.HashSecret("pat_secret"),
+					Scopes:    pq.StringArray{"GROUP_READ"},
+					ExpiredAt: time.Now().Add(time.Hour),
+					CreatedAt: time.Now(),
+				},
+			},
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newPersonalAccessTokenRepoMock(t)
+			cacheKey := model.NewPersonalAccessTokenCacheKeyByTokenHash(tt.args.token.TokenHash)
+			_ = redisMock.Set(cacheKey, "null")
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"personal_access_tokens\"").
+				WithArgs(
+					tt.args.token.ID,
+					tt.args.token.UserID,
+					tt.args.token.Name,
+					tt.args.token.TokenHash,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), tt.args.token); (err != nil) != tt.wantErr {
+				t.Errorf("personalAccessTokenRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && redisMock.Exists(cacheKey) {
+				t.Errorf("personalAccessTokenRepository.Create() negative cache not cleared")
+			}
+		})
+	}
+}
+
+func Test_personalAccessTokenRepository_FindByTokenHash(t *testing.T) {
+	var (
+		tokenHash = utils.HashSecret("pat_secret")
+		token     = &model.PersonalAccessToken{
+			ID:        utils.GenerateUUID(),
+			UserID:    utils.GenerateUUID(),
+			Name:      "ci",
+			TokenHash: tokenHash,
+			Scopes:    pq.StringArray{"GROUP_READ"},
+			ExpiredAt: time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+			CreatedAt: time.Now().UTC().Truncate(time.Second),
+		}
+	)
+	type mockSelect struct {
+		token *model.PersonalAccessToken
+		err   error
+	}
+	tests := []struct {
+		name       string
+		mockSelect *mockSelect
+		mockCache  *model.PersonalAccessToken
+		want       *model.PersonalAccessToken
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			mockSelect: &mockSelect{
+				token: token,
+			},
+			want: token,
+		},
+		{
+			name:      "success found in cache",
+			mockCache: token,
+			want:      token,
+		},
+		{
+			name: "not found",
+			mockSelect: &mockSelect{
+				err: gorm.ErrRecordNotFound,
+			},
+			want: nil,
+		},
+		{
+			name: "db error",
+			mockSelect: &mockSelect{
+				err: errors.New("db error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newPersonalAccessTokenRepoMock(t)
+			cacheKey := model.NewPersonalAccessTokenCacheKeyByTokenHash(tokenHash)
+			if tt.mockSelect != nil {
+				row := sqlmock.NewRows([]string{"id", "user_id", "name", "token_hash", "scopes", "expired_at", "created_at"})
+				if tt.mockSelect.token != nil {
+					token := tt.mockSelect.token
+					row.AddRow(token.ID, token.UserID, token.Name, token.TokenHash, "{GROUP_READ}", token.ExpiredAt, token.CreatedAt)
+				}
+
+				dbMock.ExpectQuery("^SELECT .+ FROM \"personal_access_tokens\"").
+					WithArgs(tokenHash).
+					WillReturnRows(row).
+					WillReturnError(tt.mockSelect.err)
+			}
+			if tt.mockCache != nil {
+				cacheData, err := json.Marshal(tt.mockCache)
+				utils.ContinueOrFatal(err)
+				_ = redisMock.Set(cacheKey, string(cacheData))
+			}
+			got, err := r.FindByTokenHash(context.TODO(), tokenHash)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("personalAccessTokenRepository.FindByTokenHash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) {
+				t.Errorf("personalAccessTokenRepository.FindByTokenHash() = %v, want %v", got, tt.want)
+				return
+			}
+			if got != nil && (got.ID != tt.want.ID || got.TokenHash != tt.want.TokenHash || len(got.Scopes) != len(tt.want.Scopes)) {
+				t.Errorf("personalAccessTokenRepository.FindByTokenHash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_personalAccessTokenRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newPersonalAccessTokenRepoMock(t)
+			token := &model.PersonalAccessToken{
+				ID:        utils.GenerateUUID(),
+				UserID:    utils.GenerateUUID(),
+				TokenHash: utils.HashSecret("pat_secret"),
+			}
+			cacheKey := model.NewPersonalAccessTokenCacheKeyByTokenHash(token.TokenHash)
+			_ = redisMock.Set(cacheKey, "{}")
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("DELETE FROM \"personal_access_tokens\"").
+				WithArgs(token.ID).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Delete(context.TODO(), token); (err != nil) != tt.wantErr {
+				t.Errorf("personalAccessTokenRepository.Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && redisMock.Exists(cacheKey) {
+				t.Errorf("personalAccessTokenRepository.Delete() cache not cleared")
+			}
+		})
+	}
+}
+
+func Test_personalAccessTokenRepository_DeleteByUserID(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newPersonalAccessTokenRepoMock(t)
+			userID := utils.GenerateUUID()
+			tokenHash := utils.HashSecret("pat_secret")
+			cacheKey := model.NewPersonalAccessTokenCacheKeyByTokenHash(tokenHash)
+			_ = redisMock.Set(cacheKey, "{}")
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery("DELETE FROM \"personal_access_tokens\" WHERE user_id = \\$1 RETURNING").
+				WithArgs(userID).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_hash"}).
+					AddRow(utils.GenerateUUID(), userID, tokenHash)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.DeleteByUserID(context.TODO(), userID); (err != nil) != tt.wantErr {
+				t.Errorf("personalAccessTokenRepository.DeleteByUserID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && redisMock.Exists(cacheKey) {
+				t.Errorf("personalAccessTokenRepository.DeleteByUserID() cache not cleared")
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// local buckets are swept of the full ones once there are more than this
+const maxLocalRateLimitBuckets = 10000
+
+// takeTokenScript refill the bucket for the time elapsed since its last update then take one
+// request out of it. It return whether the request is allowed and the milliseconds until the
+// next one is, the bucket expire once it would be full again.
+var takeTokenScript = goredis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1]) or burst
+local updated_at = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - updated_at) / 1000 * rate)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_at", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000))
+return {allowed, retry_after}
+`)
+
+type rateLimitRepository struct {
+	redisClient *goredis.Client
+
+	// local count alone while redis is unavailable
+	local *localRateLimiter
+}
+
+func NewRateLimitRepository() model.RateLimitRepository {
+	return &rateLimitRepository{
+		local: newLocalRateLimiter(),
+	}
+}
+
+// Take use the bucket shared through redis, falling back to a bucket local to this replica when
+// redis fail so an outage doesn't take the rate limited rpcs down with it.
+func (r *rateLimitRepository) Take(ctx context.Context, bucket string, limit *model.RateLimit) (*model.RateLimitResult, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	now := time.Now()
+	res, err := takeTokenScript.Run(ctx, r.redisClient,
+		[]string{model.NewRateLimitCacheKey(bucket)},
+		limit.RatePerSecond(), limit.Burst, now.UnixMilli(),
+	).Int64Slice()
+	if err != nil || len(res) != 2 {
+		log.WithField("bucket", bucket).Warnf("rate limit counted locally: %v", err)
+		return r.local.take(bucket, limit, now), nil
+	}
+
+	return &model.RateLimitResult{
+		Allowed:    res[0] == 1,
+		RetryAfter: time.Duration(res[1]) * time.Millisecond,
+	}, nil
+}
+
+type localRateLimitBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt is when the bucket is refilled up to its burst
+	fullAt time.Time
+}
+
+type localRateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*localRateLimitBucket
+}
+
+func newLocalRateLimiter() *localRateLimiter {
+	return &localRateLimiter{
+		buckets: make(map[string]*localRateLimitBucket),
+	}
+}
+
+func (l *localRateLimiter) take(bucket string, limit *model.RateLimit, now time.Time) *model.RateLimitResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[bucket]
+	if !ok {
+		if len(l.buckets) >= maxLocalRateLimitBuckets {
+			l.sweep(now)
+		}
+		b = &localRateLimitBucket{tokens: float64(limit.Burst), updatedAt: now}
+		l.buckets[bucket] = b
+	}
+
+	rate := limit.RatePerSecond()
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed.Seconds()*rate)
+	}
+	b.updatedAt = now
+
+	res := &model.RateLimitResult{Allowed: b.tokens >= 1}
+	if res.Allowed {
+		b.tokens--
+	} else {
+		res.RetryAfter = secondsToDuration((1 - b.tokens) / rate)
+	}
+	b.fullAt = now.Add(secondsToDuration((float64(limit.Burst) - b.tokens) / rate))
+
+	return res
+}
+
+// sweep forget the buckets that are full again, a new bucket start full anyway.
+func (l *localRateLimiter) sweep(now time.Time) {
+	for bucket, b := range l.buckets {
+		if !now.Before(b.fullAt) {
+			delete(l.buckets, bucket)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
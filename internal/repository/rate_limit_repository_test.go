@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newRateLimitRepoMock(t *testing.T) (model.RateLimitRepository, *miniredis.Miniredis) {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	rateLimitRepo := NewRateLimitRepository()
+	err = rateLimitRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return rateLimitRepo, miniRedis
+}
+
+func Test_rateLimitRepository_Take(t *testing.T) {
+	limit := &model.RateLimit{Requests: 1, Period: time.Second, Burst: 3}
+	tests := []struct {
+		name           string
+		redisDown      bool
+		takes          int
+		wait           time.Duration
+		wantAllowed    bool
+		wantRetryAfter bool
+	}{
+		{
+			name:        "allowed from a full bucket",
+			takes:       1,
+			wantAllowed: true,
+		},
+		{
+			name:        "allowed up to the burst",
+			takes:       3,
+			wantAllowed: true,
+		},
+		{
+			name:           "rejected over the burst",
+			takes:          4,
+			wantRetryAfter: true,
+		},
+		{
+			name:        "allowed once refilled",
+			takes:       4,
+			wait:        1100 * time.Millisecond,
+			wantAllowed: true,
+		},
+		{
+			name:           "rejected over the burst while redis is down",
+			redisDown:      true,
+			takes:          4,
+			wantRetryAfter: true,
+		},
+		{
+			name:        "allowed up to the burst while redis is down",
+			redisDown:   true,
+			takes:       3,
+			wantAllowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newRateLimitRepoMock(t)
+			if tt.redisDown {
+				redisMock.Close()
+			}
+
+			for i := 0; i < tt.takes-1; i++ {
+				_, err := r.Take(context.TODO(), "login:ip:10.0.0.1", limit)
+				utils.ContinueOrFatal(err)
+			}
+			if tt.wait > 0 {
+				// the bucket is refilled from the wall clock, redis only expire it
+				time.Sleep(tt.wait)
+			}
+
+			got, err := r.Take(context.TODO(), "login:ip:10.0.0.1", limit)
+			if err != nil {
+				t.Fatalf("rateLimitRepository.Take() error = %v", err)
+			}
+			if got.Allowed != tt.wantAllowed {
+				t.Errorf("rateLimitRepository.Take() allowed = %v, want %v", got.Allowed, tt.wantAllowed)
+			}
+			if (got.RetryAfter > 0) != tt.wantRetryAfter || got.RetryAfter > time.Second {
+				t.Errorf("rateLimitRepository.Take() retry after = %s, want retry after %v", got.RetryAfter, tt.wantRetryAfter)
+			}
+
+			// other buckets are counted separately
+			other, err := r.Take(context.TODO(), "login:ip:10.0.0.2", limit)
+			if err != nil || !other.Allowed {
+				t.Errorf("rateLimitRepository.Take() other bucket = %v, %v, want allowed", other, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type securityEventRepository struct {
+	db *gorm.DB
+}
+
+func NewSecurityEventRepository() model.SecurityEventRepository {
+	return new(securityEventRepository)
+}
+
+func (r *securityEventRepository) Create(ctx context.Context, event *model.SecurityEvent) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":        event.ID,
+		"userID":    event.UserID,
+		"eventType": event.EventType,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(event).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func newSecurityEventRepoMock() (model.SecurityEventRepository, sqlmock.Sqlmock) {
+	dbConn, dbMock := utils.NewDBMock()
+	securityEventRepo := NewSecurityEventRepository()
+	err := securityEventRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+
+	return securityEventRepo, dbMock
+}
+
+func Test_securityEventRepository_Create(t *testing.T) {
+	type args struct {
+		event *model.SecurityEvent
+	}
+	tests := []struct {
+		name    string
+		args    args
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				event: &model.SecurityEvent{
+					ID:        utils.GenerateUUID(),
+					UserID:    utils.GenerateUUID(),
+					EventType: model.SecurityEventRefreshTokenReuse,
+					Detail:    "refresh token reused",
+				},
+			},
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name: "db error",
+			args: args{
+				event: &model.SecurityEvent{
+					ID:        utils.GenerateUUID(),
+					UserID:    utils.GenerateUUID(),
+					EventType: model.SecurityEventRefreshTokenReuse,
+					Detail:    "refresh token reused",
+				},
+			},
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock := newSecurityEventRepoMock()
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"security_events\"").
+				WithArgs(
+					tt.args.event.ID,
+					tt.args.event.UserID,
+					tt.args.event.EventType,
+					tt.args.event.Detail,
+					sqlmock.AnyArg(),
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), tt.args.event); (err != nil) != tt.wantErr {
+				t.Errorf("securityEventRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
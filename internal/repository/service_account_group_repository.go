@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type serviceAccountGroupRepository struct {
+	db          *gorm.DB
+	redisClient *goredis.Client
+}
+
+func NewServiceAccountGroupRepository() model.ServiceAccountGroupRepository {
+	return new(serviceAccountGroupRepository)
+}
+
+func (r *serviceAccountGroupRepository) Create(ctx context.Context, data *model.ServiceAccountGroup) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": data.ServiceAccountID,
+		"groupID":          data.GroupID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, []string{model.NewServiceAccountGroupCacheKeyByServiceAccountID(data.ServiceAccountID)})
+
+	return nil
+}
+
+func (r *serviceAccountGroupRepository) FindByServiceAccountIDAndGroupID(ctx context.Context, serviceAccountID, groupID string) (*model.ServiceAccountGroup, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": serviceAccountID,
+		"groupID":          groupID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	serviceAccountGroup := new(model.ServiceAccountGroup)
+
+	err := db.WithContext(ctx).
+		Where("service_account_id = ? AND group_id = ?", serviceAccountID, groupID).
+		First(serviceAccountGroup).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return serviceAccountGroup, nil
+}
+
+func (r *serviceAccountGroupRepository) FindByServiceAccountID(ctx context.Context, serviceAccountID string) ([]*model.ServiceAccountGroup, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": serviceAccountID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	serviceAccountGroups := make([]*model.ServiceAccountGroup, 0)
+
+	cacheKey := model.NewServiceAccountGroupCacheKeyByServiceAccountID(serviceAccountID)
+	cachedData, err := Get(ctx, r.redisClient, cacheKey)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	err = json.Unmarshal(cachedData, &serviceAccountGroups)
+	if err == nil {
+		return serviceAccountGroups, nil
+	}
+
+	serviceAccountGroups = make([]*model.ServiceAccountGroup, 0)
+
+	err = db.WithContext(ctx).
+		Where("service_account_id = ?", serviceAccountID).
+		Find(&serviceAccountGroups).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return serviceAccountGroups, err
+	}
+
+	err = SetWithExpiry(ctx, r.redisClient, cacheKey, serviceAccountGroups)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	return serviceAccountGroups, nil
+}
+
+func (r *serviceAccountGroupRepository) DeleteByServiceAccountIDAndGroupID(ctx context.Context, serviceAccountID, groupID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": serviceAccountID,
+		"groupID":          groupID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).
+		Where("service_account_id = ? AND group_id = ?", serviceAccountID, groupID).
+		Delete(&model.ServiceAccountGroup{}).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, []string{model.NewServiceAccountGroupCacheKeyByServiceAccountID(serviceAccountID)})
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func newServiceAccountGroupRepoMock(t *testing.T) (model.ServiceAccountGroupRepository, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	dbConn, dbMock := utils.NewDBMock()
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	serviceAccountGroupRepo := NewServiceAccountGroupRepository()
+	err = serviceAccountGroupRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = serviceAccountGroupRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return serviceAccountGroupRepo, dbMock, miniRedis
+}
+
+func Test_serviceAccountGroupRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newServiceAccountGroupRepoMock(t)
+			data := &model.ServiceAccountGroup{
+				ServiceAccountID: utils.GenerateUUID(),
+				GroupID:          utils.GenerateUUID(),
+			}
+			cacheKey := model.NewServiceAccountGroupCacheKeyByServiceAccountID(data.ServiceAccountID)
+			_ = redisMock.Set(cacheKey, "[]")
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"service_account_groups\"").
+				WithArgs(data.ServiceAccountID, data.GroupID).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), data); (err != nil) != tt.wantErr {
+				t.Errorf("serviceAccountGroupRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && redisMock.Exists(cacheKey) {
+				t.Errorf("serviceAccountGroupRepository.Create() cache not cleared")
+			}
+		})
+	}
+}
+
+func Test_serviceAccountGroupRepository_FindByServiceAccountID(t *testing.T) {
+	var (
+		serviceAccountID = utils.GenerateUUID()
+		groupID          = utils.GenerateUUID()
+	)
+	tests := []struct {
+		name    string
+		mockErr error
+		want    []*model.ServiceAccountGroup
+		wantErr bool
+	}{
+		{
+			name: "success",
+			want: []*model.ServiceAccountGroup{
+				{
+					ServiceAccountID: serviceAccountID,
+					GroupID:          groupID,
+				},
+			},
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			want:    []*model.ServiceAccountGroup{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newServiceAccountGroupRepoMock(t)
+			row := sqlmock.NewRows([]string{"service_account_id", "group_id"})
+			if tt.mockErr == nil {
+				row.AddRow(serviceAccountID, groupID)
+			}
+			dbMock.ExpectQuery("^SELECT .+ FROM \"service_account_groups\"").
+				WithArgs(serviceAccountID).
+				WillReturnRows(row).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindByServiceAccountID(context.TODO(), serviceAccountID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("serviceAccountGroupRepository.FindByServiceAccountID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("serviceAccountGroupRepository.FindByServiceAccountID() = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && !redisMock.Exists(model.NewServiceAccountGroupCacheKeyByServiceAccountID(serviceAccountID)) {
+				t.Errorf("serviceAccountGroupRepository.FindByServiceAccountID() cache not found")
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type serviceAccountRepository struct {
+	db          *gorm.DB
+	redisClient *goredis.Client
+}
+
+func NewServiceAccountRepository() model.ServiceAccountRepository {
+	return new(serviceAccountRepository)
+}
+
+func (r *serviceAccountRepository) Create(ctx context.Context, serviceAccount *model.ServiceAccount) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":       serviceAccount.ID,
+		"name":     serviceAccount.Name,
+		"clientID": serviceAccount.ClientID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(serviceAccount).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, model.GetServiceAccountCacheKeys(serviceAccount.ID, serviceAccount.ClientID))
+
+	return nil
+}
+
+func (r *serviceAccountRepository) FindByID(ctx context.Context, id string) (*model.ServiceAccount, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return r.findOne(ctx, model.NewServiceAccountCacheKeyByID(id), "id = ?", id)
+}
+
+func (r *serviceAccountRepository) FindByClientID(ctx context.Context, clientID string) (*model.ServiceAccount, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return r.findOne(ctx, model.NewServiceAccountCacheKeyByClientID(clientID), "client_id = ?", clientID)
+}
+
+func (r *serviceAccountRepository) findOne(ctx context.Context, cacheKey string, query string, arg string) (*model.ServiceAccount, error) {
+	logger := logrus.WithFields(logrus.Fields{
+		"cacheKey": cacheKey,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	serviceAccount := new(model.ServiceAccount)
+
+	cachedData, err := Get(ctx, r.redisClient, cacheKey)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	err = json.Unmarshal(cachedData, &serviceAccount)
+	if err == nil {
+		return serviceAccount, nil
+	}
+
+	serviceAccount = new(model.ServiceAccount)
+
+	err = db.WithContext(ctx).Where(query, arg).First(serviceAccount).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = SetWithExpiry(ctx, r.redisClient, cacheKey, nil)
+			if err != nil {
+				logger.Error(err.Error())
+			}
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = SetWithExpiry(ctx, r.redisClient, cacheKey, serviceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	return serviceAccount, nil
+}
+
+func (r *serviceAccountRepository) Delete(ctx context.Context, serviceAccount *model.ServiceAccount) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":       serviceAccount.ID,
+		"clientID": serviceAccount.ClientID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Where("id = ?", serviceAccount.ID).Delete(&model.ServiceAccount{}).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	cacheKeys := model.GetServiceAccountCacheKeys(serviceAccount.ID, serviceAccount.ClientID)
+	cacheKeys = append(cacheKeys, model.NewServiceAccountGroupCacheKeyByServiceAccountID(serviceAccount.ID))
+	err = DeleteByKeys(ctx, r.redisClient, cacheKeys)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
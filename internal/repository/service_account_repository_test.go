@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+func newServiceAccountRepoMock(t *testing.T) (model.ServiceAccountRepository, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	dbConn, dbMock := utils.NewDBMock()
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	serviceAccountRepo := NewServiceAccountRepository()
+	err = serviceAccountRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = serviceAccountRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return serviceAccountRepo, dbMock, miniRedis
+}
+
+func Test_serviceAccountRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newServiceAccountRepoMock(t)
+			serviceAccount := &model.ServiceAccount{
+				ID:               utils.GenerateUUID(),
+				Name:             "billing-service",
+				ClientID:         model.ServiceAccountClientIDPrefix + utils.GenerateUUID(),
+				ClientSecretHash: utils.HashSecret("secret"),
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			}
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"service_accounts\"").
+				WithArgs(
+					serviceAccount.ID,
+					serviceAccount.Name,
+					serviceAccount.ClientID,
+					serviceAccount.ClientSecretHash,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), serviceAccount); (err != nil) != tt.wantErr {
+				t.Errorf("serviceAccountRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_serviceAccountRepository_FindByClientID(t *testing.T) {
+	var (
+		clientID       = model.ServiceAccountClientIDPrefix + utils.GenerateUUID()
+		serviceAccount = &model.ServiceAccount{
+			ID:               utils.GenerateUUID(),
+			Name:             "billing-service",
+			ClientID:         clientID,
+			ClientSecretHash: utils.HashSecret("secret"),
+		}
+	)
+	type mockSelect struct {
+		serviceAccount *model.ServiceAccount
+		err            error
+	}
+	tests := []struct {
+		name       string
+		mockSelect *mockSelect
+		mockCache  *model.ServiceAccount
+		want       *model.ServiceAccount
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			mockSelect: &mockSelect{
+				serviceAccount: serviceAccount,
+			},
+			want: serviceAccount,
+		},
+		{
+			name:      "success found in cache",
+			mockCache: serviceAccount,
+			want:      serviceAccount,
+		},
+		{
+			name: "not found",
+			mockSelect: &mockSelect{
+				err: gorm.ErrRecordNotFound,
+			},
+			want: nil,
+		},
+		{
+			name: "db error",
+			mockSelect: &mockSelect{
+				err: errors.New("db error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newServiceAccountRepoMock(t)
+			cacheKey := model.NewServiceAccountCacheKeyByClientID(clientID)
+			if tt.mockSelect != nil {
+				row := sqlmock.NewRows([]string{"id", "name", "client_id", "client_secret_hash"})
+				if tt.mockSelect.serviceAccount != nil {
+					serviceAccount := tt.mockSelect.serviceAccount
+					row.AddRow(serviceAccount.ID, serviceAccount.Name, serviceAccount.ClientID, serviceAccount.ClientSecretHash)
+				}
+
+				dbMock.ExpectQuery("^SELECT .+ FROM \"service_accounts\"").
+					WithArgs(clientID).
+					WillReturnRows(row).
+					WillReturnError(tt.mockSelect.err)
+			}
+			if tt.mockCache != nil {
+				cacheData, err := json.Marshal(tt.mockCache)
+				utils.ContinueOrFatal(err)
+				_ = redisMock.Set(cacheKey, string(cacheData))
+			}
+			got, err := r.FindByClientID(context.TODO(), clientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("serviceAccountRepository.FindByClientID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && (got.ID != tt.want.ID || got.ClientSecretHash != tt.want.ClientSecretHash)) {
+				t.Errorf("serviceAccountRepository.FindByClientID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
@@ -11,6 +12,24 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// claimRefreshTokenScript delete the refresh token and leave a rotated marker in a single step,
+// so only one of several concurrent callers presenting the same token can rotate it.
+// Return {1, familyID} when claimed, {2, familyID} when already rotated and {0, ""} otherwise.
+var claimRefreshTokenScript = goredis.NewScript(`
+local token = redis.call('GET', KEYS[1])
+if token then
+	local family = redis.call('GET', KEYS[2]) or ''
+	redis.call('DEL', KEYS[1], KEYS[2])
+	redis.call('SET', KEYS[3], family, 'PX', ARGV[1])
+	return {1, family}
+end
+local rotated = redis.call('GET', KEYS[3])
+if rotated then
+	return {2, rotated}
+end
+return {0, ''}
+`)
+
 type tokenRepository struct {
 	redisClient *goredis.Client
 }
@@ -19,7 +38,7 @@ func NewTokenRepository() model.TokenRepository {
 	return new(tokenRepository)
 }
 
-func (r *tokenRepository) Create(ctx context.Context, userID string, tokenID string, tokenType model.TokenType) (string, error) {
+func (r *tokenRepository) Create(ctx context.Context, userID string, familyID string, tokenID string, tokenType model.TokenType, metadata *model.SessionMetadata) (string, error) {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
 	defer span.End()
@@ -31,17 +50,18 @@ func (r *tokenRepository) Create(ctx context.Context, userID string, tokenID str
 	)
 
 	logger := log.WithFields(log.Fields{
-		"userID": userID,
-		"type":   tokenType,
+		"userID":   userID,
+		"familyID": familyID,
+		"type":     tokenType,
 	})
 
 	switch tokenType {
 	case model.AccessToken:
 		expDuration = config.AccessTokenDuration()
-		cacheKey = model.RefreshTokenCacheKey(userID, tokenID)
+		cacheKey = model.AccessTokenCacheKey(userID, tokenID)
 	case model.RefreshToken:
 		expDuration = config.RefreshTokenDuration()
-		cacheKey = model.AccessTokenCacheKey(userID, tokenID)
+		cacheKey = model.RefreshTokenCacheKey(userID, tokenID)
 	default:
 		err = model.ErrInvalidTokenType
 	}
@@ -50,14 +70,21 @@ func (r *tokenRepository) Create(ctx context.Context, userID string, tokenID str
 		return "", err
 	}
 
-	token, err := utils.GenerateToken(tokenID, userID, expDuration)
+	clientID := ""
+	if metadata != nil {
+		clientID = metadata.ClientID
+	}
+	token, err := utils.GenerateClientToken(tokenID, userID, clientID, tokenType, expDuration)
 	if err != nil {
 		logger.Error(err.Error())
 		return "", err
 	}
 
-	err = SetWithExpiry(ctx, r.redisClient, cacheKey, token)
-
+	// the token and its session are written together so no token is live without its session
+	pipe := r.redisClient.TxPipeline()
+	pipe.Set(ctx, cacheKey, token, expDuration)
+	addToFamily(ctx, pipe, userID, familyID, tokenID, metadata)
+	_, err = pipe.Exec(ctx)
 	if err != nil {
 		logger.WithFields(log.Fields{
 			"cacheKey": cacheKey,
@@ -135,3 +162,305 @@ func (r *tokenRepository) Revoke(ctx context.Context, userID string, tokenID str
 	}
 	return nil
 }
+
+// addToFamily queue the registration of the token as the latest member of its refresh token family,
+// along with the session details of the client the family is issued to.
+func addToFamily(ctx context.Context, pipe goredis.Pipeliner, userID string, familyID string, tokenID string, metadata *model.SessionMetadata) {
+	now := time.Now()
+	expDuration := config.RefreshTokenDuration()
+	expiredAt := now.Add(expDuration)
+	familyCacheKey := model.RefreshTokenFamilyCacheKey(userID, familyID)
+	sessionCacheKey := model.SessionCacheKey(userID, familyID)
+	sessionIndexCacheKey := model.SessionIndexCacheKey(userID)
+
+	// the session index is scored by expiry so entries of expired families can be pruned
+	pipe.SAdd(ctx, familyCacheKey, tokenID)
+	pipe.Expire(ctx, familyCacheKey, expDuration)
+	pipe.Set(ctx, model.RefreshTokenFamilyIDCacheKey(userID, tokenID), familyID, expDuration)
+	pipe.HSet(ctx, sessionCacheKey, sessionFields(tokenID, expiredAt, now, metadata)...)
+	pipe.HSetNX(ctx, sessionCacheKey, "created_at", now.UnixMilli())
+	pipe.Expire(ctx, sessionCacheKey, expDuration)
+	pipe.ZAdd(ctx, sessionIndexCacheKey, &goredis.Z{
+		Score:  float64(expiredAt.UnixMilli()),
+		Member: familyID,
+	})
+	pipe.ZRemRangeByScore(ctx, sessionIndexCacheKey, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+	pipe.Expire(ctx, sessionIndexCacheKey, expDuration)
+}
+
+func (r *tokenRepository) ClaimRefreshToken(ctx context.Context, userID string, tokenID string) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID":  userID,
+		"tokenID": tokenID,
+	})
+
+	res, err := claimRefreshTokenScript.Run(ctx, r.redisClient,
+		[]string{
+			model.RefreshTokenCacheKey(userID, tokenID),
+			model.RefreshTokenFamilyIDCacheKey(userID, tokenID),
+			model.RotatedRefreshTokenCacheKey(userID, tokenID),
+		},
+		config.RefreshTokenDuration().Milliseconds(),
+	).Slice()
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+	if len(res) != 2 {
+		return "", model.ErrTokenInvalid
+	}
+
+	state, _ := res[0].(int64)
+	familyID, _ := res[1].(string)
+	switch state {
+	case 1:
+		return familyID, nil
+	case 2:
+		return familyID, model.ErrTokenReused
+	default:
+		return "", model.ErrTokenInvalid
+	}
+}
+
+func (r *tokenRepository) RevokeFamily(ctx context.Context, userID string, familyID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID":   userID,
+		"familyID": familyID,
+	})
+
+	familyCacheKey := model.RefreshTokenFamilyCacheKey(userID, familyID)
+	tokenIDs, err := r.redisClient.SMembers(ctx, familyCacheKey).Result()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	cacheKeys := []string{familyCacheKey, model.SessionCacheKey(userID, familyID)}
+	for _, tokenID := range tokenIDs {
+		cacheKeys = append(cacheKeys,
+			model.AccessTokenCacheKey(userID, tokenID),
+			model.RefreshTokenCacheKey(userID, tokenID),
+			model.RefreshTokenFamilyIDCacheKey(userID, tokenID),
+		)
+	}
+
+	pipe := r.redisClient.TxPipeline()
+	pipe.Del(ctx, cacheKeys...)
+	pipe.ZRem(ctx, model.SessionIndexCacheKey(userID), familyID)
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	return nil
+}
+
+func (r *tokenRepository) ListSessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID": userID,
+	})
+
+	sessionIndexCacheKey := model.SessionIndexCacheKey(userID)
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	err := r.redisClient.ZRemRangeByScore(ctx, sessionIndexCacheKey, "-inf", now).Err()
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	familyIDs, err := r.redisClient.ZRevRange(ctx, sessionIndexCacheKey, 0, -1).Result()
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	sessions := make([]*model.Session, 0)
+	for _, familyID := range familyIDs {
+		session, err := r.findSession(ctx, userID, familyID)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		if session == nil {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (r *tokenRepository) FindSession(ctx context.Context, userID string, tokenID string) (*model.Session, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID":  userID,
+		"tokenID": tokenID,
+	})
+
+	familyID, err := r.findFamilyID(ctx, userID, tokenID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if familyID == "" {
+		return nil, nil
+	}
+
+	session, err := r.findSession(ctx, userID, familyID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *tokenRepository) RevokeSession(ctx context.Context, userID string, tokenID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID":  userID,
+		"tokenID": tokenID,
+	})
+
+	familyID, err := r.findFamilyID(ctx, userID, tokenID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if familyID == "" {
+		return model.ErrSessionNotFound
+	}
+
+	return r.RevokeFamily(ctx, userID, familyID)
+}
+
+func (r *tokenRepository) RevokeAllSessions(ctx context.Context, userID string, exceptTokenID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID":        userID,
+		"exceptTokenID": exceptTokenID,
+	})
+
+	var (
+		exceptFamilyID string
+		err            error
+	)
+	if exceptTokenID != "" {
+		exceptFamilyID, err = r.findFamilyID(ctx, userID, exceptTokenID)
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+	}
+
+	familyIDs, err := r.redisClient.ZRange(ctx, model.SessionIndexCacheKey(userID), 0, -1).Result()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	for _, familyID := range familyIDs {
+		if familyID == exceptFamilyID {
+			continue
+		}
+		err = r.RevokeFamily(ctx, userID, familyID)
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *tokenRepository) findFamilyID(ctx context.Context, userID string, tokenID string) (string, error) {
+	cachedData, err := Get(ctx, r.redisClient, model.RefreshTokenFamilyIDCacheKey(userID, tokenID))
+	if err != nil {
+		return "", err
+	}
+	return string(cachedData), nil
+}
+
+func (r *tokenRepository) findSession(ctx context.Context, userID string, familyID string) (*model.Session, error) {
+	cachedData, err := r.redisClient.HGetAll(ctx, model.SessionCacheKey(userID, familyID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(cachedData) == 0 {
+		return nil, nil
+	}
+
+	return &model.Session{
+		SessionID: familyID,
+		TokenID:   cachedData["token_id"],
+		ExpiredAt: parseUnixMilli(cachedData["expired_at"]),
+		SessionMetadata: model.SessionMetadata{
+			IPAddress:       cachedData["ip_address"],
+			UserAgent:       cachedData["user_agent"],
+			ClientName:      cachedData["client_name"],
+			ClientID:        cachedData["client_id"],
+			DeviceID:        cachedData["device_id"],
+			Scope:           cachedData["scope"],
+			CreatedAt:       parseUnixMilli(cachedData["created_at"]),
+			LastRefreshedAt: parseUnixMilli(cachedData["last_refreshed_at"]),
+		},
+	}, nil
+}
+
+// sessionFields build the session hash fields written on every token issue,
+// client name, client id, device id and scope are kept from earlier issues when the client omit them.
+func sessionFields(tokenID string, expiredAt time.Time, now time.Time, metadata *model.SessionMetadata) []interface{} {
+	fields := []interface{}{
+		"token_id", tokenID,
+		"expired_at", expiredAt.UnixMilli(),
+		"last_refreshed_at", now.UnixMilli(),
+	}
+	if metadata == nil {
+		return fields
+	}
+	fields = append(fields,
+		"ip_address", metadata.IPAddress,
+		"user_agent", metadata.UserAgent,
+	)
+	if metadata.ClientName != "" {
+		fields = append(fields, "client_name", metadata.ClientName)
+	}
+	if metadata.ClientID != "" {
+		fields = append(fields, "client_id", metadata.ClientID)
+	}
+	if metadata.DeviceID != "" {
+		fields = append(fields, "device_id", metadata.DeviceID)
+	}
+	if metadata.Scope != "" {
+		fields = append(fields, "scope", metadata.Scope)
+	}
+	return fields
+}
+
+func parseUnixMilli(value string) time.Time {
+	msec, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(msec)
+}
@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/krobus00/auth-service/internal/infrastructure"
@@ -67,11 +70,19 @@ func Test_tokenRepository_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			r, _ := newTokenRepoMock(t)
 
-			_, err := r.Create(context.TODO(), tt.args.userID, tt.args.tokenID, tt.args.tokenType)
+			token, err := r.Create(context.TODO(), tt.args.userID, tt.args.tokenID, tt.args.tokenID, tt.args.tokenType, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("tokenRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
+
+			isValid, err := r.IsValidToken(context.TODO(), tt.args.userID, tt.args.tokenID, tt.args.tokenType)
+			if err != nil || !isValid {
+				t.Errorf("tokenRepository.Create() token %s is not stored under its own type", token)
+			}
 		})
 	}
 }
@@ -223,3 +234,392 @@ func Test_tokenRepository_Revoke(t *testing.T) {
 		})
 	}
 }
+
+func Test_tokenRepository_ClaimRefreshToken(t *testing.T) {
+	var (
+		userID   = utils.GenerateUUID()
+		familyID = utils.GenerateUUID()
+	)
+	tests := []struct {
+		name         string
+		rotateBefore bool
+		createToken  bool
+		want         string
+		wantErr      error
+	}{
+		{
+			name:        "success claim token",
+			createToken: true,
+			want:        familyID,
+			wantErr:     nil,
+		},
+		{
+			name:         "error token already rotated",
+			createToken:  true,
+			rotateBefore: true,
+			want:         familyID,
+			wantErr:      model.ErrTokenReused,
+		},
+		{
+			name:        "error token not found",
+			createToken: false,
+			want:        "",
+			wantErr:     model.ErrTokenInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, redisMock := newTokenRepoMock(t)
+			tokenID := utils.GenerateUUID()
+
+			if tt.createToken {
+				_, err := r.Create(context.TODO(), userID, familyID, tokenID, model.RefreshToken, nil)
+				utils.ContinueOrFatal(err)
+			}
+			if tt.rotateBefore {
+				_, err := r.ClaimRefreshToken(context.TODO(), userID, tokenID)
+				utils.ContinueOrFatal(err)
+			}
+
+			got, err := r.ClaimRefreshToken(context.TODO(), userID, tokenID)
+			if err != tt.wantErr {
+				t.Errorf("tokenRepository.ClaimRefreshToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("tokenRepository.ClaimRefreshToken() = %v, want %v", got, tt.want)
+			}
+			if redisMock.Exists(model.RefreshTokenCacheKey(userID, tokenID)) {
+				t.Errorf("tokenRepository.ClaimRefreshToken() refresh token still stored")
+			}
+		})
+	}
+}
+
+func Test_tokenRepository_ClaimRefreshToken_ConcurrentRefresh(t *testing.T) {
+	var (
+		userID   = utils.GenerateUUID()
+		tokenID  = utils.GenerateUUID()
+		familyID = tokenID
+		clients  = 2
+	)
+	r, _ := newTokenRepoMock(t)
+
+	_, err := r.Create(context.TODO(), userID, familyID, tokenID, model.RefreshToken, nil)
+	utils.ContinueOrFatal(err)
+
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		results = make(chan error, clients)
+	)
+	for i := 0; i < clients; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			got, err := r.ClaimRefreshToken(context.TODO(), userID, tokenID)
+			if got != familyID {
+				t.Errorf("tokenRepository.ClaimRefreshToken() = %v, want %v", got, familyID)
+			}
+			results <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(results)
+
+	var claimed, reused int
+	for err := range results {
+		switch err {
+		case nil:
+			claimed++
+		case model.ErrTokenReused:
+			reused++
+		default:
+			t.Errorf("tokenRepository.ClaimRefreshToken() unexpected error = %v", err)
+		}
+	}
+	if claimed != 1 || reused != clients-1 {
+		t.Errorf("tokenRepository.ClaimRefreshToken() claimed = %d, reused = %d, want exactly one claim", claimed, reused)
+	}
+}
+
+func Test_tokenRepository_RevokeFamily(t *testing.T) {
+	var (
+		userID   = utils.GenerateUUID()
+		familyID = utils.GenerateUUID()
+		tokenIDs = []string{utils.GenerateUUID(), utils.GenerateUUID()}
+		otherID  = utils.GenerateUUID()
+	)
+	r, _ := newTokenRepoMock(t)
+
+	for _, tokenID := range append(tokenIDs, otherID) {
+		tokenFamilyID := familyID
+		if tokenID == otherID {
+			tokenFamilyID = otherID
+		}
+		_, err := r.Create(context.TODO(), userID, tokenFamilyID, tokenID, model.AccessToken, nil)
+		utils.ContinueOrFatal(err)
+		_, err = r.Create(context.TODO(), userID, tokenFamilyID, tokenID, model.RefreshToken, nil)
+		utils.ContinueOrFatal(err)
+	}
+
+	if err := r.RevokeFamily(context.TODO(), userID, familyID); err != nil {
+		t.Errorf("tokenRepository.RevokeFamily() error = %v", err)
+		return
+	}
+
+	for _, tokenID := range tokenIDs {
+		for _, tokenType := range []model.TokenType{model.AccessToken, model.RefreshToken} {
+			isValid, err := r.IsValidToken(context.TODO(), userID, tokenID, tokenType)
+			if err != nil || isValid {
+				t.Errorf("tokenRepository.RevokeFamily() %s token %s still valid", tokenType, tokenID)
+			}
+		}
+	}
+	for _, tokenType := range []model.TokenType{model.AccessToken, model.RefreshToken} {
+		isValid, err := r.IsValidToken(context.TODO(), userID, otherID, tokenType)
+		if err != nil || !isValid {
+			t.Errorf("tokenRepository.RevokeFamily() revoked %s token of another family", tokenType)
+		}
+	}
+}
+
+func Test_tokenRepository_ListSessions(t *testing.T) {
+	var (
+		userID     = utils.GenerateUUID()
+		familyIDs  = []string{utils.GenerateUUID(), utils.GenerateUUID()}
+		rotatedID  = utils.GenerateUUID()
+		expiredID  = utils.GenerateUUID()
+		expiredKey = model.SessionIndexCacheKey(userID)
+	)
+	r, redisMock := newTokenRepoMock(t)
+
+	for _, familyID := range familyIDs {
+		_, err := r.Create(context.TODO(), userID, familyID, familyID, model.AccessToken, nil)
+		utils.ContinueOrFatal(err)
+	}
+	// rotating the first session keep a single entry pointing to the newest token
+	_, err := r.Create(context.TODO(), userID, familyIDs[0], rotatedID, model.AccessToken, nil)
+	utils.ContinueOrFatal(err)
+	// entries past their expiry are pruned from the index
+	_, err = redisMock.ZAdd(expiredKey, float64(time.Now().Add(-time.Minute).UnixMilli()), expiredID)
+	utils.ContinueOrFatal(err)
+
+	got, err := r.ListSessions(context.TODO(), userID)
+	if err != nil {
+		t.Errorf("tokenRepository.ListSessions() error = %v", err)
+		return
+	}
+	if len(got) != len(familyIDs) {
+		t.Errorf("tokenRepository.ListSessions() got %d sessions, want %d", len(got), len(familyIDs))
+		return
+	}
+	tokenIDs := make(map[string]string)
+	for _, session := range got {
+		tokenIDs[session.SessionID] = session.TokenID
+	}
+	if tokenIDs[familyIDs[0]] != rotatedID || tokenIDs[familyIDs[1]] != familyIDs[1] {
+		t.Errorf("tokenRepository.ListSessions() sessions = %v, want session %s on token %s", tokenIDs, familyIDs[0], rotatedID)
+	}
+	members, err := redisMock.ZMembers(expiredKey)
+	utils.ContinueOrFatal(err)
+	for _, member := range members {
+		if member == expiredID {
+			t.Errorf("tokenRepository.ListSessions() expired session still indexed")
+		}
+	}
+}
+
+func Test_tokenRepository_RevokeAllSessions(t *testing.T) {
+	var (
+		userID    = utils.GenerateUUID()
+		currentID = utils.GenerateUUID()
+		otherIDs  = []string{utils.GenerateUUID(), utils.GenerateUUID()}
+	)
+	tests := []struct {
+		name          string
+		exceptTokenID string
+		wantSessions  int
+	}{
+		{
+			name:          "success except current",
+			exceptTokenID: currentID,
+			wantSessions:  1,
+		},
+		{
+			name:          "success revoke every session",
+			exceptTokenID: "",
+			wantSessions:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newTokenRepoMock(t)
+
+			for _, tokenID := range append(otherIDs, currentID) {
+				_, err := r.Create(context.TODO(), userID, tokenID, tokenID, model.AccessToken, nil)
+				utils.ContinueOrFatal(err)
+				_, err = r.Create(context.TODO(), userID, tokenID, tokenID, model.RefreshToken, nil)
+				utils.ContinueOrFatal(err)
+			}
+
+			if err := r.RevokeAllSessions(context.TODO(), userID, tt.exceptTokenID); err != nil {
+				t.Errorf("tokenRepository.RevokeAllSessions() error = %v", err)
+				return
+			}
+
+			sessions, err := r.ListSessions(context.TODO(), userID)
+			utils.ContinueOrFatal(err)
+			if len(sessions) != tt.wantSessions {
+				t.Errorf("tokenRepository.RevokeAllSessions() left %d sessions, want %d", len(sessions), tt.wantSessions)
+			}
+			for _, tokenID := range otherIDs {
+				isValid, err := r.IsValidToken(context.TODO(), userID, tokenID, model.AccessToken)
+				if err != nil || isValid {
+					t.Errorf("tokenRepository.RevokeAllSessions() access token %s still valid", tokenID)
+				}
+			}
+			isValid, err := r.IsValidToken(context.TODO(), userID, currentID, model.AccessToken)
+			if err != nil || isValid != (tt.exceptTokenID != "") {
+				t.Errorf("tokenRepository.RevokeAllSessions() current access token valid = %v", isValid)
+			}
+		})
+	}
+}
+
+func Test_tokenRepository_RevokeSession(t *testing.T) {
+	var (
+		userID  = utils.GenerateUUID()
+		tokenID = utils.GenerateUUID()
+	)
+	r, _ := newTokenRepoMock(t)
+
+	_, err := r.Create(context.TODO(), userID, tokenID, tokenID, model.AccessToken, nil)
+	utils.ContinueOrFatal(err)
+
+	if err := r.RevokeSession(context.TODO(), userID, tokenID); err != nil {
+		t.Errorf("tokenRepository.RevokeSession() error = %v", err)
+	}
+	if err := r.RevokeSession(context.TODO(), userID, utils.GenerateUUID()); err != model.ErrSessionNotFound {
+		t.Errorf("tokenRepository.RevokeSession() error = %v, wantErr %v", err, model.ErrSessionNotFound)
+	}
+}
+
+func Test_tokenRepository_FindSession(t *testing.T) {
+	var (
+		userID    = utils.GenerateUUID()
+		familyID  = utils.GenerateUUID()
+		rotatedID = utils.GenerateUUID()
+	)
+	r, _ := newTokenRepoMock(t)
+
+	_, err := r.Create(context.TODO(), userID, familyID, familyID, model.AccessToken, &model.SessionMetadata{
+		ClientName: "dashboard",
+		Scope:      "openid",
+	})
+	utils.ContinueOrFatal(err)
+	_, err = r.Create(context.TODO(), userID, familyID, rotatedID, model.AccessToken, nil)
+	utils.ContinueOrFatal(err)
+
+	tests := []struct {
+		name      string
+		tokenID   string
+		wantFound bool
+	}{
+		{
+			name:      "latest token of the family",
+			tokenID:   rotatedID,
+			wantFound: true,
+		},
+		{
+			name:      "rotated token of the family",
+			tokenID:   familyID,
+			wantFound: true,
+		},
+		{
+			name:      "unknown token",
+			tokenID:   utils.GenerateUUID(),
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.FindSession(context.TODO(), userID, tt.tokenID)
+			if err != nil {
+				t.Errorf("tokenRepository.FindSession() error = %v", err)
+				return
+			}
+			if (got != nil) != tt.wantFound {
+				t.Errorf("tokenRepository.FindSession() = %v, wantFound %v", got, tt.wantFound)
+				return
+			}
+			if got != nil && (got.SessionID != familyID || got.Scope != "openid") {
+				t.Errorf("tokenRepository.FindSession() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_tokenRepository_Create_SessionMetadata(t *testing.T) {
+	var (
+		userID   = utils.GenerateUUID()
+		familyID = utils.GenerateUUID()
+	)
+	r, redisMock := newTokenRepoMock(t)
+
+	token, err := r.Create(context.TODO(), userID, familyID, familyID, model.AccessToken, &model.SessionMetadata{
+		IPAddress:  "10.0.0.1",
+		UserAgent:  "grpc-go/1.54.0",
+		ClientName: "mobile",
+		ClientID:   "oauth_client",
+		DeviceID:   "device-1",
+		Scope:      "openid profile",
+	})
+	utils.ContinueOrFatal(err)
+	claims, err := utils.ParseTokenClaims(token)
+	utils.ContinueOrFatal(err)
+	if claims.ClientID != "oauth_client" {
+		t.Errorf("tokenRepository.Create() client id claim = %v, want oauth_client", claims.ClientID)
+	}
+	createdAt := redisMock.HGet(model.SessionCacheKey(userID, familyID), "created_at")
+
+	// a refresh from a new address without client details keep the details of the login
+	rotatedID := utils.GenerateUUID()
+	_, err = r.Create(context.TODO(), userID, familyID, rotatedID, model.RefreshToken, &model.SessionMetadata{
+		IPAddress: "10.0.0.2",
+		UserAgent: "grpc-go/1.54.0",
+	})
+	utils.ContinueOrFatal(err)
+
+	sessions, err := r.ListSessions(context.TODO(), userID)
+	utils.ContinueOrFatal(err)
+	if len(sessions) != 1 {
+		t.Errorf("tokenRepository.ListSessions() got %d sessions, want 1", len(sessions))
+		return
+	}
+
+	want := model.SessionMetadata{
+		IPAddress:  "10.0.0.2",
+		UserAgent:  "grpc-go/1.54.0",
+		ClientName: "mobile",
+		ClientID:   "oauth_client",
+		DeviceID:   "device-1",
+		Scope:      "openid profile",
+	}
+	got := sessions[0].SessionMetadata
+	if got.IPAddress != want.IPAddress || got.UserAgent != want.UserAgent || got.ClientName != want.ClientName ||
+		got.ClientID != want.ClientID || got.DeviceID != want.DeviceID || got.Scope != want.Scope {
+		t.Errorf("tokenRepository.ListSessions() metadata = %+v, want %+v", got, want)
+	}
+	if sessions[0].TokenID != rotatedID {
+		t.Errorf("tokenRepository.ListSessions() token = %v, want %v", sessions[0].TokenID, rotatedID)
+	}
+	if strconv.FormatInt(got.CreatedAt.UnixMilli(), 10) != createdAt {
+		t.Errorf("tokenRepository.ListSessions() created at changed on refresh")
+	}
+	if got.LastRefreshedAt.Before(got.CreatedAt) {
+		t.Errorf("tokenRepository.ListSessions() last refreshed at %v before created at %v", got.LastRefreshedAt, got.CreatedAt)
+	}
+}
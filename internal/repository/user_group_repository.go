@@ -165,6 +165,65 @@ func (r *userGroupRepository) DeleteByUserIDAndGroupID(ctx context.Context, user
 	return nil
 }
 
+func (r *userGroupRepository) FindMembers(ctx context.Context, filter *model.GroupMemberFilter) ([]*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"groupID": filter.GroupID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	query := db.WithContext(ctx).Model(&model.User{}).
+		Joins("JOIN user_groups ON user_groups.user_id = users.id").
+		Where("user_groups.group_id = ? AND users.status <> ?", filter.GroupID, model.UserStatusDeleted)
+
+	if filter.After != nil {
+		query = query.Where("(users.username, users.id) > (?, ?)", filter.After.Key, filter.After.ID)
+	}
+
+	users := make([]*model.User, 0)
+	err := query.Order("users.username, users.id").Limit(filter.Limit).Find(&users).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *userGroupRepository) FindUsersWithPermission(ctx context.Context, filter *model.PermissionHolderFilter) ([]*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"permissions": filter.PermissionNames,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	// a user in several groups granting the permission is listed once
+	query := db.WithContext(ctx).Model(&model.User{}).
+		Where("EXISTS (SELECT 1 FROM user_groups JOIN group_permissions ON group_permissions.group_id = user_groups.group_id "+
+			"JOIN permissions ON permissions.id = group_permissions.permission_id "+
+			"WHERE user_groups.user_id = users.id AND permissions.name IN ?)", filter.PermissionNames).
+		Where("status <> ?", model.UserStatusDeleted)
+
+	if filter.After != nil {
+		query = query.Where("(username, id) > (?, ?)", filter.After.Key, filter.After.ID)
+	}
+
+	users := make([]*model.User, 0)
+	err := query.Order("username, id").Limit(filter.Limit).Find(&users).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (r *userGroupRepository) HasPermission(ctx context.Context, groupID string, permission string) (bool, error) {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
@@ -180,10 +239,10 @@ func (r *userGroupRepository) HasPermission(ctx context.Context, groupID string,
 	result := new(model.GroupPermissionAccess)
 
 	err := db.WithContext(ctx).
-		Table("user_groups ug").
-		Select("ug.group_id as group_id", "p.name as permission_name").
-		Joins("JOIN group_permissions gp ON ug.group_id = gp.group_id AND ug.group_id = ?", groupID).
+		Table("group_permissions gp").
+		Select("gp.group_id as group_id", "p.name as permission_name").
 		Joins("JOIN permissions p ON gp.permission_id = p.id AND p.name = ?", permission).
+		Where("gp.group_id = ?", groupID).
 		First(result).Error
 
 	if err != nil {
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
@@ -501,8 +502,8 @@ func Test_userGroupRepository_HasPermission(t *testing.T) {
 					row.AddRow(groupPermissionAccess.GroupID, groupPermissionAccess.PermissionName)
 				}
 
-				dbMock.ExpectQuery("SELECT .+ FROM user_groups .+ JOIN group_permissions .+ JOIN permissions").
-					WithArgs(tt.args.groupID, tt.args.permission).
+				dbMock.ExpectQuery("SELECT .+ FROM group_permissions .+ JOIN permissions .+ WHERE gp.group_id").
+					WithArgs(tt.args.permission, tt.args.groupID).
 					WillReturnRows(row).
 					WillReturnError(tt.mockSelect.err)
 			}
@@ -518,3 +519,117 @@ func Test_userGroupRepository_HasPermission(t *testing.T) {
 		})
 	}
 }
+
+func Test_userGroupRepository_FindMembers(t *testing.T) {
+	ownerID := utils.GenerateUUID()
+	itemID := utils.GenerateUUID()
+	cursorID := utils.GenerateUUID()
+	tests := []struct {
+		name      string
+		filter    *model.GroupMemberFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      []*model.User
+		wantErr   bool
+	}{
+		{
+			name:      "success first page",
+			filter:    &model.GroupMemberFilter{GroupID: ownerID, Limit: 21},
+			wantQuery: `^SELECT .+ FROM "users" JOIN user_groups ON user_groups.user_id = users.id WHERE user_groups.group_id = \$1 AND users.status <> \$2 ORDER BY users.username, users.id LIMIT 21$`,
+			wantArgs:  []driver.Value{ownerID, model.UserStatusDeleted},
+			want:      []*model.User{{ID: itemID, Username: "john"}},
+		},
+		{
+			name:      "success after the cursor",
+			filter:    &model.GroupMemberFilter{GroupID: ownerID, After: &model.PageCursor{Key: "a", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT .+ WHERE \(user_groups.group_id = \$1 AND users.status <> \$2\) AND \(users.username, users.id\) > \(\$3, \$4\) ORDER BY users.username, users.id LIMIT 3$`,
+			wantArgs:  []driver.Value{ownerID, model.UserStatusDeleted, "a", cursorID},
+			want:      []*model.User{{ID: itemID, Username: "john"}},
+		},
+		{
+			name:      "db error",
+			filter:    &model.GroupMemberFilter{GroupID: ownerID, Limit: 21},
+			wantQuery: `^SELECT .+ FROM "users"`,
+			wantArgs:  []driver.Value{ownerID, model.UserStatusDeleted},
+			mockErr:   errors.New("db error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newUserGroupRepoMock(t)
+
+			dbMock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow(itemID, "john")).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindMembers(context.TODO(), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userGroupRepository.FindMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("userGroupRepository.FindMembers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userGroupRepository_FindUsersWithPermission(t *testing.T) {
+	permissions := []string{"USER_READ", "USER_ALL", "FULL_ACCESS"}
+	itemID := utils.GenerateUUID()
+	cursorID := utils.GenerateUUID()
+	tests := []struct {
+		name      string
+		filter    *model.PermissionHolderFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      []*model.User
+		wantErr   bool
+	}{
+		{
+			name:      "success first page",
+			filter:    &model.PermissionHolderFilter{PermissionNames: permissions, Limit: 21},
+			wantQuery: `^SELECT \* FROM "users" WHERE \(EXISTS \(SELECT 1 FROM user_groups JOIN group_permissions ON group_permissions.group_id = user_groups.group_id JOIN permissions ON permissions.id = group_permissions.permission_id WHERE user_groups.user_id = users.id AND permissions.name IN \(\$1,\$2,\$3\)\)\) AND status <> \$4 ORDER BY username, id LIMIT 21$`,
+			wantArgs:  []driver.Value{"USER_READ", "USER_ALL", "FULL_ACCESS", model.UserStatusDeleted},
+			want:      []*model.User{{ID: itemID, Username: "john"}},
+		},
+		{
+			name:      "success after the cursor",
+			filter:    &model.PermissionHolderFilter{PermissionNames: permissions, After: &model.PageCursor{Key: "a", ID: cursorID}, Limit: 3},
+			wantQuery: `^SELECT \* FROM "users" WHERE .+ AND status <> \$4 AND \(username, id\) > \(\$5, \$6\) ORDER BY username, id LIMIT 3$`,
+			wantArgs:  []driver.Value{"USER_READ", "USER_ALL", "FULL_ACCESS", model.UserStatusDeleted, "a", cursorID},
+			want:      []*model.User{{ID: itemID, Username: "john"}},
+		},
+		{
+			name:      "db error",
+			filter:    &model.PermissionHolderFilter{PermissionNames: permissions, Limit: 21},
+			wantQuery: `^SELECT \* FROM "users"`,
+			wantArgs:  []driver.Value{"USER_READ", "USER_ALL", "FULL_ACCESS", model.UserStatusDeleted},
+			mockErr:   errors.New("db error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newUserGroupRepoMock(t)
+
+			dbMock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow(itemID, "john")).
+				WillReturnError(tt.mockErr)
+
+			got, err := r.FindUsersWithPermission(context.TODO(), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userGroupRepository.FindUsersWithPermission() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("userGroupRepository.FindUsersWithPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goccy/go-json"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type userIdentityRepository struct {
+	db          *gorm.DB
+	redisClient *goredis.Client
+}
+
+func NewUserIdentityRepository() model.UserIdentityRepository {
+	return new(userIdentityRepository)
+}
+
+func (r *userIdentityRepository) Create(ctx context.Context, identity *model.UserIdentity) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":       identity.ID,
+		"userID":   identity.UserID,
+		"provider": identity.Provider,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(identity).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, model.GetUserIdentityCacheKeys(identity))
+
+	return nil
+}
+
+func (r *userIdentityRepository) FindByProviderAndSubject(ctx context.Context, provider string, subject string) (*model.UserIdentity, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"provider": provider,
+		"subject":  subject,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	identity := new(model.UserIdentity)
+	cacheKey := model.NewUserIdentityCacheKeyByProviderAndSubject(provider, subject)
+
+	cachedData, err := Get(ctx, r.redisClient, cacheKey)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	err = json.Unmarshal(cachedData, &identity)
+	if err == nil {
+		return identity, nil
+	}
+
+	identity = new(model.UserIdentity)
+
+	err = db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = SetWithExpiry(ctx, r.redisClient, cacheKey, nil)
+			if err != nil {
+				logger.Error(err.Error())
+			}
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = SetWithExpiry(ctx, r.redisClient, cacheKey, identity)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	return identity, nil
+}
+
+func (r *userIdentityRepository) FindByUserID(ctx context.Context, userID string) (model.UserIdentities, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	identities := make(model.UserIdentities, 0)
+
+	err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&identities).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+func (r *userIdentityRepository) Delete(ctx context.Context, identity *model.UserIdentity) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id":       identity.ID,
+		"userID":   identity.UserID,
+		"provider": identity.Provider,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Where("id = ?", identity.ID).Delete(&model.UserIdentity{}).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = DeleteByKeys(ctx, r.redisClient, model.GetUserIdentityCacheKeys(identity))
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
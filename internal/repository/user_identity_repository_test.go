@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+func newUserIdentityRepoMock(t *testing.T) (model.UserIdentityRepository, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	dbConn, dbMock := utils.NewDBMock()
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	userIdentityRepo := NewUserIdentityRepository()
+	err = userIdentityRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = userIdentityRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	return userIdentityRepo, dbMock, miniRedis
+}
+
+func Test_userIdentityRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newUserIdentityRepoMock(t)
+			identity := &model.UserIdentity{
+				ID:        utils.GenerateUUID(),
+				UserID:    utils.GenerateUUID(),
+				Provider:  "corporate",
+				Subject:   "248289761001",
+				Email:     "jane@example.com",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			}
+			cacheKey := model.NewUserIdentityCacheKeyByProviderAndSubject(identity.Provider, identity.Subject)
+			_ = redisMock.Set(cacheKey, "null")
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("INSERT INTO \"user_identities\"").
+				WithArgs(
+					identity.ID,
+					identity.UserID,
+					identity.Provider,
+					identity.Subject,
+					identity.Email,
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.mockErr)
+
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+			if err := r.Create(context.TODO(), identity); (err != nil) != tt.wantErr {
+				t.Errorf("userIdentityRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && redisMock.Exists(cacheKey) {
+				t.Errorf("userIdentityRepository.Create() kept the cached not found")
+			}
+		})
+	}
+}
+
+func Test_userIdentityRepository_FindByProviderAndSubject(t *testing.T) {
+	var (
+		provider = "corporate"
+		subject  = "248289761001"
+		identity = &model.UserIdentity{
+			ID:       utils.GenerateUUID(),
+			UserID:   utils.GenerateUUID(),
+			Provider: provider,
+			Subject:  subject,
+			Email:    "jane@example.com",
+		}
+	)
+	type mockSelect struct {
+		identity *model.UserIdentity
+		err      error
+	}
+	tests := []struct {
+		name       string
+		mockSelect *mockSelect
+		mockCache  *model.UserIdentity
+		want       *model.UserIdentity
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			mockSelect: &mockSelect{
+				identity: identity,
+			},
+			want: identity,
+		},
+		{
+			name:      "success found in cache",
+			mockCache: identity,
+			want:      identity,
+		},
+		{
+			name: "not found",
+			mockSelect: &mockSelect{
+				err: gorm.ErrRecordNotFound,
+			},
+			want: nil,
+		},
+		{
+			name: "db error",
+			mockSelect: &mockSelect{
+				err: errors.New("db error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newUserIdentityRepoMock(t)
+			cacheKey := model.NewUserIdentityCacheKeyByProviderAndSubject(provider, subject)
+			if tt.mockSelect != nil {
+				row := sqlmock.NewRows([]string{"id", "user_id", "provider", "subject", "email"})
+				if tt.mockSelect.identity != nil {
+					identity := tt.mockSelect.identity
+					row.AddRow(identity.ID, identity.UserID, identity.Provider, identity.Subject, identity.Email)
+				}
+
+				dbMock.ExpectQuery("^SELECT .+ FROM \"user_identities\"").
+					WithArgs(provider, subject).
+					WillReturnRows(row).
+					WillReturnError(tt.mockSelect.err)
+			}
+			if tt.mockCache != nil {
+				cacheData, err := json.Marshal(tt.mockCache)
+				utils.ContinueOrFatal(err)
+				_ = redisMock.Set(cacheKey, string(cacheData))
+			}
+			got, err := r.FindByProviderAndSubject(context.TODO(), provider, subject)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userIdentityRepository.FindByProviderAndSubject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && (got.ID != tt.want.ID || got.UserID != tt.want.UserID)) {
+				t.Errorf("userIdentityRepository.FindByProviderAndSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type userMFARepository struct {
+	db *gorm.DB
+}
+
+func NewUserMFARepository() model.UserMFARepository {
+	return new(userMFARepository)
+}
+
+func (r *userMFARepository) Create(ctx context.Context, mfa *model.UserMFA) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": mfa.UserID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Create(mfa).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *userMFARepository) FindByUserID(ctx context.Context, userID string) (*model.UserMFA, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	mfa := new(model.UserMFA)
+
+	err := db.WithContext(ctx).Where("user_id = ?", userID).First(mfa).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return mfa, nil
+}
+
+func (r *userMFARepository) Enable(ctx context.Context, userID string, recoveryCodes []string, step int64) (bool, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	res := db.WithContext(ctx).
+		Model(&model.UserMFA{}).
+		Where("user_id = ? AND enabled_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"recovery_codes": pq.StringArray(recoveryCodes),
+			"last_used_step": step,
+			"enabled_at":     time.Now(),
+		})
+	if res.Error != nil {
+		logger.Error(res.Error.Error())
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (r *userMFARepository) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	// the condition make concurrent verifications of the same code race for a single row update
+	res := db.WithContext(ctx).
+		Model(&model.UserMFA{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if res.Error != nil {
+		logger.Error(res.Error.Error())
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (r *userMFARepository) UseRecoveryCode(ctx context.Context, userID string, recoveryCode string) (bool, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	res := db.WithContext(ctx).
+		Model(&model.UserMFA{}).
+		Where("user_id = ? AND ? = ANY(recovery_codes)", userID, recoveryCode).
+		Update("recovery_codes", gorm.Expr("array_remove(recovery_codes, ?)", recoveryCode))
+	if res.Error != nil {
+		logger.Error(res.Error.Error())
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (r *userMFARepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserMFA{}).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func newUserMFARepoMock() (model.UserMFARepository, sqlmock.Sqlmock) {
+	dbConn, dbMock := utils.NewDBMock()
+	userMFARepo := NewUserMFARepository()
+	err := userMFARepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+
+	return userMFARepo, dbMock
+}
+
+func Test_userMFARepository_UseStep(t *testing.T) {
+	var (
+		userID = utils.GenerateUUID()
+		step   = int64(56190000)
+	)
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		mockErr      error
+		want         bool
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			rowsAffected: 1,
+			want:         true,
+		},
+		{
+			name:         "step already used",
+			rowsAffected: 0,
+			want:         false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock := newUserMFARepoMock()
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("UPDATE \"user_mfa\" SET \"last_used_step\"").
+				WithArgs(step, sqlmock.AnyArg(), userID, step).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected)).
+				WillReturnError(tt.mockErr)
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+
+			got, err := r.UseStep(context.TODO(), userID, step)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userMFARepository.UseStep() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("userMFARepository.UseStep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userMFARepository_UseRecoveryCode(t *testing.T) {
+	var (
+		userID       = utils.GenerateUUID()
+		recoveryCode = utils.HashSecret("abcdefghij")
+	)
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		mockErr      error
+		want         bool
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			rowsAffected: 1,
+			want:         true,
+		},
+		{
+			name:         "code already used",
+			rowsAffected: 0,
+			want:         false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock := newUserMFARepoMock()
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("UPDATE \"user_mfa\" SET \"recovery_codes\"=array_remove").
+				WithArgs(recoveryCode, sqlmock.AnyArg(), userID, recoveryCode).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected)).
+				WillReturnError(tt.mockErr)
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+
+			got, err := r.UseRecoveryCode(context.TODO(), userID, recoveryCode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userMFARepository.UseRecoveryCode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("userMFARepository.UseRecoveryCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
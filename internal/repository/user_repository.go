@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/goccy/go-json"
@@ -174,10 +175,214 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*model.
 	return user, nil
 }
 
-func (r *userRepository) UpdateByID(ctx context.Context, id string) (*model.User, error) {
-	return nil, errors.New("unimplemented")
+func (r *userRepository) FindAll(ctx context.Context, filter *model.UserFilter) ([]*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"groupID": filter.GroupID,
+		"status":  filter.Status,
+		"search":  filter.Search,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+	query := db.WithContext(ctx).Model(&model.User{})
+
+	if filter.GroupID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM user_groups WHERE user_groups.user_id = users.id AND user_groups.group_id = ?)", filter.GroupID)
+	}
+
+	// a suspension past its end is listed as active, like CurrentStatus report it
+	now := time.Now()
+	switch filter.Status {
+	case "":
+		if !filter.IncludeDeleted {
+			query = query.Where("status <> ?", model.UserStatusDeleted)
+		}
+	case model.UserStatusActive:
+		query = query.Where("(status = ? OR (status = ? AND suspended_until <= ?))", model.UserStatusActive, model.UserStatusSuspended, now)
+	case model.UserStatusSuspended:
+		query = query.Where("status = ? AND (suspended_until IS NULL OR suspended_until > ?)", model.UserStatusSuspended, now)
+	default:
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+	if filter.EmailDomain != "" {
+		query = query.Where("email ILIKE ?", "%@"+escapeLike(filter.EmailDomain))
+	}
+	if filter.Search != "" {
+		pattern := escapeLike(filter.Search) + "%"
+		if !filter.PrefixSearch {
+			pattern = "%" + pattern
+		}
+		query = query.Where("(username ILIKE ? OR email ILIKE ? OR full_name ILIKE ?)", pattern, pattern, pattern)
+	}
+
+	if filter.After != nil {
+		createdAt, err := time.Parse(time.RFC3339Nano, filter.After.Key)
+		if err != nil {
+			return nil, model.ErrInvalidPageToken
+		}
+		query = query.Where("(created_at, id) > (?, ?)", createdAt, filter.After.ID)
+	}
+
+	users := make([]*model.User, 0)
+	err := query.Order("created_at, id").Limit(filter.Limit).Find(&users).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *userRepository) UpdateByID(ctx context.Context, user *model.User) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"id": user.ID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	// the previous username and email are read back, the caller copy may be stale
+	previous := new(model.User)
+	err := db.WithContext(ctx).Model(&model.User{}).
+		Select("username", "email").
+		Where("id = ?", user.ID).
+		Take(previous).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrUserNotFound
+		}
+		logger.Error(err.Error())
+		return err
+	}
+
+	// Select keep zero values and leave directory and verification columns to their own writers
+	user.UpdatedAt = time.Now()
+	err = db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ?", user.ID).
+		Select("full_name", "username", "email", "password", "updated_at").
+		Updates(user).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	cacheKeys := model.GetUserCacheKeys(user.ID, user.Username, user.Email)
+	cacheKeys = append(cacheKeys,
+		model.NewUserCacheKeyByUsername(previous.Username),
+		model.NewUserCacheKeyByEmail(previous.Email),
+	)
+	_ = DeleteByKeys(ctx, r.redisClient, cacheKeys)
+
+	return nil
+}
+
+func (r *userRepository) UpdateStatus(ctx context.Context, user *model.User) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"id":     user.ID,
+		"status": user.Status,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ?", user.ID).
+		Select("status", "status_reason", "suspended_until", "deleted_at").
+		Updates(user).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, model.GetUserCacheKeys(user.ID, user.Username, user.Email))
+
+	return nil
 }
 
 func (r *userRepository) DeleteByID(ctx context.Context, id string) error {
-	return errors.New("unimplemented")
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	user, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return model.ErrUserNotFound
+	}
+
+	deletedAt := time.Now()
+	user.Status = model.UserStatusDeleted
+	user.SuspendedUntil = nil
+	user.DeletedAt = &deletedAt
+
+	return r.UpdateStatus(ctx, user)
+}
+
+func (r *userRepository) MarkEmailVerified(ctx context.Context, user *model.User) (bool, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"id":    user.ID,
+		"email": user.Email,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	// the email condition keep a token sent to a previous address from verifying the new one
+	res := db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND email = ?", user.ID, user.Email).
+		Update("email_verified_at", time.Now())
+	if res.Error != nil {
+		logger.Error(res.Error.Error())
+		return false, res.Error
+	}
+
+	_ = DeleteByKeys(ctx, r.redisClient, model.GetUserCacheKeys(user.ID, user.Username, user.Email))
+
+	return res.RowsAffected > 0, nil
+}
+
+func (r *userRepository) ResetEmailVerification(ctx context.Context, user *model.User) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"id": user.ID,
+	})
+
+	db := utils.GetTxFromContext(ctx, r.db)
+
+	err := db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ?", user.ID).
+		Update("email_verified_at", nil).Error
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	user.EmailVerifiedAt = nil
+
+	_ = DeleteByKeys(ctx, r.redisClient, model.GetUserCacheKeys(user.ID, user.Username, user.Email))
+
+	return nil
 }
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alicebob/miniredis/v2"
@@ -82,6 +84,11 @@ func Test_userRepository_Create(t *testing.T) {
 					tt.args.user.Username,
 					tt.args.user.Email,
 					tt.args.user.Password,
+					tt.args.user.DirectoryManaged,
+					sqlmock.AnyArg(),
+					tt.args.user.Status,
+					tt.args.user.StatusReason,
+					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
@@ -522,65 +529,455 @@ func Test_userRepository_FindByEmail(t *testing.T) {
 	}
 }
 
+func Test_userRepository_FindAll(t *testing.T) {
+	createdAfter := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursorCreatedAt := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	cursorID := utils.GenerateUUID()
+	groupID := utils.GenerateUUID()
+	userID := utils.GenerateUUID()
+
+	tests := []struct {
+		name      string
+		filter    *model.UserFilter
+		wantQuery string
+		wantArgs  []driver.Value
+		mockErr   error
+		want      int
+		wantErr   error
+	}{
+		{
+			name:      "success deleted users left out by default",
+			filter:    &model.UserFilter{Limit: 21},
+			wantQuery: `^SELECT \* FROM "users" WHERE status <> \$1 ORDER BY created_at, id LIMIT 21$`,
+			wantArgs:  []driver.Value{model.UserStatusDeleted},
+			want:      1,
+		},
+		{
+			name:      "success include deleted users",
+			filter:    &model.UserFilter{IncludeDeleted: true, Limit: 21},
+			wantQuery: `^SELECT \* FROM "users" ORDER BY created_at, id LIMIT 21$`,
+			want:      1,
+		},
+		{
+			name: "success every filter after the cursor",
+			filter: &model.UserFilter{
+				GroupID:       groupID,
+				Status:        model.UserStatusSuspended,
+				CreatedAfter:  &createdAfter,
+				CreatedBefore: &createdBefore,
+				EmailDomain:   "example.com",
+				Search:        "jo_n",
+				PrefixSearch:  true,
+				After:         &model.PageCursor{Key: cursorCreatedAt.Format(time.RFC3339Nano), ID: cursorID},
+				Limit:         11,
+			},
+			wantQuery: `^SELECT \* FROM "users" WHERE \(EXISTS \(SELECT 1 FROM user_groups WHERE user_groups.user_id = users.id AND user_groups.group_id = \$1\)\) ` +
+				`AND \(status = \$2 AND \(suspended_until IS NULL OR suspended_until > \$3\)\) ` +
+				`AND created_at >= \$4 AND created_at < \$5 AND email ILIKE \$6 ` +
+				`AND \(\(username ILIKE \$7 OR email ILIKE \$8 OR full_name ILIKE \$9\)\) ` +
+				`AND \(created_at, id\) > \(\$10, \$11\) ORDER BY created_at, id LIMIT 11$`,
+			wantArgs: []driver.Value{
+				groupID, model.UserStatusSuspended, sqlmock.AnyArg(), createdAfter, createdBefore, "%@example.com",
+				`jo\_n%`, `jo\_n%`, `jo\_n%`, cursorCreatedAt, cursorID,
+			},
+			want: 1,
+		},
+		{
+			name:      "success active include ended suspensions",
+			filter:    &model.UserFilter{Status: model.UserStatusActive, Search: "john", Limit: 21},
+			wantQuery: `^SELECT \* FROM "users" WHERE \(\(status = \$1 OR \(status = \$2 AND suspended_until <= \$3\)\)\) AND \(\(username ILIKE \$4 OR email ILIKE \$5 OR full_name ILIKE \$6\)\)`,
+			wantArgs:  []driver.Value{model.UserStatusActive, model.UserStatusSuspended, sqlmock.AnyArg(), "%john%", "%john%", "%john%"},
+			want:      1,
+		},
+		{
+			name:    "error invalid cursor",
+			filter:  &model.UserFilter{After: &model.PageCursor{Key: "yesterday", ID: cursorID}, Limit: 21},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:      "error db",
+			filter:    &model.UserFilter{Limit: 21},
+			wantQuery: `^SELECT \* FROM "users"`,
+			wantArgs:  []driver.Value{model.UserStatusDeleted},
+			mockErr:   errors.New("db error"),
+			wantErr:   errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newUserRepoMock(t)
+
+			if tt.wantQuery != "" {
+				dbMock.ExpectQuery(tt.wantQuery).
+					WithArgs(tt.wantArgs...).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at"}).AddRow(userID, "john", createdAfter)).
+					WillReturnError(tt.mockErr)
+			}
+
+			got, err := r.FindAll(context.TODO(), tt.filter)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userRepository.FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.want {
+				t.Errorf("userRepository.FindAll() returned %d users, want %d", len(got), tt.want)
+			}
+			if err := dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func Test_userRepository_UpdateByID(t *testing.T) {
-	type args struct {
-		id string
+	previousUsername := "previous-username"
+	previousEmail := "previous@gmail.com"
+	tests := []struct {
+		name          string
+		email         string
+		userNotFound  bool
+		mockUpdateErr error
+		wantErr       error
+	}{
+		{
+			name:  "success email changed",
+			email: "user@gmail.com",
+		},
+		{
+			name:  "success email kept",
+			email: previousEmail,
+		},
+		{
+			name:         "user not found",
+			email:        "user@gmail.com",
+			userNotFound: true,
+			wantErr:      model.ErrUserNotFound,
+		},
+		{
+			name:          "db error",
+			email:         "user@gmail.com",
+			mockUpdateErr: errors.New("db error"),
+			wantErr:       errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newUserRepoMock(t)
+			verifiedAt := time.Now()
+			user := &model.User{
+				ID:              utils.GenerateUUID(),
+				FullName:        "full name",
+				Username:        "username",
+				Email:           tt.email,
+				Password:        "hashed-password",
+				EmailVerifiedAt: &verifiedAt,
+			}
+
+			cacheKeys := append(model.GetUserCacheKeys(user.ID, user.Username, user.Email),
+				model.NewUserCacheKeyByUsername(previousUsername),
+				model.NewUserCacheKeyByEmail(previousEmail),
+			)
+			for _, cacheKey := range cacheKeys {
+				err := redisMock.Set(cacheKey, "cached")
+				utils.ContinueOrFatal(err)
+			}
+
+			row := sqlmock.NewRows([]string{"username", "email"})
+			if !tt.userNotFound {
+				row.AddRow(previousUsername, previousEmail)
+			}
+			dbMock.ExpectQuery("^SELECT \"username\",\"email\" FROM \"users\"").
+				WithArgs(user.ID).
+				WillReturnRows(row)
+			if !tt.userNotFound {
+				dbMock.ExpectBegin()
+				// the verification is left to its own writers
+				dbMock.ExpectExec("UPDATE \"users\" SET \"full_name\"=\\$1,\"username\"=\\$2,\"email\"=\\$3,\"password\"=\\$4,\"updated_at\"=\\$5 WHERE").
+					WithArgs(user.FullName, user.Username, user.Email, user.Password, sqlmock.AnyArg(), user.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(tt.mockUpdateErr)
+				if tt.mockUpdateErr != nil {
+					dbMock.ExpectRollback()
+				} else {
+					dbMock.ExpectCommit()
+				}
+			}
+
+			err := r.UpdateByID(context.TODO(), user)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userRepository.UpdateByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			for _, cacheKey := range cacheKeys {
+				if redisMock.Exists(cacheKey) {
+					t.Errorf("userRepository.UpdateByID() kept cache key %s", cacheKey)
+				}
+			}
+			if !user.IsEmailVerified() {
+				t.Errorf("userRepository.UpdateByID() reset the email verification")
+			}
+		})
+	}
+}
+
+func Test_userRepository_UpdateStatus(t *testing.T) {
+	suspendedUntil := time.Now().Add(time.Hour)
+	user := &model.User{
+		ID:             utils.GenerateUUID(),
+		FullName:       "full name",
+		Username:       "username",
+		Email:          "user@gmail.com",
+		Status:         model.UserStatusSuspended,
+		StatusReason:   "spam",
+		SuspendedUntil: &suspendedUntil,
 	}
 	tests := []struct {
 		name    string
-		args    args
-		want    *model.User
+		mockErr error
 		wantErr bool
 	}{
 		{
-			name: "unimplemented",
-			args: args{
-				id: "id",
-			},
-			want:    nil,
+			name: "success",
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, _, _ := newUserRepoMock(t)
-			got, err := r.UpdateByID(context.TODO(), tt.args.id)
+			r, dbMock, redisMock := newUserRepoMock(t)
+
+			for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+				err := redisMock.Set(cacheKey, "cached")
+				utils.ContinueOrFatal(err)
+			}
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("UPDATE \"users\" SET \"status\"").
+				WithArgs(user.Status, user.StatusReason, user.SuspendedUntil, sqlmock.AnyArg(), sqlmock.AnyArg(), user.ID).
+				WillReturnResult(sqlmock.NewResult(0, 1)).
+				WillReturnError(tt.mockErr)
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+
+			err := r.UpdateStatus(context.TODO(), user)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("userRepository.UpdateByID() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("userRepository.UpdateStatus() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("userRepository.UpdateByID() = %v, want %v", got, tt.want)
+			if tt.wantErr {
+				return
+			}
+			for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+				if redisMock.Exists(cacheKey) {
+					t.Errorf("userRepository.UpdateStatus() kept cache key %s", cacheKey)
+				}
 			}
 		})
 	}
 }
 
 func Test_userRepository_DeleteByID(t *testing.T) {
-	type args struct {
-		id string
+	user := &model.User{
+		ID:       utils.GenerateUUID(),
+		FullName: "full name",
+		Username: "username",
+		Email:    "user@gmail.com",
+		Status:   model.UserStatusActive,
+	}
+	tests := []struct {
+		name         string
+		userNotFound bool
+		mockErr      error
+		wantErr      error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:         "user not found",
+			userNotFound: true,
+			wantErr:      model.ErrUserNotFound,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, _ := newUserRepoMock(t)
+
+			row := sqlmock.NewRows([]string{"id", "full_name", "username", "email", "status"})
+			if !tt.userNotFound {
+				row.AddRow(user.ID, user.FullName, user.Username, user.Email, user.Status)
+			}
+			dbMock.ExpectQuery("^SELECT .+ FROM \"users\"").
+				WithArgs(user.ID).
+				WillReturnRows(row)
+			if !tt.userNotFound {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec("UPDATE \"users\" SET \"status\"").
+					WithArgs(model.UserStatusDeleted, "", nil, sqlmock.AnyArg(), sqlmock.AnyArg(), user.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1)).
+					WillReturnError(tt.mockErr)
+				if tt.mockErr != nil {
+					dbMock.ExpectRollback()
+				} else {
+					dbMock.ExpectCommit()
+				}
+			}
+
+			err := r.DeleteByID(context.TODO(), user.ID)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userRepository.DeleteByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_userRepository_MarkEmailVerified(t *testing.T) {
+	user := &model.User{
+		ID:       utils.GenerateUUID(),
+		FullName: "full name",
+		Username: "username",
+		Email:    "user@gmail.com",
 	}
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		mockErr      error
+		want         bool
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			rowsAffected: 1,
+			want:         true,
+		},
+		{
+			name:         "email changed",
+			rowsAffected: 0,
+			want:         false,
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dbMock, redisMock := newUserRepoMock(t)
+
+			for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+				err := redisMock.Set(cacheKey, "cached")
+				utils.ContinueOrFatal(err)
+			}
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("UPDATE \"users\" SET \"email_verified_at\"").
+				WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), user.ID, user.Email).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected)).
+				WillReturnError(tt.mockErr)
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+
+			got, err := r.MarkEmailVerified(context.TODO(), user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userRepository.MarkEmailVerified() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("userRepository.MarkEmailVerified() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+				if redisMock.Exists(cacheKey) {
+					t.Errorf("userRepository.MarkEmailVerified() kept cache key %s", cacheKey)
+				}
+			}
+		})
+	}
+}
+
+func Test_userRepository_ResetEmailVerification(t *testing.T) {
 	tests := []struct {
 		name    string
-		args    args
+		mockErr error
 		wantErr bool
 	}{
 		{
-			name: "unimplemented",
-			args: args{
-				id: "id",
-			},
+			name: "success",
+		},
+		{
+			name:    "db error",
+			mockErr: errors.New("db error"),
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, _, _ := newUserRepoMock(t)
-			err := r.DeleteByID(context.TODO(), tt.args.id)
+			r, dbMock, redisMock := newUserRepoMock(t)
+			verifiedAt := time.Now()
+			user := &model.User{
+				ID:              utils.GenerateUUID(),
+				FullName:        "full name",
+				Username:        "username",
+				Email:           "user@gmail.com",
+				EmailVerifiedAt: &verifiedAt,
+			}
+
+			for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+				err := redisMock.Set(cacheKey, "cached")
+				utils.ContinueOrFatal(err)
+			}
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("UPDATE \"users\" SET \"email_verified_at\"").
+				WithArgs(nil, sqlmock.AnyArg(), user.ID).
+				WillReturnResult(sqlmock.NewResult(0, 1)).
+				WillReturnError(tt.mockErr)
+			if tt.wantErr {
+				dbMock.ExpectRollback()
+			} else {
+				dbMock.ExpectCommit()
+			}
+
+			err := r.ResetEmailVerification(context.TODO(), user)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("userRepository.DeleteByID() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("userRepository.ResetEmailVerification() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
+			if user.IsEmailVerified() {
+				t.Errorf("userRepository.ResetEmailVerification() kept the verification time")
+			}
+			for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+				if redisMock.Exists(cacheKey) {
+					t.Errorf("userRepository.ResetEmailVerification() kept cache key %s", cacheKey)
+				}
+			}
+			if err := dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
 		})
 	}
 }
@@ -3,11 +3,13 @@ package grpc
 import (
 	"context"
 
+	"github.com/krobus00/auth-service/internal/constant"
 	"github.com/krobus00/auth-service/internal/model"
 	"github.com/krobus00/auth-service/internal/utils"
 	pb "github.com/krobus00/auth-service/pb/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -33,6 +35,13 @@ func (t *Server) HasAccess(ctx context.Context, req *pb.HasAccessRequest) (*wrap
 	payload := new(model.HasAccessPayload)
 	payload.ParseFromProto(req)
 
+	// system identity is reserved for in-process callers
+	if payload.UserID == constant.SystemID {
+		return &wrapperspb.BoolValue{
+			Value: false,
+		}, status.Error(codes.PermissionDenied, model.ErrUnauthorizeAccess.Error())
+	}
+
 	err := t.authUC.HasAccess(ctx, payload)
 	return &wrapperspb.BoolValue{
 		Value: err == nil,
@@ -48,8 +57,63 @@ func (t *Server) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest)
 	payload.ParseFromProto(req)
 
 	result, err := t.userUC.RefreshToken(ctx, payload)
-	if err != nil {
+	switch err {
+	case nil:
+	case model.ErrTokenExpired:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrTokenInvalid:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrTokenReused:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrTokenMalformed:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrInvalidTokenType:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrEmailNotVerified:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrAccountPending, model.ErrAccountSuspended, model.ErrAccountDeactivated:
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	default:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return result.ToGRPCResponse(), nil
 }
+
+func (t *Server) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ValidateTokenPayload)
+	payload.ParseFromProto(req)
+
+	result, err := t.authUC.ValidateToken(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrTokenExpired:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrTokenRevoked:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrTokenInvalid:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrTokenMalformed:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrInvalidTokenType:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result.ToGRPCResponse(), nil
+}
+
+func (t *Server) GetJWKS(ctx context.Context, req *emptypb.Empty) (*pb.GetJWKSResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	jwks, err := t.authUC.GetJWKS(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return jwks.ToGRPCResponse(), nil
+}
@@ -6,12 +6,23 @@ import (
 )
 
 type Server struct {
-	userUC            model.UserUsecase
-	authUC            model.AuthUsecase
-	permissionUC      model.PermissionUsecase
-	groupUC           model.GroupUsecase
-	userGroupUC       model.UserGroupUsecase
-	groupPermissionUC model.GroupPermissionUsecase
+	userUC                model.UserUsecase
+	authUC                model.AuthUsecase
+	permissionUC          model.PermissionUsecase
+	groupUC               model.GroupUsecase
+	userGroupUC           model.UserGroupUsecase
+	groupPermissionUC     model.GroupPermissionUsecase
+	sessionUC             model.SessionUsecase
+	personalAccessTokenUC model.PersonalAccessTokenUsecase
+	serviceAccountUC      model.ServiceAccountUsecase
+	oauthUC               model.OAuthUsecase
+	userIdentityUC        model.UserIdentityUsecase
+	mfaUC                 model.MFAUsecase
+	emailVerificationUC   model.EmailVerificationUsecase
+	passwordResetUC       model.PasswordResetUsecase
+	loginAttemptUC        model.LoginAttemptUsecase
+	rateLimitUC           model.RateLimitUsecase
+	userStatusUC          model.UserStatusUsecase
 	pb.UnimplementedAuthServiceServer
 }
 
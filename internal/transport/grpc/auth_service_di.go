@@ -53,3 +53,91 @@ func (t *Server) InjectGroupPermissionUsecase(usecase model.GroupPermissionUseca
 	t.groupPermissionUC = usecase
 	return nil
 }
+
+func (t *Server) InjectSessionUsecase(usecase model.SessionUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid session usecase")
+	}
+	t.sessionUC = usecase
+	return nil
+}
+
+func (t *Server) InjectPersonalAccessTokenUsecase(usecase model.PersonalAccessTokenUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid personal access token usecase")
+	}
+	t.personalAccessTokenUC = usecase
+	return nil
+}
+
+func (t *Server) InjectServiceAccountUsecase(usecase model.ServiceAccountUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid service account usecase")
+	}
+	t.serviceAccountUC = usecase
+	return nil
+}
+
+func (t *Server) InjectOAuthUsecase(usecase model.OAuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid oauth usecase")
+	}
+	t.oauthUC = usecase
+	return nil
+}
+
+func (t *Server) InjectUserIdentityUsecase(usecase model.UserIdentityUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user identity usecase")
+	}
+	t.userIdentityUC = usecase
+	return nil
+}
+
+func (t *Server) InjectMFAUsecase(usecase model.MFAUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid mfa usecase")
+	}
+	t.mfaUC = usecase
+	return nil
+}
+
+func (t *Server) InjectEmailVerificationUsecase(usecase model.EmailVerificationUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid email verification usecase")
+	}
+	t.emailVerificationUC = usecase
+	return nil
+}
+
+func (t *Server) InjectPasswordResetUsecase(usecase model.PasswordResetUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid password reset usecase")
+	}
+	t.passwordResetUC = usecase
+	return nil
+}
+
+func (t *Server) InjectLoginAttemptUsecase(usecase model.LoginAttemptUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid login attempt usecase")
+	}
+	t.loginAttemptUC = usecase
+	return nil
+}
+
+func (t *Server) InjectRateLimitUsecase(usecase model.RateLimitUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid rate limit usecase")
+	}
+	t.rateLimitUC = usecase
+	return nil
+}
+
+func (t *Server) InjectUserStatusUsecase(usecase model.UserStatusUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user status usecase")
+	}
+	t.userStatusUC = usecase
+	return nil
+}
@@ -2,10 +2,57 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func setUserIDCtx(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, constant.KeyUserIDCtx, userID)
 }
+
+func setTokenIDCtx(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, constant.KeyTokenIDCtx, tokenID)
+}
+
+func setTokenScopesCtx(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, constant.KeyTokenScopesCtx, scopes)
+}
+
+func setClientIDCtx(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, constant.KeyClientIDCtx, clientID)
+}
+
+func setSessionMetadataCtx(ctx context.Context, metadata *model.SessionMetadata) context.Context {
+	return context.WithValue(ctx, constant.KeySessionMetadataCtx, metadata)
+}
+
+func getUserIDFromCtx(ctx context.Context) string {
+	userID, ok := ctx.Value(constant.KeyUserIDCtx).(string)
+	if !ok || userID == "" {
+		return constant.GuestID
+	}
+	return userID
+}
+
+func getTokenIDFromCtx(ctx context.Context) string {
+	tokenID, _ := ctx.Value(constant.KeyTokenIDCtx).(string)
+	return tokenID
+}
+
+// passwordPolicyStatusError return an InvalidArgument status carrying every failed rule as a
+// pb.PasswordPolicyError detail, nil when err isn't a policy violation.
+func passwordPolicyStatusError(err error) error {
+	var policyErr *model.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return nil
+	}
+	st, detailErr := status.New(codes.InvalidArgument, policyErr.Error()).WithDetails(policyErr.ToProto())
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, policyErr.Error())
+	}
+	return st.Err()
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) SendVerificationEmail(ctx context.Context, req *pb.SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+	})
+
+	payload := new(model.SendVerificationEmailPayload)
+	payload.ParseFromProto(req)
+
+	err := t.emailVerificationUC.SendVerificationEmail(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrEmailRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUserNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrEmailAlreadyVerified:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.VerifyEmailPayload)
+	payload.ParseFromProto(req)
+
+	err := t.emailVerificationUC.VerifyEmail(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrEmailVerificationTokenInvalid:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		logrus.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
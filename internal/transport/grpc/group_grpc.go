@@ -18,12 +18,10 @@ func (t *Server) FindGroupByID(ctx context.Context, req *pb.FindGroupByIDRequest
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupID":       req.GetId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindGroupByIDPayload)
 	payload.ParseFromProto(req)
 
@@ -48,12 +46,10 @@ func (t *Server) FindGroupByName(ctx context.Context, req *pb.FindGroupByNameReq
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupName":     req.GetName(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindGroupByNamePayload)
 	payload.ParseFromProto(req)
 
@@ -78,12 +74,10 @@ func (t *Server) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupName":     req.GetName(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.CreateGroupPayload)
 	payload.ParseFromProto(req)
 
@@ -108,12 +102,10 @@ func (t *Server) DeleteGroupByID(ctx context.Context, req *pb.DeleteGroupRequest
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupID":       req.GetId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.DeleteGroupByIDPayload)
 	payload.ParseFromProto(req)
 
@@ -131,3 +123,31 @@ func (t *Server) DeleteGroupByID(ctx context.Context, req *pb.DeleteGroupRequest
 
 	return &emptypb.Empty{}, nil
 }
+
+func (t *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"namePrefix":    req.GetNamePrefix(),
+	})
+
+	payload := new(model.ListGroupsPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.groupUC.List(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrInvalidPageToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
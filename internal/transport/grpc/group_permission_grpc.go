@@ -18,13 +18,11 @@ func (t *Server) FindGroupPermission(ctx context.Context, req *pb.FindGroupPermi
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupID":       req.GetGroupId(),
 		"permissionID":  req.GetPermissionId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindGroupPermissionPayload)
 	payload.ParseFromProto(req)
 
@@ -53,13 +51,11 @@ func (t *Server) CreateGroupPermission(ctx context.Context, req *pb.CreateGroupP
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupID":       req.GetGroupId(),
 		"permissionID":  req.GetPermissionId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.CreateGroupPermissionPayload)
 	payload.ParseFromProto(req)
 
@@ -90,13 +86,11 @@ func (t *Server) DeleteGroupPermission(ctx context.Context, req *pb.DeleteGroupP
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"groupID":       req.GetGroupId(),
 		"permissionID":  req.GetPermissionId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.DeleteGroupPermissionPayload)
 	payload.ParseFromProto(req)
 
@@ -118,3 +112,63 @@ func (t *Server) DeleteGroupPermission(ctx context.Context, req *pb.DeleteGroupP
 
 	return &emptypb.Empty{}, nil
 }
+
+func (t *Server) ListGroupPermissions(ctx context.Context, req *pb.ListGroupPermissionsRequest) (*pb.ListGroupPermissionsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"groupID":       req.GetGroupId(),
+	})
+
+	payload := new(model.ListGroupPermissionsPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.groupPermissionUC.ListGroupPermissions(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrGroupNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrInvalidPageToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) ListGroupsWithPermission(ctx context.Context, req *pb.ListGroupsWithPermissionRequest) (*pb.ListGroupsWithPermissionResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"permissionID":  req.GetPermissionId(),
+	})
+
+	payload := new(model.ListGroupsWithPermissionPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.groupPermissionUC.ListGroupsWithPermission(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrPermissionNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrInvalidPageToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
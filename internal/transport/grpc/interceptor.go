@@ -0,0 +1,204 @@
+package grpc
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+	userAgentHeader     = "user-agent"
+	clientNameHeader    = "x-client-name"
+	deviceIDHeader      = "x-device-id"
+	retryAfterHeader    = "retry-after"
+)
+
+// guestMethods can be called without an identity. An invalid bearer token sent to them is ignored,
+// so a client attaching its expired access token to every call can still log in or refresh it.
+var guestMethods = map[string]bool{
+	pb.AuthService_Login_FullMethodName:                 true,
+	pb.AuthService_Register_FullMethodName:              true,
+	pb.AuthService_RefreshToken_FullMethodName:          true,
+	pb.AuthService_ValidateToken_FullMethodName:         true,
+	pb.AuthService_GetJWKS_FullMethodName:               true,
+	pb.AuthService_HasAccess_FullMethodName:             true,
+	pb.AuthService_ClientCredentials_FullMethodName:     true,
+	pb.AuthService_VerifyMFA_FullMethodName:             true,
+	pb.AuthService_SendVerificationEmail_FullMethodName: true,
+	pb.AuthService_VerifyEmail_FullMethodName:           true,
+	pb.AuthService_RequestPasswordReset_FullMethodName:  true,
+	pb.AuthService_ResetPassword_FullMethodName:         true,
+}
+
+// selfServiceMethods act on the caller own account without a permission check, so the scopes of a
+// personal access token can't restrict them. Scoped tokens can only call them with a FULL_ACCESS scope.
+var selfServiceMethods = map[string]bool{
+	pb.AuthService_Logout_FullMethodName:                    true,
+	pb.AuthService_ChangePassword_FullMethodName:            true,
+	pb.AuthService_UpdateProfile_FullMethodName:             true,
+	pb.AuthService_ListSessions_FullMethodName:              true,
+	pb.AuthService_RevokeSession_FullMethodName:             true,
+	pb.AuthService_RevokeAllSessions_FullMethodName:         true,
+	pb.AuthService_CreatePersonalAccessToken_FullMethodName: true,
+	pb.AuthService_ListPersonalAccessTokens_FullMethodName:  true,
+	pb.AuthService_RevokePersonalAccessToken_FullMethodName: true,
+	pb.AuthService_FindAllUserIdentities_FullMethodName:     true,
+	pb.AuthService_LinkUserIdentity_FullMethodName:          true,
+	pb.AuthService_UnlinkUserIdentity_FullMethodName:        true,
+	pb.AuthService_EnrollMFA_FullMethodName:                 true,
+	pb.AuthService_ConfirmMFA_FullMethodName:                true,
+	pb.AuthService_DisableMFA_FullMethodName:                true,
+}
+
+// UnaryAuthInterceptor resolve the caller identity from the bearer token in the request metadata.
+// Requests without a bearer token are served as guest.
+func (t *Server) UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = setUserIDCtx(ctx, constant.GuestID)
+
+	accessToken, err := getBearerToken(ctx)
+	if err != nil {
+		if guestMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if accessToken == "" {
+		return handler(ctx, req)
+	}
+
+	session, err := t.authUC.ValidateToken(ctx, &model.ValidateTokenPayload{
+		AccessToken: accessToken,
+	})
+	switch err {
+	case nil:
+	case model.ErrTokenExpired, model.ErrTokenRevoked, model.ErrTokenInvalid, model.ErrTokenMalformed, model.ErrInvalidTokenType:
+		if guestMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logrus.WithField("method", info.FullMethod).Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// system identity is reserved for in-process callers
+	if session.UserID == constant.SystemID {
+		return nil, status.Error(codes.Unauthenticated, model.ErrUnauthorizeAccess.Error())
+	}
+	// tokens issued to an OAuth client are meant for the OpenID endpoints, they never act as a user session
+	if session.ClientID != "" && !guestMethods[info.FullMethod] {
+		return nil, status.Error(codes.PermissionDenied, model.ErrUnauthorizeAccess.Error())
+	}
+	if session.Scopes != nil && selfServiceMethods[info.FullMethod] && !hasFullAccessScope(session.Scopes) {
+		return nil, status.Error(codes.PermissionDenied, model.ErrUnauthorizeAccess.Error())
+	}
+
+	ctx = setUserIDCtx(ctx, session.UserID)
+	ctx = setTokenIDCtx(ctx, session.TokenID)
+	// scoped tokens restrict every permission check made on behalf of the caller
+	if session.Scopes != nil {
+		ctx = setTokenScopesCtx(ctx, session.Scopes)
+	}
+	if session.ClientID != "" {
+		ctx = setClientIDCtx(ctx, session.ClientID)
+	}
+
+	return handler(ctx, req)
+}
+
+func hasFullAccessScope(scopes []string) bool {
+	for _, scope := range scopes {
+		if scope == constant.PermissionFullAccess {
+			return true
+		}
+	}
+	return false
+}
+
+func getBearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 || values[0] == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(strings.ToLower(values[0]), bearerPrefix) {
+		return "", model.ErrInvalidAuthorizationHeader
+	}
+	return strings.TrimSpace(values[0][len(bearerPrefix):]), nil
+}
+
+// UnarySessionMetadataInterceptor collect the client details recorded on the sessions a request mint.
+func (t *Server) UnarySessionMetadataInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	sessionMetadata := new(model.SessionMetadata)
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		sessionMetadata.IPAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(sessionMetadata.IPAddress); err == nil {
+			sessionMetadata.IPAddress = host
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		sessionMetadata.UserAgent = firstMetadataValue(md, userAgentHeader)
+		sessionMetadata.ClientName = firstMetadataValue(md, clientNameHeader)
+		sessionMetadata.DeviceID = firstMetadataValue(md, deviceIDHeader)
+	}
+
+	return handler(setSessionMetadataCtx(ctx, sessionMetadata), req)
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// UnaryAddressRateLimitInterceptor reject the calls over the address rate limit of their method, it runs
+// before the caller is authenticated so guessing bearer tokens is throttled as well.
+func (t *Server) UnaryAddressRateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return t.rateLimit(ctx, req, info, handler, t.rateLimitUC.AllowAddress)
+}
+
+// UnaryRateLimitInterceptor reject the calls over the user and client rate limit of their method.
+// The caller must be resolved before it.
+func (t *Server) UnaryRateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return t.rateLimit(ctx, req, info, handler, t.rateLimitUC.Allow)
+}
+
+// rateLimit reject the call when allow refuse it, telling the client how many seconds to wait in the retry-after header.
+func (t *Server) rateLimit(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	allow func(ctx context.Context, method string) (*model.RateLimitResult, error)) (interface{}, error) {
+	res, err := allow(ctx, info.FullMethod)
+	if err != nil {
+		// a broken limiter shouldn't take the service down with it
+		logrus.WithField("method", info.FullMethod).Error(err.Error())
+		return handler(ctx, req)
+	}
+	if res.Allowed {
+		return handler(ctx, req)
+	}
+
+	retryAfter := strconv.FormatInt(int64(math.Ceil(res.RetryAfter.Seconds())), 10)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, retryAfter)); err != nil {
+		logrus.WithField("method", info.FullMethod).Error(err.Error())
+	}
+	return nil, status.Error(codes.ResourceExhausted, model.ErrRateLimited.Error())
+}
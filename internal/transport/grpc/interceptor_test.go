@@ -0,0 +1,421 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func Test_getBearerToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		want    string
+		wantErr error
+	}{
+		{
+			name: "no metadata",
+		},
+		{
+			name: "no authorization header",
+			md:   metadata.Pairs(userAgentHeader, "grpc-go"),
+		},
+		{
+			name: "empty authorization header",
+			md:   metadata.Pairs(authorizationHeader, ""),
+		},
+		{
+			name: "bearer token",
+			md:   metadata.Pairs(authorizationHeader, "Bearer token"),
+			want: "token",
+		},
+		{
+			name: "scheme is case insensitive",
+			md:   metadata.Pairs(authorizationHeader, "bearer  token "),
+			want: "token",
+		},
+		{
+			name:    "non bearer scheme",
+			md:      metadata.Pairs(authorizationHeader, "Basic dXNlcjpwYXNz"),
+			wantErr: model.ErrInvalidAuthorizationHeader,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			got, err := getBearerToken(ctx)
+			if err != tt.wantErr {
+				t.Errorf("getBearerToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("getBearerToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_UnaryAuthInterceptor(t *testing.T) {
+	userID := utils.GenerateUUID()
+	tokenID := utils.GenerateUUID()
+	adminMethod := pb.AuthService_CreateGroup_FullMethodName
+	guestMethod := pb.AuthService_RefreshToken_FullMethodName
+	selfServiceMethod := pb.AuthService_RevokeAllSessions_FullMethodName
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantValidate  bool
+		mockSession   *model.ValidateTokenResponse
+		mockErr       error
+		wantHandler   bool
+		wantUserID    string
+		wantTokenID   string
+		wantScopes    []string
+		wantClientID  string
+		wantCode      codes.Code
+	}{
+		{
+			name:        "no header is a guest",
+			method:      adminMethod,
+			wantHandler: true,
+			wantUserID:  constant.GuestID,
+		},
+		{
+			name:          "valid token",
+			method:        adminMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockSession:   &model.ValidateTokenResponse{UserID: userID, TokenID: tokenID},
+			wantHandler:   true,
+			wantUserID:    userID,
+			wantTokenID:   tokenID,
+		},
+		{
+			name:          "scoped token propagate its scopes",
+			method:        adminMethod,
+			authorization: "Bearer pat_token",
+			wantValidate:  true,
+			mockSession: &model.ValidateTokenResponse{
+				UserID:  userID,
+				TokenID: tokenID,
+				Scopes:  []string{constant.PermissionGroupRead},
+			},
+			wantHandler: true,
+			wantUserID:  userID,
+			wantTokenID: tokenID,
+			wantScopes:  []string{constant.PermissionGroupRead},
+		},
+		{
+			name:          "scoped token can't call a self service method",
+			method:        selfServiceMethod,
+			authorization: "Bearer pat_token",
+			wantValidate:  true,
+			mockSession: &model.ValidateTokenResponse{
+				UserID:  userID,
+				TokenID: tokenID,
+				Scopes:  []string{constant.PermissionGroupRead},
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:          "full access scoped token call a self service method",
+			method:        selfServiceMethod,
+			authorization: "Bearer pat_token",
+			wantValidate:  true,
+			mockSession: &model.ValidateTokenResponse{
+				UserID:  userID,
+				TokenID: tokenID,
+				Scopes:  []string{constant.PermissionFullAccess},
+			},
+			wantHandler: true,
+			wantUserID:  userID,
+			wantTokenID: tokenID,
+			wantScopes:  []string{constant.PermissionFullAccess},
+		},
+		{
+			name:          "oauth token can't call a self service method",
+			method:        selfServiceMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockSession: &model.ValidateTokenResponse{
+				UserID:   userID,
+				TokenID:  tokenID,
+				Scopes:   []string{constant.PermissionAllowGuest},
+				ClientID: "oauth_client",
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:          "oauth token can't call an admin method",
+			method:        adminMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockSession: &model.ValidateTokenResponse{
+				UserID:   userID,
+				TokenID:  tokenID,
+				Scopes:   []string{constant.PermissionAllowGuest},
+				ClientID: "oauth_client",
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:          "oauth token propagate its client",
+			method:        pb.AuthService_HasAccess_FullMethodName,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockSession: &model.ValidateTokenResponse{
+				UserID:   userID,
+				TokenID:  tokenID,
+				Scopes:   []string{constant.PermissionAllowGuest},
+				ClientID: "oauth_client",
+			},
+			wantHandler:  true,
+			wantUserID:   userID,
+			wantTokenID:  tokenID,
+			wantScopes:   []string{constant.PermissionAllowGuest},
+			wantClientID: "oauth_client",
+		},
+		{
+			name:          "non bearer scheme",
+			method:        adminMethod,
+			authorization: "Basic dXNlcjpwYXNz",
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "non bearer scheme on a guest method is a guest",
+			method:        guestMethod,
+			authorization: "Basic dXNlcjpwYXNz",
+			wantHandler:   true,
+			wantUserID:    constant.GuestID,
+		},
+		{
+			name:          "expired token",
+			method:        adminMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockErr:       model.ErrTokenExpired,
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "revoked token",
+			method:        adminMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockErr:       model.ErrTokenRevoked,
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "expired token on a guest method is a guest",
+			method:        guestMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockErr:       model.ErrTokenExpired,
+			wantHandler:   true,
+			wantUserID:    constant.GuestID,
+		},
+		{
+			name:          "system subject is rejected",
+			method:        adminMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockSession:   &model.ValidateTokenResponse{UserID: constant.SystemID, TokenID: tokenID},
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "system subject is rejected on a guest method",
+			method:        guestMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockSession:   &model.ValidateTokenResponse{UserID: constant.SystemID, TokenID: tokenID},
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "error validate token",
+			method:        guestMethod,
+			authorization: "Bearer token",
+			wantValidate:  true,
+			mockErr:       errors.New("redis error"),
+			wantCode:      codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+			server := NewGRPCServer()
+			err := server.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+
+			if tt.wantValidate {
+				authUsecase.EXPECT().ValidateToken(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockSession, tt.mockErr)
+			}
+
+			ctx := context.TODO()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, tt.authorization))
+			}
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				if got := getUserIDFromCtx(ctx); got != tt.wantUserID {
+					t.Errorf("UnaryAuthInterceptor() user id = %v, want %v", got, tt.wantUserID)
+				}
+				if got := getTokenIDFromCtx(ctx); got != tt.wantTokenID {
+					t.Errorf("UnaryAuthInterceptor() token id = %v, want %v", got, tt.wantTokenID)
+				}
+				if got, _ := ctx.Value(constant.KeyTokenScopesCtx).([]string); !reflect.DeepEqual(got, tt.wantScopes) {
+					t.Errorf("UnaryAuthInterceptor() scopes = %v, want %v", got, tt.wantScopes)
+				}
+				if got, _ := ctx.Value(constant.KeyClientIDCtx).(string); got != tt.wantClientID {
+					t.Errorf("UnaryAuthInterceptor() client id = %v, want %v", got, tt.wantClientID)
+				}
+				return nil, nil
+			}
+
+			_, err = server.UnaryAuthInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("UnaryAuthInterceptor() code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+			if called != tt.wantHandler {
+				t.Errorf("UnaryAuthInterceptor() handler called = %v, want %v", called, tt.wantHandler)
+			}
+		})
+	}
+}
+
+// headerStream record the headers set by an interceptor.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return ""
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+func TestServer_UnaryRateLimitInterceptor(t *testing.T) {
+	method := pb.AuthService_Login_FullMethodName
+
+	tests := []struct {
+		name           string
+		address        bool
+		mockRes        *model.RateLimitResult
+		mockErr        error
+		wantHandler    bool
+		wantRetryAfter string
+		wantCode       codes.Code
+	}{
+		{
+			name:        "allowed",
+			mockRes:     &model.RateLimitResult{Allowed: true},
+			wantHandler: true,
+		},
+		{
+			name:           "rejected with retry after rounded up",
+			mockRes:        &model.RateLimitResult{RetryAfter: 1500 * time.Millisecond},
+			wantRetryAfter: "2",
+			wantCode:       codes.ResourceExhausted,
+		},
+		{
+			name:        "fail open when the limiter is broken",
+			mockErr:     errors.New("redis error"),
+			wantHandler: true,
+		},
+		{
+			name:        "address allowed",
+			address:     true,
+			mockRes:     &model.RateLimitResult{Allowed: true},
+			wantHandler: true,
+		},
+		{
+			name:           "address rejected with retry after",
+			address:        true,
+			mockRes:        &model.RateLimitResult{RetryAfter: 30 * time.Second},
+			wantRetryAfter: "30",
+			wantCode:       codes.ResourceExhausted,
+		},
+		{
+			name:        "address fail open when the limiter is broken",
+			address:     true,
+			mockErr:     errors.New("redis error"),
+			wantHandler: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rateLimitUsecase := mock.NewMockRateLimitUsecase(ctrl)
+			server := NewGRPCServer()
+			err := server.InjectRateLimitUsecase(rateLimitUsecase)
+			utils.ContinueOrFatal(err)
+
+			interceptor := server.UnaryRateLimitInterceptor
+			if tt.address {
+				rateLimitUsecase.EXPECT().AllowAddress(gomock.Any(), method).Times(1).Return(tt.mockRes, tt.mockErr)
+				interceptor = server.UnaryAddressRateLimitInterceptor
+			} else {
+				rateLimitUsecase.EXPECT().Allow(gomock.Any(), method).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			stream := new(headerStream)
+			ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("UnaryRateLimitInterceptor() code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+			if called != tt.wantHandler {
+				t.Errorf("UnaryRateLimitInterceptor() handler called = %v, want %v", called, tt.wantHandler)
+			}
+			var retryAfter string
+			if values := stream.header.Get(retryAfterHeader); len(values) > 0 {
+				retryAfter = values[0]
+			}
+			if retryAfter != tt.wantRetryAfter {
+				t.Errorf("UnaryRateLimitInterceptor() retry after = %v, want %v", retryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) EnrollMFA(ctx context.Context, req *emptypb.Empty) (*pb.EnrollMFAResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+	})
+
+	res, err := t.mfaUC.Enroll(ctx)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrUserNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrMFAAlreadyEnabled:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) ConfirmMFA(ctx context.Context, req *pb.ConfirmMFARequest) (*pb.ConfirmMFAResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+	})
+
+	payload := new(model.ConfirmMFAPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.mfaUC.Confirm(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrMFACodeRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrMFACodeInvalid:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrMFANotEnrolled:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrMFAAlreadyEnabled:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) DisableMFA(ctx context.Context, req *pb.DisableMFARequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+	})
+
+	payload := new(model.DisableMFAPayload)
+	payload.ParseFromProto(req)
+
+	err := t.mfaUC.Disable(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrMFACodeRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrMFACodeInvalid:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrMFANotEnabled:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.AuthResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.VerifyMFAPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.mfaUC.VerifyChallenge(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrMFACodeRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrMFACodeInvalid:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrMFAChallengeInvalid:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrAccountPending, model.ErrAccountSuspended, model.ErrAccountDeactivated:
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	default:
+		logrus.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
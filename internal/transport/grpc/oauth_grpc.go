@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) CreateOAuthClient(ctx context.Context, req *pb.CreateOAuthClientRequest) (*pb.CreateOAuthClientResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"name":          req.GetName(),
+	})
+
+	payload := new(model.CreateOAuthClientPayload)
+	payload.ParseFromProto(req)
+
+	client, err := t.oauthUC.CreateClient(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrOAuthInvalidRequest:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrOAuthInvalidRedirectURI:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return client.ToGRPCResponse(), nil
+}
+
+func (t *Server) DeleteOAuthClient(ctx context.Context, req *pb.DeleteOAuthClientRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"clientID":      req.GetClientId(),
+	})
+
+	payload := new(model.DeleteOAuthClientPayload)
+	payload.ParseFromProto(req)
+
+	err := t.oauthUC.DeleteClient(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrOAuthClientNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.RequestPasswordResetPayload)
+	payload.ParseFromProto(req)
+
+	err := t.passwordResetUC.RequestPasswordReset(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrEmailRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		logrus.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ResetPasswordPayload)
+	payload.ParseFromProto(req)
+
+	err := t.passwordResetUC.ResetPassword(ctx, payload)
+	if policyErr := passwordPolicyStatusError(err); policyErr != nil {
+		return nil, policyErr
+	}
+	switch err {
+	case nil:
+	case model.ErrPasswordRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrPasswordResetTokenInvalid:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		logrus.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
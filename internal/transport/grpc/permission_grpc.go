@@ -18,12 +18,10 @@ func (t *Server) FindPermissionByID(ctx context.Context, req *pb.FindPermissionB
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"permissionID":  req.GetId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindPermissionByIDPayload)
 	payload.ParseFromProto(req)
 
@@ -48,12 +46,10 @@ func (t *Server) FindPermissionByName(ctx context.Context, req *pb.FindPermissio
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID":  req.GetSessionUserId(),
+		"sessionUserID":  getUserIDFromCtx(ctx),
 		"permissionName": req.GetName(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindPermissionByNamePayload)
 	payload.ParseFromProto(req)
 
@@ -78,12 +74,10 @@ func (t *Server) CreatePermission(ctx context.Context, req *pb.CreatePermissionR
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID":  req.GetSessionUserId(),
+		"sessionUserID":  getUserIDFromCtx(ctx),
 		"permissionName": req.GetName(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.CreatePermissionPayload)
 	payload.ParseFromProto(req)
 
@@ -108,12 +102,10 @@ func (t *Server) DeletePermission(ctx context.Context, req *pb.DeletePermissionR
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"permissionID":  req.GetId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.DeletePermissionByIDPayload)
 	payload.ParseFromProto(req)
 
@@ -131,3 +123,31 @@ func (t *Server) DeletePermission(ctx context.Context, req *pb.DeletePermissionR
 
 	return &emptypb.Empty{}, nil
 }
+
+func (t *Server) ListPermissions(ctx context.Context, req *pb.ListPermissionsRequest) (*pb.ListPermissionsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"namePrefix":    req.GetNamePrefix(),
+	})
+
+	payload := new(model.ListPermissionsPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.permissionUC.List(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrInvalidPageToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
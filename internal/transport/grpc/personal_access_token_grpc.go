@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) CreatePersonalAccessToken(ctx context.Context, req *pb.CreatePersonalAccessTokenRequest) (*pb.CreatePersonalAccessTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"name":          req.GetName(),
+		"scopes":        req.GetScopes(),
+	})
+
+	payload := new(model.CreatePersonalAccessTokenPayload)
+	err := payload.ParseFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	personalAccessToken, err := t.personalAccessTokenUC.Create(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrInvalidPersonalAccessToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return personalAccessToken.ToGRPCResponse(), nil
+}
+
+func (t *Server) ListPersonalAccessTokens(ctx context.Context, req *emptypb.Empty) (*pb.ListPersonalAccessTokensResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+	})
+
+	personalAccessTokens, err := t.personalAccessTokenUC.FindByCurrentUser(ctx)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return personalAccessTokens.ToGRPCResponse(), nil
+}
+
+func (t *Server) RevokePersonalAccessToken(ctx context.Context, req *pb.RevokePersonalAccessTokenRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"id":            req.GetId(),
+	})
+
+	payload := new(model.RevokePersonalAccessTokenPayload)
+	payload.ParseFromProto(req)
+
+	err := t.personalAccessTokenUC.Revoke(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrPersonalAccessTokenNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,210 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) ClientCredentials(ctx context.Context, req *pb.ClientCredentialsRequest) (*pb.AuthResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": req.GetClientId(),
+	})
+
+	payload := new(model.ClientCredentialsPayload)
+	payload.ParseFromProto(req)
+
+	result, err := t.serviceAccountUC.ClientCredentials(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrInvalidClientCredentials:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return result.ToGRPCResponse(), nil
+}
+
+func (t *Server) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountRequest) (*pb.CreateServiceAccountResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"name":          req.GetName(),
+	})
+
+	payload := new(model.CreateServiceAccountPayload)
+	payload.ParseFromProto(req)
+
+	serviceAccount, err := t.serviceAccountUC.Create(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrInvalidServiceAccount:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return serviceAccount.ToGRPCResponse(), nil
+}
+
+func (t *Server) FindServiceAccountByID(ctx context.Context, req *pb.FindServiceAccountByIDRequest) (*pb.ServiceAccount, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"id":            req.GetId(),
+	})
+
+	payload := new(model.FindServiceAccountByIDPayload)
+	payload.ParseFromProto(req)
+
+	serviceAccount, err := t.serviceAccountUC.FindByID(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrServiceAccountNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return serviceAccount.ToGRPCResponse(), nil
+}
+
+func (t *Server) DeleteServiceAccount(ctx context.Context, req *pb.DeleteServiceAccountRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"id":            req.GetId(),
+	})
+
+	payload := new(model.DeleteServiceAccountPayload)
+	payload.ParseFromProto(req)
+
+	err := t.serviceAccountUC.Delete(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrServiceAccountNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) FindAllServiceAccountGroups(ctx context.Context, req *pb.FindAllServiceAccountGroupsRequest) (*pb.FindAllServiceAccountGroupsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID":    getUserIDFromCtx(ctx),
+		"serviceAccountID": req.GetServiceAccountId(),
+	})
+
+	payload := new(model.FindServiceAccountGroupsPayload)
+	payload.ParseFromProto(req)
+
+	serviceAccountGroups, err := t.serviceAccountUC.FindGroups(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return serviceAccountGroups.ToGRPCResponse(), nil
+}
+
+func (t *Server) CreateServiceAccountGroup(ctx context.Context, req *pb.ServiceAccountGroupRequest) (*pb.ServiceAccountGroup, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID":    getUserIDFromCtx(ctx),
+		"serviceAccountID": req.GetServiceAccountId(),
+		"groupID":          req.GetGroupId(),
+	})
+
+	payload := new(model.ServiceAccountGroupPayload)
+	payload.ParseFromProto(req)
+
+	serviceAccountGroup, err := t.serviceAccountUC.AddGroup(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrServiceAccountNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrGroupNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrServiceAccountGroupAlreadyExist:
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return serviceAccountGroup.ToGRPCResponse(), nil
+}
+
+func (t *Server) DeleteServiceAccountGroup(ctx context.Context, req *pb.ServiceAccountGroupRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID":    getUserIDFromCtx(ctx),
+		"serviceAccountID": req.GetServiceAccountId(),
+		"groupID":          req.GetGroupId(),
+	})
+
+	payload := new(model.ServiceAccountGroupPayload)
+	payload.ParseFromProto(req)
+
+	err := t.serviceAccountUC.RemoveGroup(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrServiceAccountGroupNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"userID":        req.GetUserId(),
+	})
+
+	payload := new(model.ListSessionsPayload)
+	payload.ParseFromProto(req)
+
+	sessions, err := t.sessionUC.ListSessions(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return sessions.ToGRPCResponse(), nil
+}
+
+func (t *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"userID":        req.GetUserId(),
+		"tokenID":       req.GetTokenId(),
+	})
+
+	payload := new(model.RevokeSessionPayload)
+	payload.ParseFromProto(req)
+
+	err := t.sessionUC.RevokeSession(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrSessionNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"userID":        req.GetUserId(),
+		"exceptCurrent": req.GetExceptCurrent(),
+	})
+
+	payload := new(model.RevokeAllSessionsPayload)
+	payload.ParseFromProto(req)
+
+	err := t.sessionUC.RevokeAllSessions(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
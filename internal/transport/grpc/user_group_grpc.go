@@ -18,12 +18,10 @@ func (t *Server) FindAllUserGroups(ctx context.Context, req *pb.FindAllUserGroup
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"userID":        req.GetUserId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindUserGroupsByUserIDPayload)
 	payload.ParseFromProto(req)
 
@@ -48,13 +46,11 @@ func (t *Server) FindUserGroup(ctx context.Context, req *pb.FindUserGroupRequest
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"userID":        req.GetUserId(),
 		"groupID":       req.GetGroupId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.FindUserGroupPayload)
 	payload.ParseFromProto(req)
 
@@ -83,13 +79,11 @@ func (t *Server) CreateUserGroup(ctx context.Context, req *pb.CreateUserGroupReq
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"userID":        req.GetUserId(),
 		"groupID":       req.GetGroupId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.CreateUserGroupPayload)
 	payload.ParseFromProto(req)
 
@@ -120,13 +114,11 @@ func (t *Server) DeleteUserGroup(ctx context.Context, req *pb.DeleteUserGroupReq
 	defer span.End()
 
 	logger := logrus.WithFields(logrus.Fields{
-		"sessionUserID": req.GetSessionUserId(),
+		"sessionUserID": getUserIDFromCtx(ctx),
 		"userID":        req.GetUserId(),
 		"groupID":       req.GetGroupId(),
 	})
 
-	ctx = setUserIDCtx(ctx, req.GetSessionUserId())
-
 	payload := new(model.DeleteUserGroupPayload)
 	payload.ParseFromProto(req)
 
@@ -148,3 +140,63 @@ func (t *Server) DeleteUserGroup(ctx context.Context, req *pb.DeleteUserGroupReq
 
 	return &emptypb.Empty{}, nil
 }
+
+func (t *Server) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersRequest) (*pb.ListGroupMembersResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"groupID":       req.GetGroupId(),
+	})
+
+	payload := new(model.ListGroupMembersPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.userGroupUC.ListGroupMembers(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrGroupNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrInvalidPageToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) ListUsersWithPermission(ctx context.Context, req *pb.ListUsersWithPermissionRequest) (*pb.ListUsersWithPermissionResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"permissionID":  req.GetPermissionId(),
+	})
+
+	payload := new(model.ListUsersWithPermissionPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.userGroupUC.ListUsersWithPermission(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrPermissionNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrInvalidPageToken:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res.ToGRPCResponse(), nil
+}
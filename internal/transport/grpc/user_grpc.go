@@ -3,9 +3,11 @@ package grpc
 import (
 	"context"
 
+	"github.com/krobus00/auth-service/internal/constant"
 	"github.com/krobus00/auth-service/internal/model"
 	"github.com/krobus00/auth-service/internal/utils"
 	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -24,6 +26,18 @@ func (t *Server) Login(ctx context.Context, req *pb.LoginRequest) (*pb.AuthRespo
 	case nil:
 	case model.ErrWrongUsernameOrPassword:
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrUsernameOrEmailAlreadyTaken:
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case model.ErrDirectoryEntryIncomplete:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrDirectoryUnavailable:
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case model.ErrEmailNotVerified:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrAccountLocked, model.ErrAccountPending, model.ErrAccountSuspended, model.ErrAccountDeactivated:
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case model.ErrTooManyLoginAttempts:
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
 	default:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -39,6 +53,9 @@ func (t *Server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.Aut
 	payload.ParseFromProto(req)
 
 	result, err := t.userUC.Register(ctx, payload)
+	if policyErr := passwordPolicyStatusError(err); policyErr != nil {
+		return nil, policyErr
+	}
 	switch err {
 	case nil:
 	case model.ErrUsernameOrEmailAlreadyTaken:
@@ -54,8 +71,15 @@ func (t *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Em
 	ctx, span := utils.NewSpan(ctx, fn)
 	defer span.End()
 
-	payload := new(model.UserLogoutPayload)
-	payload.ParseFromProto(req)
+	userID := getUserIDFromCtx(ctx)
+	if userID == constant.GuestID {
+		return nil, status.Error(codes.Unauthenticated, model.ErrUnauthorizeAccess.Error())
+	}
+
+	payload := &model.UserLogoutPayload{
+		UserID:  userID,
+		TokenID: getTokenIDFromCtx(ctx),
+	}
 
 	err := t.userUC.Logout(ctx, payload)
 	switch err {
@@ -67,3 +91,202 @@ func (t *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Em
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (t *Server) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ChangePasswordPayload)
+	payload.ParseFromProto(req)
+
+	err := t.userUC.ChangePassword(ctx, payload)
+	if policyErr := passwordPolicyStatusError(err); policyErr != nil {
+		return nil, policyErr
+	}
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrPasswordRequired, model.ErrWrongPassword:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUserNotFound, model.ErrDirectoryManagedPassword:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.UpdateProfilePayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.userUC.UpdateProfile(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrFullNameRequired:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUserNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"userID":        req.GetUserId(),
+	})
+
+	payload := new(model.UnlockUserPayload)
+	payload.ParseFromProto(req)
+
+	err := t.loginAttemptUC.UnlockUser(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrUserNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (t *Server) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ChangeUserStatusPayload)
+	if err := payload.ParseSuspendFromProto(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return t.changeUserStatus(ctx, payload)
+}
+
+func (t *Server) DeactivateUser(ctx context.Context, req *pb.DeactivateUserRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ChangeUserStatusPayload)
+	payload.ParseDeactivateFromProto(req)
+
+	return t.changeUserStatus(ctx, payload)
+}
+
+func (t *Server) ReactivateUser(ctx context.Context, req *pb.ReactivateUserRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ChangeUserStatusPayload)
+	payload.ParseReactivateFromProto(req)
+
+	return t.changeUserStatus(ctx, payload)
+}
+
+func (t *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ChangeUserStatusPayload)
+	payload.ParseDeleteFromProto(req)
+
+	return t.changeUserStatus(ctx, payload)
+}
+
+func (t *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"userID":        req.GetUserId(),
+	})
+
+	payload := new(model.UpdateUserPayload)
+	payload.ParseFromProto(req)
+
+	res, err := t.userUC.UpdateUser(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrInvalidUsername, model.ErrInvalidEmail:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrUserNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUsernameOrEmailAlreadyTaken:
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	payload := new(model.ListUsersPayload)
+	if err := payload.ParseFromProto(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := t.userUC.ListUsers(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrInvalidPageToken, model.ErrInvalidUserFilter:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		logrus.WithField("sessionUserID", getUserIDFromCtx(ctx)).Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res.ToGRPCResponse(), nil
+}
+
+func (t *Server) changeUserStatus(ctx context.Context, payload *model.ChangeUserStatusPayload) (*emptypb.Empty, error) {
+	err := t.userStatusUC.ChangeStatus(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case model.ErrUserNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUserStatusReasonRequired, model.ErrInvalidSuspensionEnd:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrInvalidUserStatusTransition, model.ErrChangeOwnStatus:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		logrus.WithFields(logrus.Fields{
+			"sessionUserID": getUserIDFromCtx(ctx),
+			"userID":        payload.UserID,
+		}).Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	pb "github.com/krobus00/auth-service/pb/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (t *Server) FindAllUserIdentities(ctx context.Context, req *emptypb.Empty) (*pb.FindAllUserIdentitiesResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+	})
+
+	identities, err := t.userIdentityUC.FindByCurrentUser(ctx)
+	switch err {
+	case nil:
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return identities.ToGRPCResponse(), nil
+}
+
+func (t *Server) LinkUserIdentity(ctx context.Context, req *pb.LinkUserIdentityRequest) (*pb.LinkUserIdentityResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"provider":      req.GetProvider(),
+	})
+
+	payload := new(model.LinkUserIdentityPayload)
+	payload.ParseFromProto(req)
+
+	authorizationURL, err := t.userIdentityUC.StartLink(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrIdentityProviderNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrIdentityProviderExchange:
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.LinkUserIdentityResponse{
+		AuthorizationUrl: authorizationURL,
+	}, nil
+}
+
+func (t *Server) UnlinkUserIdentity(ctx context.Context, req *pb.UnlinkUserIdentityRequest) (*emptypb.Empty, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": getUserIDFromCtx(ctx),
+		"provider":      req.GetProvider(),
+	})
+
+	payload := new(model.UnlinkUserIdentityPayload)
+	payload.ParseFromProto(req)
+
+	err := t.userIdentityUC.Unlink(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrUserIdentityNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrUserIdentityRequired:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrUnauthorizeAccess:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+type Server struct {
+	authUC              model.AuthUsecase
+	oauthUC             model.OAuthUsecase
+	userIdentityUC      model.UserIdentityUsecase
+	emailVerificationUC model.EmailVerificationUsecase
+}
+
+func NewHTTPServer() *Server {
+	return new(Server)
+}
+
+// RegisterHandlers mount the authorization server endpoints.
+func (t *Server) RegisterHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("/.well-known/jwks.json", t.JWKS)
+	mux.HandleFunc("/.well-known/openid-configuration", t.OpenIDConfiguration)
+	mux.HandleFunc("/authorize", t.Authorize)
+	mux.HandleFunc("/token", t.Token)
+	mux.HandleFunc("/userinfo", t.UserInfo)
+	mux.HandleFunc(model.FederatedLoginPath, t.FederatedLogin)
+	mux.HandleFunc(model.FederatedCallbackPath, t.FederatedCallback)
+	mux.HandleFunc(model.VerifyEmailPath, t.VerifyEmail)
+}
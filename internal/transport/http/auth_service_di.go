@@ -0,0 +1,39 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (t *Server) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	t.authUC = usecase
+	return nil
+}
+
+func (t *Server) InjectOAuthUsecase(usecase model.OAuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid oauth usecase")
+	}
+	t.oauthUC = usecase
+	return nil
+}
+
+func (t *Server) InjectUserIdentityUsecase(usecase model.UserIdentityUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user identity usecase")
+	}
+	t.userIdentityUC = usecase
+	return nil
+}
+
+func (t *Server) InjectEmailVerificationUsecase(usecase model.EmailVerificationUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid email verification usecase")
+	}
+	t.emailVerificationUC = usecase
+	return nil
+}
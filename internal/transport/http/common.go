@@ -0,0 +1,43 @@
+package http
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/goccy/go-json"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	bearerPrefix   = "bearer "
+	deviceIDHeader = "X-Device-ID"
+)
+
+func writeJSON(w http.ResponseWriter, statusCode int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	err := json.NewEncoder(w).Encode(data)
+	if err != nil {
+		logrus.Error(err.Error())
+	}
+}
+
+func setSessionMetadataCtx(ctx context.Context, metadata *model.SessionMetadata) context.Context {
+	return context.WithValue(ctx, constant.KeySessionMetadataCtx, metadata)
+}
+
+// newSessionMetadata collect the client details recorded on the sessions a request mint.
+func newSessionMetadata(r *http.Request) *model.SessionMetadata {
+	sessionMetadata := &model.SessionMetadata{
+		IPAddress: r.RemoteAddr,
+		UserAgent: r.UserAgent(),
+		DeviceID:  r.Header.Get(deviceIDHeader),
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		sessionMetadata.IPAddress = host
+	}
+	return sessionMetadata
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// VerifyEmail serve the link sent in the verification email.
+func (t *Server) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	ctx = setSessionMetadataCtx(ctx, newSessionMetadata(r))
+
+	err := t.emailVerificationUC.VerifyEmail(ctx, &model.VerifyEmailPayload{
+		Token: r.URL.Query().Get("token"),
+	})
+	switch err {
+	case nil:
+	case model.ErrEmailVerificationTokenInvalid:
+		writeJSON(w, http.StatusBadRequest, model.NewResponse().WithMessage(err.Error()))
+		return
+	default:
+		logrus.Error(err.Error())
+		writeJSON(w, http.StatusInternalServerError, model.NewResponse().WithMessage(http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, model.NewResponse().WithMessage("email verified"))
+}
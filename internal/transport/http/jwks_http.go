@@ -0,0 +1,28 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func (t *Server) JWKS(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	jwks, err := t.authUC.GetJWKS(ctx)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, model.NewResponse().WithErrorMessage(err))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, jwks)
+}
@@ -0,0 +1,314 @@
+package http
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	oauthErrorInvalidRequest          = "invalid_request"
+	oauthErrorInvalidClient           = "invalid_client"
+	oauthErrorInvalidGrant            = "invalid_grant"
+	oauthErrorAccessDenied            = "access_denied"
+	oauthErrorUnsupportedGrantType    = "unsupported_grant_type"
+	oauthErrorUnsupportedResponseType = "unsupported_response_type"
+	oauthErrorInvalidScope            = "invalid_scope"
+	oauthErrorServerError             = "server_error"
+)
+
+// authorizeCSRFCookie hold the hash of the token embedded in the sign in form, a post
+// without the matching pair didn't come from a form served to this user agent.
+const authorizeCSRFCookie = "authorize_csrf"
+
+var authorizeTemplate = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Sign in to {{.ClientName}}</title></head>
+<body>
+<h1>Sign in to {{.ClientName}}</h1>
+{{if .Error}}<p>{{.Error}}</p>{{end}}
+<form method="POST" action="/authorize">
+<input type="hidden" name="csrf_token" value="{{.CSRFToken}}">
+<input type="hidden" name="response_type" value="{{.Payload.ResponseType}}">
+<input type="hidden" name="client_id" value="{{.Payload.ClientID}}">
+<input type="hidden" name="redirect_uri" value="{{.Payload.RedirectURI}}">
+<input type="hidden" name="scope" value="{{.Payload.Scope}}">
+<input type="hidden" name="state" value="{{.Payload.State}}">
+<input type="hidden" name="code_challenge" value="{{.Payload.CodeChallenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Payload.CodeChallengeMethod}}">
+<input type="hidden" name="nonce" value="{{.Payload.Nonce}}">
+<input type="text" name="username" placeholder="username" value="{{.Payload.Username}}">
+<input type="password" name="password" placeholder="password">
+<input type="text" name="mfa_code" placeholder="authentication code (if enabled)" autocomplete="one-time-code">
+<button type="submit">Sign in</button>
+</form>
+{{range .Providers}}<p><a href="{{.URL}}">Sign in with {{.Name}}</a></p>
+{{end}}</body>
+</html>
+`))
+
+type authorizeView struct {
+	ClientName string
+	Error      string
+	CSRFToken  string
+	Payload    *model.AuthorizePayload
+	Providers  []*federatedLoginLink
+}
+
+type oauthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// Authorize render the sign in form on GET and issue the authorization code on POST.
+func (t *Server) Authorize(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	}
+
+	ctx = setSessionMetadataCtx(ctx, newSessionMetadata(r))
+
+	payload := parseAuthorizePayload(r)
+
+	client, err := t.oauthUC.ValidateAuthorizeRequest(ctx, payload)
+	if err != nil {
+		writeAuthorizeError(w, r, payload, err)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		renderAuthorize(w, http.StatusOK, &authorizeView{
+			ClientName: client.Name,
+			Payload:    payload,
+			Providers:  t.federatedLoginLinks(ctx, payload),
+		})
+		return
+	}
+
+	payload.Username = r.PostForm.Get("username")
+	if !hasAuthorizeCSRFToken(r) {
+		renderAuthorize(w, http.StatusForbidden, &authorizeView{
+			ClientName: client.Name,
+			Error:      model.ErrOAuthFormExpired.Error(),
+			Payload:    payload,
+			Providers:  t.federatedLoginLinks(ctx, payload),
+		})
+		return
+	}
+	payload.Password = r.PostForm.Get("password")
+	payload.MFACode = strings.TrimSpace(r.PostForm.Get("mfa_code"))
+
+	res, err := t.oauthUC.Authorize(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrWrongUsernameOrPassword, model.ErrMFACodeRequired, model.ErrMFACodeInvalid:
+		payload.Password = ""
+		payload.MFACode = ""
+		renderAuthorize(w, http.StatusUnauthorized, &authorizeView{
+			ClientName: client.Name,
+			Error:      err.Error(),
+			Payload:    payload,
+			Providers:  t.federatedLoginLinks(ctx, payload),
+		})
+		return
+	case model.ErrEmailNotVerified, model.ErrAccountLocked,
+		model.ErrAccountPending, model.ErrAccountSuspended, model.ErrAccountDeactivated:
+		payload.Password = ""
+		payload.MFACode = ""
+		renderAuthorize(w, http.StatusForbidden, &authorizeView{
+			ClientName: client.Name,
+			Error:      err.Error(),
+			Payload:    payload,
+			Providers:  t.federatedLoginLinks(ctx, payload),
+		})
+		return
+	case model.ErrTooManyLoginAttempts:
+		payload.Password = ""
+		payload.MFACode = ""
+		renderAuthorize(w, http.StatusTooManyRequests, &authorizeView{
+			ClientName: client.Name,
+			Error:      err.Error(),
+			Payload:    payload,
+			Providers:  t.federatedLoginLinks(ctx, payload),
+		})
+		return
+	default:
+		writeAuthorizeError(w, r, payload, err)
+		return
+	}
+
+	http.Redirect(w, r, res.RedirectURL(), http.StatusFound)
+}
+
+func parseAuthorizePayload(r *http.Request) *model.AuthorizePayload {
+	return &model.AuthorizePayload{
+		ResponseType:        r.Form.Get("response_type"),
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		State:               r.Form.Get("state"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+		Nonce:               r.Form.Get("nonce"),
+	}
+}
+
+// writeAuthorizeError report the error back to the client redirect uri once it is trusted,
+// otherwise the user agent must not be redirected.
+func writeAuthorizeError(w http.ResponseWriter, r *http.Request, payload *model.AuthorizePayload, err error) {
+	var code string
+	switch err {
+	case model.ErrOAuthInvalidClient:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidClient, err)
+		return
+	case model.ErrOAuthInvalidRedirectURI:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	case model.ErrOAuthInvalidRequest:
+		code = oauthErrorInvalidRequest
+	case model.ErrOAuthUnsupportedResponseType:
+		code = oauthErrorUnsupportedResponseType
+	case model.ErrOAuthInvalidScope:
+		code = oauthErrorInvalidScope
+	case model.ErrUnauthorizeAccess:
+		code = oauthErrorAccessDenied
+	default:
+		logrus.WithField("clientID", payload.ClientID).Error(err.Error())
+		code = oauthErrorServerError
+	}
+
+	http.Redirect(w, r, model.BuildOAuthRedirectURL(payload.RedirectURI, url.Values{
+		"error":             {code},
+		"error_description": {err.Error()},
+		"state":             {payload.State},
+	}), http.StatusFound)
+}
+
+// renderAuthorize serve the sign in form with a new CSRF token, the form can't be framed
+// by another site to trick the user into submitting it (RFC 6749 section 10.13).
+func renderAuthorize(w http.ResponseWriter, statusCode int, view *authorizeView) {
+	csrfToken, err := utils.GenerateSecret("")
+	if err != nil {
+		logrus.Error(err.Error())
+		writeOAuthError(w, http.StatusInternalServerError, oauthErrorServerError, err)
+		return
+	}
+	view.CSRFToken = csrfToken
+	http.SetCookie(w, &http.Cookie{
+		Name:     authorizeCSRFCookie,
+		Value:    utils.HashSecret(csrfToken),
+		Path:     "/authorize",
+		Secure:   strings.HasPrefix(config.OAuthIssuer(), "https://"),
+		HttpOnly: true,
+		// the form always post to this server
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	w.WriteHeader(statusCode)
+	err = authorizeTemplate.Execute(w, view)
+	if err != nil {
+		logrus.Error(err.Error())
+	}
+}
+
+func hasAuthorizeCSRFToken(r *http.Request) bool {
+	cookie, err := r.Cookie(authorizeCSRFCookie)
+	if err != nil {
+		return false
+	}
+	csrfToken := r.PostForm.Get("csrf_token")
+	return csrfToken != "" && utils.CompareSecret(cookie.Value, csrfToken)
+}
+
+// Token exchange an authorization code or a refresh token for tokens.
+// Clients authenticate with HTTP basic auth or with the client_id and client_secret form fields.
+func (t *Server) Token(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	}
+
+	ctx = setSessionMetadataCtx(ctx, newSessionMetadata(r))
+
+	payload := &model.OAuthTokenPayload{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+	}
+
+	clientID, clientSecret, basicAuth := r.BasicAuth()
+	if basicAuth {
+		payload.ClientID, _ = url.QueryUnescape(clientID)
+		payload.ClientSecret, _ = url.QueryUnescape(clientSecret)
+	}
+
+	res, err := t.oauthUC.Token(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrOAuthInvalidClient:
+		if basicAuth {
+			w.Header().Set("WWW-Authenticate", `Basic realm="token"`)
+		}
+		writeOAuthError(w, http.StatusUnauthorized, oauthErrorInvalidClient, err)
+		return
+	case model.ErrOAuthInvalidGrant:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidGrant, err)
+		return
+	case model.ErrOAuthInvalidRequest:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	case model.ErrOAuthUnsupportedGrantType:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorUnsupportedGrantType, err)
+		return
+	default:
+		logrus.WithField("clientID", payload.ClientID).Error(err.Error())
+		writeOAuthError(w, http.StatusInternalServerError, oauthErrorServerError, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	writeJSON(w, http.StatusOK, res)
+}
+
+func writeOAuthError(w http.ResponseWriter, statusCode int, code string, err error) {
+	writeJSON(w, statusCode, &oauthErrorResponse{
+		Error:            code,
+		ErrorDescription: err.Error(),
+	})
+}
@@ -0,0 +1,760 @@
+package http
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/infrastructure"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/repository"
+	"github.com/krobus00/auth-service/internal/usecase"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+const testCodeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+type oauthTestServer struct {
+	server           *httptest.Server
+	client           *http.Client
+	miniRedis        *miniredis.Miniredis
+	authUC           model.AuthUsecase
+	user             *model.User
+	oauthClient      *model.OAuthClient
+	clientSecret     string
+	redirectURI      string
+	dbMock           sqlmock.Sqlmock
+	userRepo         *mock.MockUserRepository
+	userIdentityRepo *mock.MockUserIdentityRepository
+	// signingKey sign the tokens, id tokens are only issued with an asymmetric key
+	signingKey *ecdsa.PrivateKey
+	// userMFA is returned for the test user, nil means mfa is not enrolled
+	userMFA *model.UserMFA
+}
+
+// newOAuthTestServer run the authorization server in process with real token, authorization code and
+// federation state stores on miniredis, only postgres backed repositories are mocked.
+func newOAuthTestServer(t *testing.T, ctrl *gomock.Controller) *oauthTestServer {
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	viper.Set("jwt.secret_key", "test-secret")
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	signingKey := loadTestSigningKey(t)
+
+	redirectURI := "https://dashboard.example.com/callback"
+	clientSecret := model.OAuthClientSecretPrefix + "secret"
+	oauthClient := &model.OAuthClient{
+		ID:               utils.GenerateUUID(),
+		ClientID:         model.OAuthClientIDPrefix + utils.GenerateUUID(),
+		Name:             "dashboard",
+		ClientSecretHash: utils.HashSecret(clientSecret),
+		RedirectURIs:     []string{redirectURI},
+	}
+	password, err := utils.HashPassword("password")
+	utils.ContinueOrFatal(err)
+	user := &model.User{
+		ID:       utils.GenerateUUID(),
+		FullName: "Krobus",
+		Username: "krobus",
+		Email:    "krobus@example.com",
+		Password: password,
+	}
+
+	userRepo := mock.NewMockUserRepository(ctrl)
+	userRepo.EXPECT().FindByUsername(gomock.Any(), user.Username).AnyTimes().Return(user, nil)
+	userRepo.EXPECT().FindByUsername(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	userRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	userRepo.EXPECT().FindByID(gomock.Any(), user.ID).AnyTimes().Return(user, nil)
+	oauthClientRepo := mock.NewMockOAuthClientRepository(ctrl)
+	oauthClientRepo.EXPECT().FindByClientID(gomock.Any(), oauthClient.ClientID).AnyTimes().Return(oauthClient, nil)
+	oauthClientRepo.EXPECT().FindByClientID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	securityEventRepo := mock.NewMockSecurityEventRepository(ctrl)
+	securityEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	groupRepo := mock.NewMockGroupRepository(ctrl)
+	groupRepo.EXPECT().FindByName(gomock.Any(), constant.GroupDefault).AnyTimes().Return(&model.Group{ID: utils.GenerateUUID(), Name: constant.GroupDefault}, nil)
+	userGroupRepo := mock.NewMockUserGroupRepository(ctrl)
+	userGroupRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	userIdentityRepo := mock.NewMockUserIdentityRepository(ctrl)
+	dbConn, dbMock := utils.NewDBMock()
+	ts := &oauthTestServer{}
+	userMFARepo := mock.NewMockUserMFARepository(ctrl)
+	userMFARepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, userID string) (*model.UserMFA, error) {
+		return ts.userMFA, nil
+	})
+	userMFARepo.EXPECT().UseStep(gomock.Any(), user.ID, gomock.Any()).AnyTimes().Return(true, nil)
+	userMFARepo.EXPECT().UseRecoveryCode(gomock.Any(), user.ID, gomock.Any()).AnyTimes().Return(false, nil)
+
+	tokenRepo := repository.NewTokenRepository()
+	err = tokenRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+	authorizationCodeRepo := repository.NewAuthorizationCodeRepository()
+	err = authorizationCodeRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+	federationStateRepo := repository.NewFederationStateRepository()
+	err = federationStateRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+	identityProviderRepo := repository.NewIdentityProviderRepository()
+	err = identityProviderRepo.InjectHTTPClient(http.DefaultClient)
+	utils.ContinueOrFatal(err)
+	mfaChallengeRepo := repository.NewMFAChallengeRepository()
+	err = mfaChallengeRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+	loginAttemptRepo := repository.NewLoginAttemptRepository()
+	err = loginAttemptRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	authUC := usecase.NewAuthUsecase()
+	err = authUC.InjectTokenRepo(tokenRepo)
+	utils.ContinueOrFatal(err)
+
+	loginAttemptUC := usecase.NewLoginAttemptUsecase()
+	err = loginAttemptUC.InjectAuthUsecase(authUC)
+	utils.ContinueOrFatal(err)
+	err = loginAttemptUC.InjectUserRepo(userRepo)
+	utils.ContinueOrFatal(err)
+	err = loginAttemptUC.InjectLoginAttemptRepo(loginAttemptRepo)
+	utils.ContinueOrFatal(err)
+	err = loginAttemptUC.InjectSecurityEventRepo(securityEventRepo)
+	utils.ContinueOrFatal(err)
+
+	userUC := usecase.NewUserUsecase()
+	err = userUC.InjectUserRepo(userRepo)
+	utils.ContinueOrFatal(err)
+	err = userUC.InjectTokenRepo(tokenRepo)
+	utils.ContinueOrFatal(err)
+	err = userUC.InjectSecurityEventRepo(securityEventRepo)
+	utils.ContinueOrFatal(err)
+	err = userUC.InjectGroupRepo(groupRepo)
+	utils.ContinueOrFatal(err)
+	err = userUC.InjectUserGroupRepo(userGroupRepo)
+	utils.ContinueOrFatal(err)
+	err = userUC.InjectLoginAttemptUsecase(loginAttemptUC)
+	utils.ContinueOrFatal(err)
+
+	mfaUC := usecase.NewMFAUsecase()
+	err = mfaUC.InjectUserUsecase(userUC)
+	utils.ContinueOrFatal(err)
+	err = mfaUC.InjectUserRepo(userRepo)
+	utils.ContinueOrFatal(err)
+	err = mfaUC.InjectUserMFARepo(userMFARepo)
+	utils.ContinueOrFatal(err)
+	err = mfaUC.InjectMFAChallengeRepo(mfaChallengeRepo)
+	utils.ContinueOrFatal(err)
+	err = mfaUC.InjectSecurityEventRepo(securityEventRepo)
+	utils.ContinueOrFatal(err)
+	err = mfaUC.InjectLoginAttemptUsecase(loginAttemptUC)
+	utils.ContinueOrFatal(err)
+	err = userUC.InjectMFAUsecase(mfaUC)
+	utils.ContinueOrFatal(err)
+
+	oauthUC := usecase.NewOAuthUsecase()
+	err = oauthUC.InjectAuthUsecase(authUC)
+	utils.ContinueOrFatal(err)
+	err = oauthUC.InjectUserUsecase(userUC)
+	utils.ContinueOrFatal(err)
+	err = oauthUC.InjectMFAUsecase(mfaUC)
+	utils.ContinueOrFatal(err)
+	err = oauthUC.InjectOAuthClientRepo(oauthClientRepo)
+	utils.ContinueOrFatal(err)
+	err = oauthUC.InjectAuthorizationCodeRepo(authorizationCodeRepo)
+	utils.ContinueOrFatal(err)
+	err = oauthUC.InjectTokenRepo(tokenRepo)
+	utils.ContinueOrFatal(err)
+
+	userIdentityUC := usecase.NewUserIdentityUsecase()
+	err = userIdentityUC.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = userIdentityUC.InjectOAuthUsecase(oauthUC)
+	utils.ContinueOrFatal(err)
+	err = userIdentityUC.InjectUserUsecase(userUC)
+	utils.ContinueOrFatal(err)
+	err = userIdentityUC.InjectUserRepo(userRepo)
+	utils.ContinueOrFatal(err)
+	err = userIdentityUC.InjectUserIdentityRepo(userIdentityRepo)
+	utils.ContinueOrFatal(err)
+	err = userIdentityUC.InjectFederationStateRepo(federationStateRepo)
+	utils.ContinueOrFatal(err)
+	err = userIdentityUC.InjectIdentityProviderRepo(identityProviderRepo)
+	utils.ContinueOrFatal(err)
+
+	httpDelivery := NewHTTPServer()
+	err = httpDelivery.InjectAuthUsecase(authUC)
+	utils.ContinueOrFatal(err)
+	err = httpDelivery.InjectOAuthUsecase(oauthUC)
+	utils.ContinueOrFatal(err)
+	err = httpDelivery.InjectUserIdentityUsecase(userIdentityUC)
+	utils.ContinueOrFatal(err)
+
+	mux := http.NewServeMux()
+	httpDelivery.RegisterHandlers(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := server.Client()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	client.Jar, err = cookiejar.New(nil)
+	utils.ContinueOrFatal(err)
+
+	*ts = oauthTestServer{
+		server:           server,
+		client:           client,
+		miniRedis:        miniRedis,
+		authUC:           authUC,
+		user:             user,
+		oauthClient:      oauthClient,
+		clientSecret:     clientSecret,
+		redirectURI:      redirectURI,
+		dbMock:           dbMock,
+		userRepo:         userRepo,
+		userIdentityRepo: userIdentityRepo,
+		signingKey:       signingKey,
+	}
+	return ts
+}
+
+// loadTestSigningKey sign the tokens with a new ES256 key until the test end.
+func loadTestSigningKey(t *testing.T) *ecdsa.PrivateKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	utils.ContinueOrFatal(err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	utils.ContinueOrFatal(err)
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+	utils.ContinueOrFatal(err)
+
+	viper.Set("jwt.signing_method", "ES256")
+	viper.Set("jwt.signing_key_id", "test")
+	viper.Set("jwt.signing_key_file", path)
+	utils.ContinueOrFatal(utils.LoadTokenKeys())
+	t.Cleanup(func() {
+		viper.Set("jwt.signing_method", "HS256")
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+	})
+	return privateKey
+}
+
+func (s *oauthTestServer) authorizeParams() url.Values {
+	return url.Values{
+		"response_type":         {model.OAuthResponseTypeCode},
+		"client_id":             {s.oauthClient.ClientID},
+		"redirect_uri":          {s.redirectURI},
+		"scope":                 {"openid profile"},
+		"nonce":                 {"n-0S6_WzA2Mj"},
+		"state":                 {"xyz"},
+		"code_challenge":        {utils.NewCodeChallenge(testCodeVerifier)},
+		"code_challenge_method": {model.OAuthCodeChallengeMethodS256},
+	}
+}
+
+var csrfTokenPattern = regexp.MustCompile(`name="csrf_token" value="([^"]+)"`)
+
+// signInForm load the sign in form like a browser would and return its CSRF token.
+func (s *oauthTestServer) signInForm(t *testing.T) string {
+	res, err := s.client.Get(s.server.URL + "/authorize?" + s.authorizeParams().Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	match := csrfTokenPattern.FindSubmatch(body)
+	if res.StatusCode != http.StatusOK || match == nil {
+		t.Fatalf("GET /authorize = %d, want %d with a CSRF token", res.StatusCode, http.StatusOK)
+	}
+	return string(match[1])
+}
+
+// authorize submit the sign in form served to the test user agent.
+func (s *oauthTestServer) authorize(t *testing.T, params url.Values) *http.Response {
+	params.Set("csrf_token", s.signInForm(t))
+	res, err := s.client.PostForm(s.server.URL+"/authorize", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	return res
+}
+
+func (s *oauthTestServer) token(t *testing.T, params url.Values, withBasicAuth bool) (int, map[string]any) {
+	req, err := http.NewRequest(http.MethodPost, s.server.URL+"/token", strings.NewReader(params.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if withBasicAuth {
+		req.SetBasicAuth(s.oauthClient.ClientID, s.clientSecret)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body := make(map[string]any)
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, body
+}
+
+func (s *oauthTestServer) userInfo(t *testing.T, accessToken string) (int, map[string]any) {
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/userinfo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body := make(map[string]any)
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, body
+}
+
+func Test_Server_OAuthAuthorizationCodeFlow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	// sign in form
+	res, err := s.client.Get(s.server.URL + "/authorize?" + s.authorizeParams().Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusOK || !strings.Contains(string(body), s.oauthClient.Name) {
+		t.Fatalf("GET /authorize = %d, want %d with the sign in form", res.StatusCode, http.StatusOK)
+	}
+
+	// wrong password keep the user on the form
+	params := s.authorizeParams()
+	params.Set("username", "krobus")
+	params.Set("password", "wrong")
+	res = s.authorize(t, params)
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("POST /authorize with wrong password = %d, want %d", res.StatusCode, http.StatusUnauthorized)
+	}
+
+	// the next attempt wait for the back-off delay
+	params.Set("password", "password")
+	res = s.authorize(t, params)
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("POST /authorize during the back-off delay = %d, want %d", res.StatusCode, http.StatusTooManyRequests)
+	}
+	s.miniRedis.FastForward(config.LoginAttemptBackoffBase())
+
+	// successful sign in redirect back with the code
+	res = s.authorize(t, params)
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("POST /authorize = %d, want %d", res.StatusCode, http.StatusFound)
+	}
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := location.Query().Get("code")
+	if !strings.HasPrefix(location.String(), s.redirectURI) || code == "" || location.Query().Get("state") != "xyz" {
+		t.Fatalf("POST /authorize redirected to %s", location)
+	}
+
+	// exchange the code for tokens
+	tokenParams := url.Values{
+		"grant_type":    {model.OAuthGrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {s.redirectURI},
+		"code_verifier": {testCodeVerifier},
+	}
+	statusCode, tokenRes := s.token(t, tokenParams, true)
+	if statusCode != http.StatusOK {
+		t.Fatalf("POST /token = %d %v, want %d", statusCode, tokenRes, http.StatusOK)
+	}
+	if tokenRes["token_type"] != model.OAuthTokenTypeBearer || tokenRes["scope"] != "openid profile" {
+		t.Errorf("POST /token = %v", tokenRes)
+	}
+	accessToken, _ := tokenRes["access_token"].(string)
+	refreshToken, _ := tokenRes["refresh_token"].(string)
+	_, err = s.authUC.ValidateToken(context.TODO(), &model.ValidateTokenPayload{
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		t.Errorf("access token minted by /token is not valid: %v", err)
+	}
+
+	idToken, _ := tokenRes["id_token"].(string)
+	idTokenClaims := new(model.IDTokenClaims)
+	_, err = jwt.ParseWithClaims(idToken, idTokenClaims, func(token *jwt.Token) (interface{}, error) {
+		return &s.signingKey.PublicKey, nil
+	})
+	if err != nil {
+		t.Fatalf("POST /token returned an invalid id token: %v", err)
+	}
+	if idTokenClaims.Subject != s.user.ID || idTokenClaims.Nonce != "n-0S6_WzA2Mj" ||
+		idTokenClaims.PreferredUsername != s.user.Username || idTokenClaims.Email != "" {
+		t.Errorf("POST /token id token claims = %+v", idTokenClaims)
+	}
+
+	// userinfo release the claims of the granted scope
+	statusCode, userInfo := s.userInfo(t, accessToken)
+	if statusCode != http.StatusOK || userInfo["sub"] != s.user.ID || userInfo["name"] != s.user.FullName {
+		t.Errorf("GET /userinfo = %d %v", statusCode, userInfo)
+	}
+	if _, ok := userInfo["email"]; ok {
+		t.Errorf("GET /userinfo released the email without the email scope")
+	}
+
+	// codes are single use
+	statusCode, tokenRes = s.token(t, tokenParams, true)
+	if statusCode != http.StatusBadRequest || tokenRes["error"] != oauthErrorInvalidGrant {
+		t.Errorf("POST /token with a used code = %d %v, want %d %s", statusCode, tokenRes, http.StatusBadRequest, oauthErrorInvalidGrant)
+	}
+
+	// refresh token grant rotate the tokens
+	statusCode, tokenRes = s.token(t, url.Values{
+		"grant_type":    {model.OAuthGrantTypeRefreshToken},
+		"refresh_token": {refreshToken},
+		"client_id":     {s.oauthClient.ClientID},
+		"client_secret": {s.clientSecret},
+	}, false)
+	if statusCode != http.StatusOK || tokenRes["refresh_token"] == refreshToken {
+		t.Errorf("POST /token with refresh token = %d %v, want %d", statusCode, tokenRes, http.StatusOK)
+	}
+}
+
+func Test_Server_Authorize_MFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabledAt := time.Now()
+	s.userMFA = &model.UserMFA{
+		UserID:    s.user.ID,
+		Secret:    secret,
+		EnabledAt: &enabledAt,
+	}
+
+	params := s.authorizeParams()
+	params.Set("username", "krobus")
+	params.Set("password", "password")
+
+	// the password alone is not enough
+	res := s.authorize(t, params)
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("POST /authorize without mfa code = %d, want %d", res.StatusCode, http.StatusUnauthorized)
+	}
+
+	params.Set("mfa_code", "000000")
+	code, err := utils.GenerateTOTPCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code == "000000" {
+		params.Set("mfa_code", "999999")
+	}
+	res = s.authorize(t, params)
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("POST /authorize with wrong mfa code = %d, want %d", res.StatusCode, http.StatusUnauthorized)
+	}
+
+	// the wrong code delay the next attempt like a wrong password
+	res = s.authorize(t, params)
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("POST /authorize right after a wrong mfa code = %d, want %d", res.StatusCode, http.StatusTooManyRequests)
+	}
+	s.miniRedis.FastForward(config.LoginAttemptBackoffBase())
+
+	params.Set("mfa_code", code)
+	res = s.authorize(t, params)
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("POST /authorize with mfa code = %d, want %d", res.StatusCode, http.StatusFound)
+	}
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(location.String(), s.redirectURI) || location.Query().Get("code") == "" {
+		t.Fatalf("POST /authorize redirected to %s", location)
+	}
+}
+
+func Test_Server_Authorize_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	tests := []struct {
+		name           string
+		params         func() url.Values
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name: "unregistered redirect uri is never followed",
+			params: func() url.Values {
+				params := s.authorizeParams()
+				params.Set("redirect_uri", "https://evil.example.com/callback")
+				return params
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown client",
+			params: func() url.Values {
+				params := s.authorizeParams()
+				params.Set("client_id", "unknown")
+				return params
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "missing code challenge is reported to the client",
+			params: func() url.Values {
+				params := s.authorizeParams()
+				params.Del("code_challenge")
+				return params
+			},
+			wantStatusCode: http.StatusFound,
+			wantError:      oauthErrorInvalidRequest,
+		},
+		{
+			name: "unsupported response type is reported to the client",
+			params: func() url.Values {
+				params := s.authorizeParams()
+				params.Set("response_type", "token")
+				return params
+			},
+			wantStatusCode: http.StatusFound,
+			wantError:      oauthErrorUnsupportedResponseType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.client.Get(s.server.URL + "/authorize?" + tt.params().Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = res.Body.Close()
+			if res.StatusCode != tt.wantStatusCode {
+				t.Fatalf("GET /authorize = %d, want %d", res.StatusCode, tt.wantStatusCode)
+			}
+			if tt.wantError == "" {
+				return
+			}
+			location, err := url.Parse(res.Header.Get("Location"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(location.String(), s.redirectURI) || location.Query().Get("error") != tt.wantError {
+				t.Errorf("GET /authorize redirected to %s, want error %s", location, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_Server_Authorize_CSRF(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	res, err := s.client.Get(s.server.URL + "/authorize?" + s.authorizeParams().Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.Header.Get("X-Frame-Options") != "DENY" || res.Header.Get("Content-Security-Policy") != "frame-ancestors 'none'" {
+		t.Errorf("GET /authorize can be framed, X-Frame-Options %q Content-Security-Policy %q",
+			res.Header.Get("X-Frame-Options"), res.Header.Get("Content-Security-Policy"))
+	}
+
+	tests := []struct {
+		name   string
+		client func() *http.Client
+		token  func() string
+	}{
+		{
+			name:   "missing token",
+			client: func() *http.Client { return s.client },
+			token:  func() string { return "" },
+		},
+		{
+			name:   "forged token",
+			client: func() *http.Client { return s.client },
+			token:  func() string { return "forged" },
+		},
+		{
+			name: "token of another user agent",
+			client: func() *http.Client {
+				return &http.Client{CheckRedirect: s.client.CheckRedirect}
+			},
+			token: func() string { return s.signInForm(t) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := s.authorizeParams()
+			params.Set("username", "krobus")
+			params.Set("password", "password")
+			params.Set("csrf_token", tt.token())
+
+			res, err := tt.client().PostForm(s.server.URL+"/authorize", params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(res.Body)
+			_ = res.Body.Close()
+			if res.StatusCode != http.StatusForbidden || !strings.Contains(string(body), model.ErrOAuthFormExpired.Error()) {
+				t.Errorf("POST /authorize = %d %s, want %d", res.StatusCode, res.Header.Get("Location"), http.StatusForbidden)
+			}
+		})
+	}
+}
+
+func Test_Server_Authorize_OpenIDWithSharedSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+	viper.Set("jwt.signing_method", "HS256")
+	utils.ContinueOrFatal(utils.LoadTokenKeys())
+
+	// any client knowing the shared secret could forge the id token
+	res, err := s.client.Get(s.server.URL + "/authorize?" + s.authorizeParams().Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusFound || location.Query().Get("error") != oauthErrorInvalidScope {
+		t.Errorf("GET /authorize with openid scope = %d %s, want error %s", res.StatusCode, location, oauthErrorInvalidScope)
+	}
+}
+
+func Test_Server_Token_InvalidClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	statusCode, tokenRes := s.token(t, url.Values{
+		"grant_type":    {model.OAuthGrantTypeAuthorizationCode},
+		"client_id":     {s.oauthClient.ClientID},
+		"client_secret": {"wrong"},
+		"code":          {"code"},
+	}, false)
+	if statusCode != http.StatusUnauthorized || tokenRes["error"] != oauthErrorInvalidClient {
+		t.Errorf("POST /token with wrong secret = %d %v, want %d %s", statusCode, tokenRes, http.StatusUnauthorized, oauthErrorInvalidClient)
+	}
+}
+
+func Test_Server_UserInfo_InvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	tests := []struct {
+		name           string
+		accessToken    string
+		wantStatusCode int
+	}{
+		{
+			name:           "missing token",
+			accessToken:    "",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "malformed token",
+			accessToken:    "not-a-token",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusCode, _ := s.userInfo(t, tt.accessToken)
+			if statusCode != tt.wantStatusCode {
+				t.Errorf("GET /userinfo = %d, want %d", statusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func Test_Server_OpenIDConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+	viper.Set("oauth.issuer", s.server.URL+"/")
+	defer viper.Set("oauth.issuer", "")
+
+	res, err := s.client.Get(s.server.URL + "/.well-known/openid-configuration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	configuration := new(model.OpenIDConfiguration)
+	err = json.NewDecoder(res.Body).Decode(configuration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || configuration.Issuer != s.server.URL ||
+		configuration.TokenEndpoint != s.server.URL+"/token" || configuration.UserInfoEndpoint != s.server.URL+"/userinfo" {
+		t.Errorf("GET /.well-known/openid-configuration = %d %+v", res.StatusCode, configuration)
+	}
+
+	if !model.HasScope(strings.Join(configuration.ScopesSupported, " "), model.OIDCScopeOpenID) ||
+		!reflect.DeepEqual(configuration.IDTokenSigningAlgValuesSupported, []string{"ES256"}) {
+		t.Errorf("GET /.well-known/openid-configuration scopes %v signed with %v", configuration.ScopesSupported, configuration.IDTokenSigningAlgValuesSupported)
+	}
+
+	// every advertised endpoint is served
+	for _, endpoint := range []string{configuration.JWKSURI, configuration.AuthorizationEndpoint} {
+		res, err := s.client.Get(endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			t.Errorf("GET %s = %d", endpoint, res.StatusCode)
+		}
+	}
+}
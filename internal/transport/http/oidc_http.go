@@ -0,0 +1,77 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	oauthErrorInvalidToken      = "invalid_token"
+	oauthErrorInsufficientScope = "insufficient_scope"
+)
+
+func (t *Server) OpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, t.oauthUC.OpenIDConfiguration(ctx))
+}
+
+// UserInfo return the claims about the owner of the bearer access token.
+func (t *Server) UserInfo(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	accessToken := getBearerToken(r)
+	if accessToken == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeOAuthError(w, http.StatusUnauthorized, oauthErrorInvalidRequest, model.ErrOAuthInvalidToken)
+		return
+	}
+
+	claims, err := t.oauthUC.UserInfo(ctx, accessToken)
+	switch err {
+	case nil:
+	case model.ErrOAuthInvalidToken:
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="%s"`, oauthErrorInvalidToken))
+		writeOAuthError(w, http.StatusUnauthorized, oauthErrorInvalidToken, err)
+		return
+	case model.ErrOAuthInsufficientScope:
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="%s", scope="%s"`, oauthErrorInsufficientScope, model.OIDCScopeOpenID))
+		writeOAuthError(w, http.StatusForbidden, oauthErrorInsufficientScope, err)
+		return
+	default:
+		logrus.Error(err.Error())
+		writeOAuthError(w, http.StatusInternalServerError, oauthErrorServerError, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, claims)
+}
+
+func getBearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) < len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len(bearerPrefix):])
+}
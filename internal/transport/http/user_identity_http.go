@@ -0,0 +1,150 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// federationBindingCookie keep the binding of a federated login in the user agent that started it.
+const federationBindingCookie = "federation_binding"
+
+type federatedLoginLink struct {
+	Name string
+	URL  string
+}
+
+// federatedLoginLinks carry the pending authorize request to every configured provider.
+func (t *Server) federatedLoginLinks(ctx context.Context, payload *model.AuthorizePayload) []*federatedLoginLink {
+	providers := t.userIdentityUC.Providers(ctx)
+	links := make([]*federatedLoginLink, 0, len(providers))
+	for _, provider := range providers {
+		query := url.Values{
+			"provider":              {provider},
+			"response_type":         {payload.ResponseType},
+			"client_id":             {payload.ClientID},
+			"redirect_uri":          {payload.RedirectURI},
+			"scope":                 {payload.Scope},
+			"state":                 {payload.State},
+			"code_challenge":        {payload.CodeChallenge},
+			"code_challenge_method": {payload.CodeChallengeMethod},
+			"nonce":                 {payload.Nonce},
+		}
+		links = append(links, &federatedLoginLink{
+			Name: provider,
+			URL:  model.FederatedLoginPath + "?" + query.Encode(),
+		})
+	}
+	return links
+}
+
+// FederatedLogin send the user agent to the upstream provider to sign in for the pending authorize request.
+func (t *Server) FederatedLogin(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	}
+
+	payload := &model.StartFederatedLoginPayload{
+		Provider:  r.Form.Get("provider"),
+		Authorize: parseAuthorizePayload(r),
+	}
+
+	res, err := t.userIdentityUC.StartLogin(ctx, payload)
+	switch err {
+	case nil:
+	case model.ErrIdentityProviderNotFound:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	default:
+		writeAuthorizeError(w, r, payload.Authorize, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     federationBindingCookie,
+		Value:    res.Binding,
+		Path:     model.FederatedCallbackPath,
+		MaxAge:   int(config.OAuthFederationStateDuration().Seconds()),
+		Secure:   strings.HasPrefix(config.OAuthIssuer(), "https://"),
+		HttpOnly: true,
+		// the provider send the user agent back with a top level navigation
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, res.AuthorizationURL, http.StatusFound)
+}
+
+// FederatedCallback is where the upstream provider send the user agent back. A login continue
+// to the client redirect uri with the authorization code, a link report the linked identity.
+func (t *Server) FederatedCallback(w http.ResponseWriter, r *http.Request) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(r.Context(), fn)
+	defer span.End()
+
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, model.NewResponse().WithMessage(http.StatusText(http.StatusMethodNotAllowed)))
+		return
+	}
+
+	ctx = setSessionMetadataCtx(ctx, newSessionMetadata(r))
+
+	var binding string
+	if cookie, err := r.Cookie(federationBindingCookie); err == nil {
+		binding = cookie.Value
+	}
+	// the state is single use, so is its binding
+	http.SetCookie(w, &http.Cookie{
+		Name:     federationBindingCookie,
+		Path:     model.FederatedCallbackPath,
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	query := r.URL.Query()
+	res, err := t.userIdentityUC.Callback(ctx, &model.FederatedCallbackPayload{
+		State:   query.Get("state"),
+		Code:    query.Get("code"),
+		Error:   query.Get("error"),
+		Binding: binding,
+	})
+	switch err {
+	case nil:
+	case model.ErrFederationStateInvalid:
+		writeOAuthError(w, http.StatusBadRequest, oauthErrorInvalidRequest, err)
+		return
+	case model.ErrIdentityProviderExchange,
+		model.ErrExternalIdentityIncomplete,
+		model.ErrExternalEmailNotVerified,
+		model.ErrUsernameOrEmailAlreadyTaken,
+		model.ErrUserIdentityAlreadyLinked:
+		writeOAuthError(w, http.StatusForbidden, oauthErrorAccessDenied, err)
+		return
+	default:
+		logrus.Error(err.Error())
+		writeOAuthError(w, http.StatusInternalServerError, oauthErrorServerError, err)
+		return
+	}
+
+	if res.Authorize != nil {
+		http.Redirect(w, r, res.Authorize.RedirectURL(), http.StatusFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, model.NewResponse().WithMessage("identity linked").WithData(res.Identity.ToGRPCResponse()))
+}
@@ -0,0 +1,284 @@
+package http
+
+import (
+	"context"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+const testIdentityProviderSubject = "248289761001"
+
+// newIdentityProviderServer run a stand-in upstream provider which sign the user in without
+// asking and release a fixed identity.
+func newIdentityProviderServer(t *testing.T) *httptest.Server {
+	var (
+		mu         sync.Mutex
+		challenges = make(map[string]string)
+	)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, &model.OpenIDConfiguration{
+			Issuer:                server.URL,
+			AuthorizationEndpoint: server.URL + "/authorize",
+			TokenEndpoint:         server.URL + "/token",
+			UserInfoEndpoint:      server.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		code := utils.GenerateUUID()
+		mu.Lock()
+		challenges[code] = query.Get("code_challenge")
+		mu.Unlock()
+		http.Redirect(w, r, model.BuildOAuthRedirectURL(query.Get("redirect_uri"), url.Values{
+			"code":  {code},
+			"state": {query.Get("state")},
+		}), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		mu.Lock()
+		challenge, ok := challenges[r.PostFormValue("code")]
+		delete(challenges, r.PostFormValue("code"))
+		mu.Unlock()
+		if clientID != "auth-service" || clientSecret != "upstream-secret" || !ok ||
+			!utils.VerifyCodeChallenge(challenge, r.PostFormValue("code_verifier")) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(w, map[string]string{"access_token": "upstream-access-token", "token_type": "Bearer"})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer upstream-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"sub":                testIdentityProviderSubject,
+			"email":              "jane@example.com",
+			"email_verified":     true,
+			"name":               "Jane Doe",
+			"preferred_username": "jane",
+		})
+	})
+
+	viper.Set("identity_providers", []map[string]interface{}{
+		{
+			"name":          "corporate",
+			"issuer":        server.URL,
+			"client_id":     "auth-service",
+			"client_secret": "upstream-secret",
+		},
+	})
+	t.Cleanup(func() { viper.Set("identity_providers", nil) })
+
+	return server
+}
+
+// federatedLogin follow the redirects from the sign in form through the upstream provider
+// and return where the user agent is finally sent.
+func (s *oauthTestServer) federatedLogin(t *testing.T, params url.Values) *http.Response {
+	res, err := s.client.Get(s.server.URL + "/authorize?" + params.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	start := strings.Index(string(body), model.FederatedLoginPath)
+	if res.StatusCode != http.StatusOK || start < 0 {
+		t.Fatalf("GET /authorize = %d, want the sign in form with a federated login link", res.StatusCode)
+	}
+	link := string(body[start:])
+	link = html.UnescapeString(link[:strings.Index(link, `"`)])
+
+	nextURL := s.server.URL + link
+	for i := 0; i < 3; i++ {
+		res, err = s.client.Get(nextURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+		if res.StatusCode != http.StatusFound || strings.HasPrefix(res.Header.Get("Location"), s.redirectURI) {
+			return res
+		}
+		nextURL = res.Header.Get("Location")
+	}
+	t.Fatalf("federated login did not come back to %s", s.redirectURI)
+	return nil
+}
+
+func (s *oauthTestServer) exchangeCode(t *testing.T, res *http.Response) string {
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("GET %s = %d, want %d", model.FederatedCallbackPath, res.StatusCode, http.StatusFound)
+	}
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location.Query().Get("state") != "xyz" {
+		t.Fatalf("GET %s redirected to %s", model.FederatedCallbackPath, location)
+	}
+
+	statusCode, tokenRes := s.token(t, url.Values{
+		"grant_type":    {model.OAuthGrantTypeAuthorizationCode},
+		"code":          {location.Query().Get("code")},
+		"redirect_uri":  {s.redirectURI},
+		"code_verifier": {testCodeVerifier},
+	}, true)
+	if statusCode != http.StatusOK {
+		t.Fatalf("POST /token = %d %v, want %d", statusCode, tokenRes, http.StatusOK)
+	}
+	accessToken, _ := tokenRes["access_token"].(string)
+	return accessToken
+}
+
+func Test_Server_FederatedLogin_ProvisionUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+	newIdentityProviderServer(t)
+	viper.Set("oauth.issuer", s.server.URL)
+	defer viper.Set("oauth.issuer", nil)
+
+	var provisioned *model.User
+	s.userIdentityRepo.EXPECT().FindByProviderAndSubject(gomock.Any(), "corporate", testIdentityProviderSubject).Times(1).Return(nil, nil)
+	s.dbMock.ExpectBegin()
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, user *model.User) error {
+			provisioned = user
+			return nil
+		})
+	s.userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, id string) (*model.User, error) {
+			return provisioned, nil
+		})
+	s.userIdentityRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, identity *model.UserIdentity) error {
+			if identity.UserID != provisioned.ID || identity.Subject != testIdentityProviderSubject {
+				t.Errorf("linked identity = %+v, want user %s", identity, provisioned.ID)
+			}
+			return nil
+		})
+	s.dbMock.ExpectCommit()
+
+	params := s.authorizeParams()
+	params.Set("scope", "openid profile email")
+	accessToken := s.exchangeCode(t, s.federatedLogin(t, params))
+
+	if provisioned == nil || provisioned.Username != "jane" || provisioned.FullName != "Jane Doe" || provisioned.Password != "" {
+		t.Fatalf("provisioned user = %+v", provisioned)
+	}
+	if err := s.dbMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("provisioning transaction: %v", err)
+	}
+
+	statusCode, userInfo := s.userInfo(t, accessToken)
+	if statusCode != http.StatusOK || userInfo["sub"] != provisioned.ID || userInfo["email"] != "jane@example.com" {
+		t.Errorf("GET /userinfo = %d %v", statusCode, userInfo)
+	}
+}
+
+func Test_Server_FederatedLogin_LinkedUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+	newIdentityProviderServer(t)
+	viper.Set("oauth.issuer", s.server.URL)
+	defer viper.Set("oauth.issuer", nil)
+
+	s.userIdentityRepo.EXPECT().FindByProviderAndSubject(gomock.Any(), "corporate", testIdentityProviderSubject).Times(1).
+		Return(&model.UserIdentity{
+			ID:       utils.GenerateUUID(),
+			UserID:   s.user.ID,
+			Provider: "corporate",
+			Subject:  testIdentityProviderSubject,
+		}, nil)
+
+	accessToken := s.exchangeCode(t, s.federatedLogin(t, s.authorizeParams()))
+
+	statusCode, userInfo := s.userInfo(t, accessToken)
+	if statusCode != http.StatusOK || userInfo["sub"] != s.user.ID {
+		t.Errorf("GET /userinfo = %d %v", statusCode, userInfo)
+	}
+}
+
+func Test_Server_FederatedCallback_OtherUserAgent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+	newIdentityProviderServer(t)
+	viper.Set("oauth.issuer", s.server.URL)
+	defer viper.Set("oauth.issuer", nil)
+
+	// the login is started in one browser up to the callback url
+	query := s.authorizeParams()
+	query.Set("provider", "corporate")
+	nextURL := s.server.URL + model.FederatedLoginPath + "?" + query.Encode()
+	for !strings.HasPrefix(nextURL, s.server.URL+model.FederatedCallbackPath) {
+		res, err := s.client.Get(nextURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+		if res.StatusCode != http.StatusFound {
+			t.Fatalf("GET %s = %d, want %d", nextURL, res.StatusCode, http.StatusFound)
+		}
+		nextURL = res.Header.Get("Location")
+	}
+
+	// and the callback url is opened in another one
+	otherClient := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res, err := otherClient.Get(nextURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("GET %s from another user agent = %d, want %d", model.FederatedCallbackPath, res.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func Test_Server_FederatedCallback_InvalidState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := newOAuthTestServer(t, ctrl)
+
+	res, err := s.client.Get(s.server.URL + model.FederatedCallbackPath + "?state=unknown&code=code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body := new(oauthErrorResponse)
+	err = json.NewDecoder(res.Body).Decode(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusBadRequest || body.Error != oauthErrorInvalidRequest {
+		t.Errorf("GET %s = %d %v, want %d", model.FederatedCallbackPath, res.StatusCode, body, http.StatusBadRequest)
+	}
+}
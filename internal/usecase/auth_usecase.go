@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/krobus00/auth-service/internal/constant"
 	"github.com/krobus00/auth-service/internal/model"
@@ -11,7 +12,11 @@ import (
 )
 
 type authUsecase struct {
-	userGroupRepo model.UserGroupRepository
+	userRepo                model.UserRepository
+	userGroupRepo           model.UserGroupRepository
+	tokenRepo               model.TokenRepository
+	personalAccessTokenRepo model.PersonalAccessTokenRepository
+	serviceAccountGroupRepo model.ServiceAccountGroupRepository
 }
 
 func NewAuthUsecase() model.AuthUsecase {
@@ -32,22 +37,43 @@ func (uc *authUsecase) HasAccess(ctx context.Context, payload *model.HasAccessPa
 		return nil
 	}
 
+	permissions := payload.Permissions
+	scopes := payload.Scopes
+	if scopes == nil && payload.UserID == getUserIDFromCtx(ctx) {
+		scopes = getTokenScopesFromCtx(ctx)
+	}
+	if scopes != nil {
+		permissions = intersectScopes(permissions, scopes)
+		if len(permissions) == 0 {
+			logger.Warn("token scopes don't cover the permissions")
+			return model.ErrUnauthorizeAccess
+		}
+	}
+
 	userGroups, err := uc.userGroupRepo.FindByUserID(ctx, payload.UserID)
 	if err != nil {
 		return err
 	}
 
+	// principals without user groups may be service accounts
+	if len(userGroups) == 0 {
+		userGroups, err = uc.findServiceAccountGroups(ctx, payload.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(userGroups) == 0 {
 		logger.Warn("user don't have any groups")
 		return model.ErrUnauthorizeAccess
 	}
 
-	hasAccessCh := make(chan bool, len(payload.Permissions))
+	hasAccessCh := make(chan bool, len(userGroups))
 
 	wg := sync.WaitGroup{}
 	for _, userGroup := range userGroups {
 		wg.Add(1)
-		go uc.userGroupHasPermissions(ctx, &wg, userGroup, payload.Permissions, hasAccessCh)
+		go uc.userGroupHasPermissions(ctx, &wg, userGroup, permissions, hasAccessCh)
 	}
 
 	wg.Wait()
@@ -63,18 +89,153 @@ func (uc *authUsecase) HasAccess(ctx context.Context, payload *model.HasAccessPa
 
 }
 
+// intersectScopes keep the permissions a scoped token is allowed to use.
+// A FULL_ACCESS scope allow every permission and guest permissions are never restricted.
+func intersectScopes(permissions []string, scopes []string) []string {
+	allowed := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		if scope == constant.PermissionFullAccess {
+			return permissions
+		}
+		allowed[scope] = true
+	}
+
+	res := make([]string, 0)
+	for _, permission := range permissions {
+		if allowed[permission] || permission == constant.PermissionAllowGuest {
+			res = append(res, permission)
+		}
+	}
+	return res
+}
+
+// findServiceAccountGroups return the service account memberships shaped as user groups,
+// so both principal types are evaluated the same way.
+func (uc *authUsecase) findServiceAccountGroups(ctx context.Context, serviceAccountID string) ([]*model.UserGroup, error) {
+	serviceAccountGroups, err := uc.serviceAccountGroupRepo.FindByServiceAccountID(ctx, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroups := make([]*model.UserGroup, 0, len(serviceAccountGroups))
+	for _, serviceAccountGroup := range serviceAccountGroups {
+		userGroups = append(userGroups, &model.UserGroup{
+			UserID:  serviceAccountGroup.ServiceAccountID,
+			GroupID: serviceAccountGroup.GroupID,
+		})
+	}
+	return userGroups, nil
+}
+
 func (uc *authUsecase) userGroupHasPermissions(ctx context.Context, wg *sync.WaitGroup, userGroup *model.UserGroup, permissions []string, ch chan bool) {
 	defer wg.Done()
 	for _, permission := range permissions {
 		if permission == constant.PermissionAllowGuest {
 			ch <- true
-			break
+			return
 		}
 		hasAccess, _ := uc.userGroupRepo.HasPermission(ctx, userGroup.GroupID, permission)
 		if hasAccess {
 			ch <- hasAccess
-			break
+			return
 		}
 	}
 	ch <- false
 }
+
+func (uc *authUsecase) ValidateToken(ctx context.Context, payload *model.ValidateTokenPayload) (*model.ValidateTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if model.IsPersonalAccessToken(payload.AccessToken) {
+		return uc.validatePersonalAccessToken(ctx, payload.AccessToken)
+	}
+
+	claims, err := utils.ParseTokenClaims(payload.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID":  claims.UserID,
+		"tokenID": claims.ID,
+	})
+
+	if claims.TokenType != model.AccessToken.String() {
+		return nil, model.ErrInvalidTokenType
+	}
+
+	isValidToken, err := uc.tokenRepo.IsValidToken(ctx, claims.UserID, claims.ID, model.AccessToken)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if !isValidToken {
+		return nil, model.ErrTokenRevoked
+	}
+
+	res := &model.ValidateTokenResponse{
+		UserID:    claims.UserID,
+		TokenID:   claims.ID,
+		ExpiredAt: claims.ExpiresAt.Time,
+		Issuer:    claims.Issuer,
+		ClientID:  claims.ClientID,
+	}
+	// tokens issued to an OAuth client are only good for the OpenID endpoints,
+	// they don't carry any of the user permissions
+	if claims.ClientID != "" {
+		res.Scopes = []string{constant.PermissionAllowGuest}
+	}
+
+	return res, nil
+}
+
+func (uc *authUsecase) validatePersonalAccessToken(ctx context.Context, token string) (*model.ValidateTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	personalAccessToken, err := uc.personalAccessTokenRepo.FindByTokenHash(ctx, utils.HashSecret(token))
+	if err != nil {
+		logrus.Error(err.Error())
+		return nil, err
+	}
+	if personalAccessToken == nil {
+		return nil, model.ErrTokenRevoked
+	}
+	if !time.Now().Before(personalAccessToken.ExpiredAt) {
+		return nil, model.ErrTokenExpired
+	}
+
+	// the token is only as good as its owner, a suspension must not wait for it to be revoked
+	user, err := uc.userRepo.FindByID(ctx, personalAccessToken.UserID)
+	if err != nil {
+		logrus.WithField("userID", personalAccessToken.UserID).Error(err.Error())
+		return nil, err
+	}
+	if user == nil || user.StatusError() != nil {
+		return nil, model.ErrTokenRevoked
+	}
+
+	return &model.ValidateTokenResponse{
+		UserID:    personalAccessToken.UserID,
+		TokenID:   personalAccessToken.ID,
+		ExpiredAt: personalAccessToken.ExpiredAt,
+		Issuer:    utils.TokenIssuer,
+		Scopes:    personalAccessToken.Scopes,
+	}, nil
+}
+
+func (uc *authUsecase) GetJWKS(ctx context.Context) (*model.JSONWebKeySet, error) {
+	_, _, fn := utils.Trace()
+	_, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	jwks, err := utils.JWKS()
+	if err != nil {
+		logrus.Error(err.Error())
+		return nil, err
+	}
+	return jwks, nil
+}
@@ -13,3 +13,35 @@ func (uc *authUsecase) InjectUserGroupRepo(repo model.UserGroupRepository) error
 	uc.userGroupRepo = repo
 	return nil
 }
+
+func (uc *authUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repository")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *authUsecase) InjectTokenRepo(repo model.TokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid token repository")
+	}
+	uc.tokenRepo = repo
+	return nil
+}
+
+func (uc *authUsecase) InjectPersonalAccessTokenRepo(repo model.PersonalAccessTokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid personal access token repo")
+	}
+	uc.personalAccessTokenRepo = repo
+	return nil
+}
+
+func (uc *authUsecase) InjectServiceAccountGroupRepo(repo model.ServiceAccountGroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid service account group repo")
+	}
+	uc.serviceAccountGroupRepo = repo
+	return nil
+}
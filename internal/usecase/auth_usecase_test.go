@@ -2,15 +2,28 @@ package usecase
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/krobus00/auth-service/internal/constant"
 	"github.com/krobus00/auth-service/internal/model"
 	"github.com/krobus00/auth-service/internal/model/mock"
 	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
 )
 
 func Test_authUsecase_HasAccess(t *testing.T) {
@@ -29,12 +42,17 @@ func Test_authUsecase_HasAccess(t *testing.T) {
 		hasAccess bool
 		err       error
 	}
+	type mockFindServiceAccountGroups struct {
+		serviceAccountGroups []*model.ServiceAccountGroup
+		err                  error
+	}
 	tests := []struct {
-		name             string
-		args             args
-		mockFindByUserID *mockFindByUserID
-		mockHasAccess    *mockHasAccess
-		wantErr          bool
+		name                         string
+		args                         args
+		mockFindByUserID             *mockFindByUserID
+		mockFindServiceAccountGroups *mockFindServiceAccountGroups
+		mockHasAccess                *mockHasAccess
+		wantErr                      bool
 	}{
 		{
 			name: "success",
@@ -58,6 +76,42 @@ func Test_authUsecase_HasAccess(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success scoped token",
+			args: args{
+				payload: &model.HasAccessPayload{
+					UserID:      userID,
+					Permissions: []string{"TEST_READ", "TEST_WRITE"},
+					Scopes:      []string{"TEST_READ"},
+				},
+			},
+			mockFindByUserID: &mockFindByUserID{
+				userGroups: []*model.UserGroup{
+					{
+						UserID:  userID,
+						GroupID: groupID,
+					},
+				},
+				err: nil,
+			},
+			mockHasAccess: &mockHasAccess{
+				hasAccess: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "error scopes don't cover permissions",
+			args: args{
+				payload: &model.HasAccessPayload{
+					UserID:      userID,
+					Permissions: []string{"TEST_WRITE"},
+					Scopes:      []string{"TEST_READ"},
+				},
+			},
+			mockFindByUserID: nil,
+			mockHasAccess:    nil,
+			wantErr:          true,
+		},
 		{
 			name: "success system id",
 			args: args{
@@ -102,6 +156,55 @@ func Test_authUsecase_HasAccess(t *testing.T) {
 				userGroups: []*model.UserGroup{},
 				err:        nil,
 			},
+			mockFindServiceAccountGroups: &mockFindServiceAccountGroups{
+				serviceAccountGroups: []*model.ServiceAccountGroup{},
+				err:                  nil,
+			},
+			mockHasAccess: nil,
+			wantErr:       true,
+		},
+		{
+			name: "success service account",
+			args: args{
+				payload: &model.HasAccessPayload{
+					UserID:      userID,
+					Permissions: []string{"TEST_READ"},
+				},
+			},
+			mockFindByUserID: &mockFindByUserID{
+				userGroups: []*model.UserGroup{},
+				err:        nil,
+			},
+			mockFindServiceAccountGroups: &mockFindServiceAccountGroups{
+				serviceAccountGroups: []*model.ServiceAccountGroup{
+					{
+						ServiceAccountID: userID,
+						GroupID:          groupID,
+					},
+				},
+				err: nil,
+			},
+			mockHasAccess: &mockHasAccess{
+				hasAccess: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "error when find service account groups",
+			args: args{
+				payload: &model.HasAccessPayload{
+					UserID:      userID,
+					Permissions: []string{"TEST_READ"},
+				},
+			},
+			mockFindByUserID: &mockFindByUserID{
+				userGroups: []*model.UserGroup{},
+				err:        nil,
+			},
+			mockFindServiceAccountGroups: &mockFindServiceAccountGroups{
+				serviceAccountGroups: nil,
+				err:                  errors.New("db error"),
+			},
 			mockHasAccess: nil,
 			wantErr:       true,
 		},
@@ -163,7 +266,11 @@ func Test_authUsecase_HasAccess(t *testing.T) {
 			}
 
 			if tt.mockHasAccess != nil {
-				for _, permission := range tt.args.payload.Permissions {
+				permissions := tt.args.payload.Permissions
+				if tt.args.payload.Scopes != nil {
+					permissions = intersectScopes(permissions, tt.args.payload.Scopes)
+				}
+				for _, permission := range permissions {
 					if permission != constant.PermissionAllowGuest {
 						wg.Add(1)
 						userGroupRepo.EXPECT().HasPermission(gomock.Any(), groupID, permission).
@@ -176,9 +283,21 @@ func Test_authUsecase_HasAccess(t *testing.T) {
 				}
 			}
 
+			serviceAccountGroupRepo := mock.NewMockServiceAccountGroupRepository(ctrl)
+			if tt.mockFindServiceAccountGroups != nil {
+				serviceAccountGroupRepo.EXPECT().FindByServiceAccountID(gomock.Any(), tt.args.payload.UserID).
+					Times(1).
+					Return(
+						tt.mockFindServiceAccountGroups.serviceAccountGroups,
+						tt.mockFindServiceAccountGroups.err,
+					)
+			}
+
 			uc := NewAuthUsecase()
 			err := uc.InjectUserGroupRepo(userGroupRepo)
 			utils.ContinueOrFatal(err)
+			err = uc.InjectServiceAccountGroupRepo(serviceAccountGroupRepo)
+			utils.ContinueOrFatal(err)
 
 			if err := uc.HasAccess(ctx, tt.args.payload); (err != nil) != tt.wantErr {
 				t.Errorf("authUsecase.HasAccess() error = %v, wantErr %v", err, tt.wantErr)
@@ -187,3 +306,480 @@ func Test_authUsecase_HasAccess(t *testing.T) {
 		})
 	}
 }
+
+func Test_authUsecase_ValidateToken(t *testing.T) {
+	var (
+		userID  = utils.GenerateUUID()
+		tokenID = utils.GenerateUUID()
+	)
+	viper.Set("jwt.secret_key", "test-secret")
+
+	accessToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, time.Minute)
+	utils.ContinueOrFatal(err)
+	refreshToken, err := utils.GenerateToken(tokenID, userID, model.RefreshToken, time.Minute)
+	utils.ContinueOrFatal(err)
+	expiredToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, -time.Minute)
+	utils.ContinueOrFatal(err)
+	clientToken, err := utils.GenerateClientToken(tokenID, userID, "oauth_client", model.AccessToken, time.Minute)
+	utils.ContinueOrFatal(err)
+
+	type args struct {
+		payload *model.ValidateTokenPayload
+	}
+	type mockIsValidToken struct {
+		res bool
+		err error
+	}
+	tests := []struct {
+		name             string
+		args             args
+		mockIsValidToken *mockIsValidToken
+		want             *model.ValidateTokenResponse
+		wantErr          error
+	}{
+		{
+			name: "success",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: accessToken,
+				},
+			},
+			mockIsValidToken: &mockIsValidToken{
+				res: true,
+				err: nil,
+			},
+			want: &model.ValidateTokenResponse{
+				UserID:  userID,
+				TokenID: tokenID,
+				Issuer:  "auth-service",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success oauth client token only carry guest scope",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: clientToken,
+				},
+			},
+			mockIsValidToken: &mockIsValidToken{
+				res: true,
+				err: nil,
+			},
+			want: &model.ValidateTokenResponse{
+				UserID:   userID,
+				TokenID:  tokenID,
+				Issuer:   "auth-service",
+				ClientID: "oauth_client",
+				Scopes:   []string{constant.PermissionAllowGuest},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error token revoked",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: accessToken,
+				},
+			},
+			mockIsValidToken: &mockIsValidToken{
+				res: false,
+				err: nil,
+			},
+			wantErr: model.ErrTokenRevoked,
+		},
+		{
+			name: "error token expired",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: expiredToken,
+				},
+			},
+			wantErr: model.ErrTokenExpired,
+		},
+		{
+			name: "error malformed token",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: "not-a-jwt",
+				},
+			},
+			wantErr: model.ErrTokenMalformed,
+		},
+		{
+			name: "error wrong token type",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: refreshToken,
+				},
+			},
+			wantErr: model.ErrInvalidTokenType,
+		},
+		{
+			name: "error redis",
+			args: args{
+				payload: &model.ValidateTokenPayload{
+					AccessToken: accessToken,
+				},
+			},
+			mockIsValidToken: &mockIsValidToken{
+				res: false,
+				err: errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			if tt.mockIsValidToken != nil {
+				tokenRepo.EXPECT().IsValidToken(gomock.Any(), userID, tokenID, model.AccessToken).
+					Times(1).
+					Return(tt.mockIsValidToken.res, tt.mockIsValidToken.err)
+			}
+
+			uc := NewAuthUsecase()
+			err := uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ValidateToken(context.TODO(), tt.args.payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("authUsecase.ValidateToken() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("authUsecase.ValidateToken() unexpected error = %v", err)
+				return
+			}
+			if got.UserID != tt.want.UserID || got.TokenID != tt.want.TokenID || got.Issuer != tt.want.Issuer ||
+				got.ClientID != tt.want.ClientID || !reflect.DeepEqual(got.Scopes, tt.want.Scopes) {
+				t.Errorf("authUsecase.ValidateToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_authUsecase_ValidateToken_PersonalAccessToken(t *testing.T) {
+	var (
+		userID = utils.GenerateUUID()
+		token  = model.PersonalAccessTokenPrefix + "secret"
+	)
+	var (
+		suspendedUntil = time.Now().Add(time.Hour)
+		validToken     = &model.PersonalAccessToken{
+			ID:        "pat-id",
+			UserID:    userID,
+			Scopes:    []string{"TEST_READ"},
+			ExpiredAt: time.Now().Add(time.Hour),
+		}
+	)
+	type mockFindByTokenHash struct {
+		res *model.PersonalAccessToken
+		err error
+	}
+	type mockFindUser struct {
+		res *model.User
+		err error
+	}
+	tests := []struct {
+		name                string
+		mockFindByTokenHash *mockFindByTokenHash
+		mockFindUser        *mockFindUser
+		want                *model.ValidateTokenResponse
+		wantErr             error
+	}{
+		{
+			name: "success",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				res: validToken,
+			},
+			mockFindUser: &mockFindUser{
+				res: &model.User{ID: userID, Status: model.UserStatusActive},
+			},
+			want: &model.ValidateTokenResponse{
+				UserID:  userID,
+				TokenID: "pat-id",
+				Issuer:  "auth-service",
+				Scopes:  []string{"TEST_READ"},
+			},
+		},
+		{
+			name: "error token revoked",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				res: nil,
+			},
+			wantErr: model.ErrTokenRevoked,
+		},
+		{
+			name: "error token expired",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				res: &model.PersonalAccessToken{
+					ID:        "pat-id",
+					UserID:    userID,
+					Scopes:    []string{"TEST_READ"},
+					ExpiredAt: time.Now().Add(-time.Hour),
+				},
+			},
+			wantErr: model.ErrTokenExpired,
+		},
+		{
+			name: "error owner suspended",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				res: validToken,
+			},
+			mockFindUser: &mockFindUser{
+				res: &model.User{ID: userID, Status: model.UserStatusSuspended, SuspendedUntil: &suspendedUntil},
+			},
+			wantErr: model.ErrTokenRevoked,
+		},
+		{
+			name: "error owner deleted",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				res: validToken,
+			},
+			mockFindUser: &mockFindUser{
+				res: nil,
+			},
+			wantErr: model.ErrTokenRevoked,
+		},
+		{
+			name: "error find owner",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				res: validToken,
+			},
+			mockFindUser: &mockFindUser{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+		{
+			name: "error db",
+			mockFindByTokenHash: &mockFindByTokenHash{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			personalAccessTokenRepo := mock.NewMockPersonalAccessTokenRepository(ctrl)
+			personalAccessTokenRepo.EXPECT().FindByTokenHash(gomock.Any(), utils.HashSecret(token)).
+				Times(1).
+				Return(tt.mockFindByTokenHash.res, tt.mockFindByTokenHash.err)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			if tt.mockFindUser != nil {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).
+					Times(1).
+					Return(tt.mockFindUser.res, tt.mockFindUser.err)
+			}
+
+			uc := NewAuthUsecase()
+			err := uc.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ValidateToken(context.TODO(), &model.ValidateTokenPayload{
+				AccessToken: token,
+			})
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("authUsecase.ValidateToken() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("authUsecase.ValidateToken() unexpected error = %v", err)
+				return
+			}
+			if got.UserID != tt.want.UserID || got.TokenID != tt.want.TokenID || got.Issuer != tt.want.Issuer || !reflect.DeepEqual(got.Scopes, tt.want.Scopes) {
+				t.Errorf("authUsecase.ValidateToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func writeTestKeyFile(t *testing.T, privateKey crypto.Signer, private bool) string {
+	var (
+		der []byte
+		err error
+	)
+	if private {
+		der, err = x509.MarshalPKCS8PrivateKey(privateKey)
+	} else {
+		der, err = x509.MarshalPKIXPublicKey(privateKey.Public())
+	}
+	utils.ContinueOrFatal(err)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "KEY", Bytes: der}), 0o600)
+	utils.ContinueOrFatal(err)
+	return path
+}
+
+func Test_authUsecase_GetJWKS(t *testing.T) {
+	var (
+		userID  = utils.GenerateUUID()
+		tokenID = utils.GenerateUUID()
+	)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	utils.ContinueOrFatal(err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	utils.ContinueOrFatal(err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	utils.ContinueOrFatal(err)
+	previousKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	utils.ContinueOrFatal(err)
+
+	t.Cleanup(func() {
+		viper.Set("jwt.signing_method", "HS256")
+		viper.Set("jwt.verification_keys", nil)
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		signingKey crypto.Signer
+		wantKty    string
+		wantKeyIDs []string
+	}{
+		{
+			name:       "success RS256",
+			method:     "RS256",
+			signingKey: rsaKey,
+			wantKty:    "RSA",
+			wantKeyIDs: []string{"current", "previous"},
+		},
+		{
+			name:       "success ES256",
+			method:     "ES256",
+			signingKey: ecKey,
+			wantKty:    "EC",
+			wantKeyIDs: []string{"current", "previous"},
+		},
+		{
+			name:       "success EdDSA",
+			method:     "EdDSA",
+			signingKey: edKey,
+			wantKty:    "OKP",
+			wantKeyIDs: []string{"current", "previous"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			viper.Set("jwt.signing_method", tt.method)
+			viper.Set("jwt.signing_key_id", "current")
+			viper.Set("jwt.signing_key_file", writeTestKeyFile(t, tt.signingKey, true))
+			viper.Set("jwt.verification_keys", []map[string]string{
+				{
+					"key_id":    "previous",
+					"algorithm": "ES256",
+					"file":      writeTestKeyFile(t, previousKey, false),
+				},
+			})
+			err := utils.LoadTokenKeys()
+			utils.ContinueOrFatal(err)
+
+			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			tokenRepo.EXPECT().IsValidToken(gomock.Any(), userID, tokenID, model.AccessToken).
+				Times(1).
+				Return(true, nil)
+
+			uc := NewAuthUsecase()
+			err = uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+
+			accessToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, time.Minute)
+			utils.ContinueOrFatal(err)
+			session, err := uc.ValidateToken(context.TODO(), &model.ValidateTokenPayload{
+				AccessToken: accessToken,
+			})
+			if err != nil || session.UserID != userID {
+				t.Errorf("authUsecase.ValidateToken() = %v, error = %v", session, err)
+			}
+
+			jwks, err := uc.GetJWKS(context.TODO())
+			if err != nil {
+				t.Errorf("authUsecase.GetJWKS() unexpected error = %v", err)
+				return
+			}
+			if len(jwks.Keys) != len(tt.wantKeyIDs) {
+				t.Errorf("authUsecase.GetJWKS() got %d keys, want %d", len(jwks.Keys), len(tt.wantKeyIDs))
+				return
+			}
+			for i, key := range jwks.Keys {
+				if key.KeyID != tt.wantKeyIDs[i] {
+					t.Errorf("authUsecase.GetJWKS() key %d kid = %s, want %s", i, key.KeyID, tt.wantKeyIDs[i])
+				}
+			}
+			if jwks.Keys[0].KeyType != tt.wantKty || jwks.Keys[0].Algorithm != tt.method {
+				t.Errorf("authUsecase.GetJWKS() current key = %+v, want kty %s alg %s", jwks.Keys[0], tt.wantKty, tt.method)
+			}
+		})
+	}
+
+	t.Run("error hmac token rejected when signing asymmetrically", func(t *testing.T) {
+		viper.Set("jwt.signing_method", "HS256")
+		viper.Set("jwt.verification_keys", nil)
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+		hmacToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, time.Minute)
+		utils.ContinueOrFatal(err)
+
+		viper.Set("jwt.signing_method", "RS256")
+		viper.Set("jwt.signing_key_file", writeTestKeyFile(t, rsaKey, true))
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+
+		_, err = NewAuthUsecase().ValidateToken(context.TODO(), &model.ValidateTokenPayload{
+			AccessToken: hmacToken,
+		})
+		if err != model.ErrTokenInvalid {
+			t.Errorf("authUsecase.ValidateToken() error = %v, wantErr %v", err, model.ErrTokenInvalid)
+		}
+	})
+
+	t.Run("hmac token accepted while migrating to an asymmetric key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		defer viper.Set("jwt.accept_shared_secret", false)
+
+		viper.Set("jwt.signing_method", "HS256")
+		viper.Set("jwt.verification_keys", nil)
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+		hmacToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, time.Minute)
+		utils.ContinueOrFatal(err)
+
+		viper.Set("jwt.signing_method", "RS256")
+		viper.Set("jwt.signing_key_file", writeTestKeyFile(t, rsaKey, true))
+		viper.Set("jwt.accept_shared_secret", true)
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+
+		tokenRepo := mock.NewMockTokenRepository(ctrl)
+		tokenRepo.EXPECT().IsValidToken(gomock.Any(), userID, tokenID, model.AccessToken).
+			Times(1).
+			Return(true, nil)
+
+		uc := NewAuthUsecase()
+		err = uc.InjectTokenRepo(tokenRepo)
+		utils.ContinueOrFatal(err)
+
+		session, err := uc.ValidateToken(context.TODO(), &model.ValidateTokenPayload{
+			AccessToken: hmacToken,
+		})
+		if err != nil || session.UserID != userID {
+			t.Errorf("authUsecase.ValidateToken() = %v, error = %v", session, err)
+		}
+	})
+}
@@ -2,17 +2,82 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
 	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	log "github.com/sirupsen/logrus"
 )
 
 func getUserIDFromCtx(ctx context.Context) string {
-	ctxUserID := ctx.Value(constant.KeyUserIDCtx)
-
-	userID := fmt.Sprintf("%v", ctxUserID)
-	if userID == "" {
+	userID, ok := ctx.Value(constant.KeyUserIDCtx).(string)
+	if !ok || userID == "" {
 		return constant.GuestID
 	}
 	return userID
 }
+
+func getTokenIDFromCtx(ctx context.Context) string {
+	tokenID, _ := ctx.Value(constant.KeyTokenIDCtx).(string)
+	return tokenID
+}
+
+func getTokenScopesFromCtx(ctx context.Context) []string {
+	scopes, _ := ctx.Value(constant.KeyTokenScopesCtx).([]string)
+	return scopes
+}
+
+func getClientIDFromCtx(ctx context.Context) string {
+	clientID, _ := ctx.Value(constant.KeyClientIDCtx).(string)
+	return clientID
+}
+
+func getSessionMetadataFromCtx(ctx context.Context) *model.SessionMetadata {
+	metadata, ok := ctx.Value(constant.KeySessionMetadataCtx).(*model.SessionMetadata)
+	if !ok || metadata == nil {
+		return new(model.SessionMetadata)
+	}
+	return metadata
+}
+
+func sessionMetadataLogFields(metadata *model.SessionMetadata) log.Fields {
+	return log.Fields{
+		"ipAddress":  metadata.IPAddress,
+		"userAgent":  metadata.UserAgent,
+		"clientName": metadata.ClientName,
+		"deviceID":   metadata.DeviceID,
+	}
+}
+
+// withSessionClient return a context whose session metadata name the OAuth client the session is issued to
+// and the scope it was granted.
+func withSessionClient(ctx context.Context, clientID string, clientName string, scope string) context.Context {
+	metadata := *getSessionMetadataFromCtx(ctx)
+	metadata.ClientID = clientID
+	metadata.ClientName = clientName
+	metadata.Scope = scope
+	return context.WithValue(ctx, constant.KeySessionMetadataCtx, &metadata)
+}
+
+// grantingPermissions return the permissions granting name the way the rpcs check access: the permission
+// itself, the _ALL permission of its resource and FULL_ACCESS.
+func grantingPermissions(name string) []string {
+	if name == constant.PermissionFullAccess || name == constant.PermissionAllowGuest {
+		return []string{name}
+	}
+	permissions := []string{name}
+	if i := strings.LastIndex(name, "_"); i > 0 && name[i:] != "_ALL" {
+		permissions = append(permissions, name[:i]+"_ALL")
+	}
+	return append(permissions, constant.PermissionFullAccess)
+}
+
+// pageOf trim the extra item fetched to detect a next page, the token of the next page
+// is empty on the last page.
+func pageOf[T any](items []T, pageSize int, cursorOf func(T) *model.PageCursor) ([]T, string) {
+	if len(items) <= pageSize {
+		return items, ""
+	}
+	items = items[:pageSize]
+	return items, model.EncodePageToken(cursorOf(items[pageSize-1]))
+}
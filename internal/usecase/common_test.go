@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func Test_grantingPermissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission string
+		want       []string
+	}{
+		{
+			name:       "read permission",
+			permission: constant.PermissionGroupPermissionRead,
+			want:       []string{constant.PermissionGroupPermissionRead, constant.PermissionGroupPermissionAll, constant.PermissionFullAccess},
+		},
+		{
+			name:       "all permission",
+			permission: constant.PermissionUserAll,
+			want:       []string{constant.PermissionUserAll, constant.PermissionFullAccess},
+		},
+		{
+			name:       "full access",
+			permission: constant.PermissionFullAccess,
+			want:       []string{constant.PermissionFullAccess},
+		},
+		{
+			name:       "guest full access",
+			permission: constant.PermissionAllowGuest,
+			want:       []string{constant.PermissionAllowGuest},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grantingPermissions(tt.permission); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("grantingPermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_pageOf(t *testing.T) {
+	items := []*model.Group{{ID: "1", Name: "A"}, {ID: "2", Name: "B"}, {ID: "3", Name: "C"}}
+	cursorOf := func(group *model.Group) *model.PageCursor {
+		return &model.PageCursor{Key: group.Name, ID: group.ID}
+	}
+	tests := []struct {
+		name      string
+		items     []*model.Group
+		pageSize  int
+		wantItems []*model.Group
+		wantNext  string
+	}{
+		{
+			name:      "extra item fetched",
+			items:     items,
+			pageSize:  2,
+			wantItems: items[:2],
+			wantNext:  model.EncodePageToken(&model.PageCursor{Key: "B", ID: "2"}),
+		},
+		{
+			name:      "last page",
+			items:     items,
+			pageSize:  3,
+			wantItems: items,
+		},
+		{
+			name:      "empty page",
+			items:     []*model.Group{},
+			pageSize:  2,
+			wantItems: []*model.Group{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotItems, gotNext := pageOf(tt.items, tt.pageSize, cursorOf)
+			if !reflect.DeepEqual(gotItems, tt.wantItems) {
+				t.Errorf("pageOf() items = %v, want %v", gotItems, tt.wantItems)
+			}
+			if gotNext != tt.wantNext {
+				t.Errorf("pageOf() next page token = %q, want %q", gotNext, tt.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type directoryUsecase struct {
+	db               *gorm.DB
+	userUC           model.UserUsecase
+	userRepo         model.UserRepository
+	groupRepo        model.GroupRepository
+	userGroupRepo    model.UserGroupRepository
+	userIdentityRepo model.UserIdentityRepository
+	directoryRepo    model.DirectoryRepository
+}
+
+func NewDirectoryUsecase() model.DirectoryUsecase {
+	return new(directoryUsecase)
+}
+
+// Authenticate bind to the directory with the credentials, provision the directory managed user
+// on first sign in and sync the mapped group memberships.
+func (uc *directoryUsecase) Authenticate(ctx context.Context, payload *model.UserLoginPayload) (*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"username": payload.Username,
+	})
+
+	entry, err := uc.directoryRepo.Authenticate(ctx, payload.Username, payload.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	// DN are case insensitive, the identity subject is kept lower case so lookups are stable
+	subject := strings.ToLower(entry.DN)
+	identity, err := uc.userIdentityRepo.FindByProviderAndSubject(ctx, model.IdentityProviderLDAP, subject)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	var user *model.User
+	if identity == nil {
+		user, err = uc.provision(ctx, subject, entry)
+	} else {
+		user, err = uc.userRepo.FindByID(ctx, identity.UserID)
+		if err == nil && user == nil {
+			err = model.ErrUserNotFound
+		}
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = uc.syncGroups(ctx, user.ID, entry.Groups)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (uc *directoryUsecase) provision(ctx context.Context, subject string, entry *model.DirectoryEntry) (*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if entry.Email == "" {
+		return nil, model.ErrDirectoryEntryIncomplete
+	}
+
+	var user *model.User
+	err := uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := utils.NewTxContext(ctx, tx)
+
+		var err error
+		user, err = uc.userUC.ProvisionUser(txCtx, &model.ProvisionUserPayload{
+			FullName:         entry.FullName,
+			Username:         entry.Username,
+			Email:            entry.Email,
+			DirectoryManaged: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		return uc.userIdentityRepo.Create(txCtx, &model.UserIdentity{
+			ID:       utils.GenerateUUID(),
+			UserID:   user.ID,
+			Provider: model.IdentityProviderLDAP,
+			Subject:  subject,
+			Email:    entry.Email,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// syncGroups make the membership of every mapped local group match the directory,
+// groups without a mapping are left untouched.
+func (uc *directoryUsecase) syncGroups(ctx context.Context, userID string, memberOf []string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	groupNames := make([]string, 0)
+	isMember := make(map[string]bool)
+	for _, mapping := range config.LDAPGroupMappings() {
+		if _, ok := isMember[mapping.Group]; !ok {
+			groupNames = append(groupNames, mapping.Group)
+		}
+		isMember[mapping.Group] = isMember[mapping.Group] || hasDN(memberOf, mapping.DN)
+	}
+
+	for _, groupName := range groupNames {
+		group, err := uc.groupRepo.FindByName(ctx, groupName)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			logger.WithField("group", groupName).Warn("mapped group not found")
+			continue
+		}
+
+		userGroup, err := uc.userGroupRepo.FindByUserIDAndGroupID(ctx, userID, group.ID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case isMember[groupName] && userGroup == nil:
+			err = uc.userGroupRepo.Create(ctx, &model.UserGroup{
+				UserID:  userID,
+				GroupID: group.ID,
+			})
+		case !isMember[groupName] && userGroup != nil:
+			err = uc.userGroupRepo.DeleteByUserIDAndGroupID(ctx, userID, group.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasDN(dns []string, dn string) bool {
+	for _, candidate := range dns {
+		if utils.EqualDN(candidate, dn) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"gorm.io/gorm"
+)
+
+func (uc *directoryUsecase) InjectDB(db *gorm.DB) error {
+	if db == nil {
+		return errors.New("invalid db")
+	}
+	uc.db = db
+	return nil
+}
+
+func (uc *directoryUsecase) InjectUserUsecase(usecase model.UserUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user usecase")
+	}
+	uc.userUC = usecase
+	return nil
+}
+
+func (uc *directoryUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *directoryUsecase) InjectGroupRepo(repo model.GroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid group repo")
+	}
+	uc.groupRepo = repo
+	return nil
+}
+
+func (uc *directoryUsecase) InjectUserGroupRepo(repo model.UserGroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid user group repo")
+	}
+	uc.userGroupRepo = repo
+	return nil
+}
+
+func (uc *directoryUsecase) InjectUserIdentityRepo(repo model.UserIdentityRepository) error {
+	if repo == nil {
+		return errors.New("invalid user identity repo")
+	}
+	uc.userIdentityRepo = repo
+	return nil
+}
+
+func (uc *directoryUsecase) InjectDirectoryRepo(repo model.DirectoryRepository) error {
+	if repo == nil {
+		return errors.New("invalid directory repo")
+	}
+	uc.directoryRepo = repo
+	return nil
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+type directoryUsecaseMock struct {
+	dbMock           sqlmock.Sqlmock
+	userUsecase      *mock.MockUserUsecase
+	userRepo         *mock.MockUserRepository
+	groupRepo        *mock.MockGroupRepository
+	userGroupRepo    *mock.MockUserGroupRepository
+	userIdentityRepo *mock.MockUserIdentityRepository
+	directoryRepo    *mock.MockDirectoryRepository
+}
+
+func newDirectoryUsecaseMock(ctrl *gomock.Controller) (model.DirectoryUsecase, *directoryUsecaseMock) {
+	dbConn, dbMock := utils.NewDBMock()
+	m := &directoryUsecaseMock{
+		dbMock:           dbMock,
+		userUsecase:      mock.NewMockUserUsecase(ctrl),
+		userRepo:         mock.NewMockUserRepository(ctrl),
+		groupRepo:        mock.NewMockGroupRepository(ctrl),
+		userGroupRepo:    mock.NewMockUserGroupRepository(ctrl),
+		userIdentityRepo: mock.NewMockUserIdentityRepository(ctrl),
+		directoryRepo:    mock.NewMockDirectoryRepository(ctrl),
+	}
+
+	uc := NewDirectoryUsecase()
+	err := uc.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserUsecase(m.userUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectGroupRepo(m.groupRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserGroupRepo(m.userGroupRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserIdentityRepo(m.userIdentityRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectDirectoryRepo(m.directoryRepo)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func Test_directoryUsecase_Authenticate(t *testing.T) {
+	var (
+		userID      = utils.GenerateUUID()
+		engineering = &model.Group{ID: utils.GenerateUUID(), Name: "ENGINEERING"}
+		admin       = &model.Group{ID: utils.GenerateUUID(), Name: "ADMIN"}
+		payload     = &model.UserLoginPayload{Username: "jane", Password: "directory-password"}
+		entry       = &model.DirectoryEntry{
+			DN:       "uid=Jane,ou=people,dc=example,dc=com",
+			Username: "jane",
+			Email:    "jane@example.com",
+			FullName: "Jane Doe",
+			Groups:   []string{"CN=Engineering, OU=Groups, DC=example, DC=com"},
+		}
+		user = &model.User{ID: userID, Username: "jane", Email: "jane@example.com", DirectoryManaged: true}
+	)
+	viper.Set("ldap.group_mapping", []map[string]interface{}{
+		{"dn": "cn=engineering,ou=groups,dc=example,dc=com", "group": engineering.Name},
+		{"dn": "cn=admins,ou=groups,dc=example,dc=com", "group": admin.Name},
+	})
+	defer viper.Set("ldap.group_mapping", nil)
+
+	tests := []struct {
+		name          string
+		mockEntry     *model.DirectoryEntry
+		mockEntryErr  error
+		mockIdentity  *model.UserIdentity
+		mockProvision bool
+		wantErr       error
+	}{
+		{
+			name:         "success linked user",
+			mockEntry:    entry,
+			mockIdentity: &model.UserIdentity{ID: utils.GenerateUUID(), UserID: userID, Provider: model.IdentityProviderLDAP},
+		},
+		{
+			name:          "success provision user",
+			mockEntry:     entry,
+			mockProvision: true,
+		},
+		{
+			name: "entry without email",
+			mockEntry: &model.DirectoryEntry{
+				DN:       entry.DN,
+				Username: "jane",
+			},
+			wantErr: model.ErrDirectoryEntryIncomplete,
+		},
+		{
+			name:         "wrong password",
+			mockEntryErr: model.ErrWrongUsernameOrPassword,
+			wantErr:      model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:         "directory unavailable",
+			mockEntryErr: model.ErrDirectoryUnavailable,
+			wantErr:      model.ErrDirectoryUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newDirectoryUsecaseMock(ctrl)
+
+			m.directoryRepo.EXPECT().Authenticate(gomock.Any(), payload.Username, payload.Password).Times(1).Return(tt.mockEntry, tt.mockEntryErr)
+			if tt.mockEntry != nil {
+				m.userIdentityRepo.EXPECT().FindByProviderAndSubject(gomock.Any(), model.IdentityProviderLDAP, "uid=jane,ou=people,dc=example,dc=com").Times(1).Return(tt.mockIdentity, nil)
+			}
+			if tt.mockIdentity != nil {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Times(1).Return(user, nil)
+			}
+			if tt.mockProvision {
+				m.dbMock.ExpectBegin()
+				m.userUsecase.EXPECT().ProvisionUser(gomock.Any(), &model.ProvisionUserPayload{
+					FullName:         "Jane Doe",
+					Username:         "jane",
+					Email:            "jane@example.com",
+					DirectoryManaged: true,
+				}).Times(1).Return(user, nil)
+				m.userIdentityRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, identity *model.UserIdentity) error {
+						if identity.UserID != userID || identity.Subject != "uid=jane,ou=people,dc=example,dc=com" {
+							t.Errorf("directoryUsecase.Authenticate() linked identity = %+v", identity)
+						}
+						return nil
+					})
+				m.dbMock.ExpectCommit()
+			}
+			if tt.wantErr == nil {
+				// engineering membership is added, admin membership granted earlier is removed
+				m.groupRepo.EXPECT().FindByName(gomock.Any(), engineering.Name).Times(1).Return(engineering, nil)
+				m.groupRepo.EXPECT().FindByName(gomock.Any(), admin.Name).Times(1).Return(admin, nil)
+				m.userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), userID, engineering.ID).Times(1).Return(nil, nil)
+				m.userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), userID, admin.ID).Times(1).
+					Return(&model.UserGroup{UserID: userID, GroupID: admin.ID}, nil)
+				m.userGroupRepo.EXPECT().Create(gomock.Any(), &model.UserGroup{UserID: userID, GroupID: engineering.ID}).Times(1).Return(nil)
+				m.userGroupRepo.EXPECT().DeleteByUserIDAndGroupID(gomock.Any(), userID, admin.ID).Times(1).Return(nil)
+			}
+
+			got, err := uc.Authenticate(context.TODO(), payload)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("directoryUsecase.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && got != user {
+				t.Errorf("directoryUsecase.Authenticate() = %v, want %v", got, user)
+			}
+			if err := m.dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("directoryUsecase.Authenticate() transaction: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type emailVerificationUsecase struct {
+	userRepo              model.UserRepository
+	groupRepo             model.GroupRepository
+	userGroupRepo         model.UserGroupRepository
+	emailVerificationRepo model.EmailVerificationRepository
+	eventRepo             model.SecurityEventRepository
+	mailer                model.Mailer
+	db                    *gorm.DB
+}
+
+func NewEmailVerificationUsecase() model.EmailVerificationUsecase {
+	return new(emailVerificationUsecase)
+}
+
+func (uc *emailVerificationUsecase) SendVerificationEmail(ctx context.Context, payload *model.SendVerificationEmailPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID := getUserIDFromCtx(ctx)
+	if userID != constant.GuestID {
+		user, err := uc.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			logrus.WithField("userID", userID).Error(err.Error())
+			return err
+		}
+		if user == nil {
+			return model.ErrUserNotFound
+		}
+		if user.IsEmailVerified() {
+			return model.ErrEmailAlreadyVerified
+		}
+		return uc.SendToUser(ctx, user)
+	}
+
+	if payload.Email == "" {
+		return model.ErrEmailRequired
+	}
+
+	user, err := uc.userRepo.FindByEmail(ctx, payload.Email)
+	if err != nil {
+		logrus.WithField("email", payload.Email).Error(err.Error())
+		return err
+	}
+	// a guest always get the same answer so the endpoint can't tell which emails are registered
+	if user == nil || user.IsEmailVerified() {
+		return nil
+	}
+
+	return uc.SendToUser(ctx, user)
+}
+
+// SendToUser mail a single-use verification link bound to the current email of the user.
+func (uc *emailVerificationUsecase) SendToUser(ctx context.Context, user *model.User) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": user.ID,
+	})
+
+	tokenID := utils.GenerateUUID()
+	token, err := utils.GenerateToken(tokenID, user.ID, model.EmailVerificationToken, config.EmailVerificationTokenDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	link, err := url.Parse(config.EmailVerificationURL())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	err = uc.emailVerificationRepo.Create(ctx, tokenID, &model.EmailVerification{
+		UserID: user.ID,
+		Email:  user.Email,
+	}, config.EmailVerificationTokenDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = uc.mailer.Send(ctx, &model.Mail{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nConfirm your email address by opening the link below, it expire in %s.\n\n%s\n",
+			user.FullName, config.EmailVerificationTokenDuration(), link.String()),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// VerifyEmail mark the email of the token owner as verified and, when the user was kept in the
+// unverified group, move them to the default group.
+func (uc *emailVerificationUsecase) VerifyEmail(ctx context.Context, payload *model.VerifyEmailPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	claims, err := utils.ParseTokenClaims(payload.Token)
+	if err != nil {
+		return model.ErrEmailVerificationTokenInvalid
+	}
+	if claims.TokenType != model.EmailVerificationToken.String() {
+		return model.ErrEmailVerificationTokenInvalid
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": claims.UserID,
+	})
+
+	verification, err := uc.emailVerificationRepo.Consume(ctx, claims.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if verification == nil || verification.UserID != claims.UserID {
+		return model.ErrEmailVerificationTokenInvalid
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if user == nil || user.Email != verification.Email {
+		return model.ErrEmailVerificationTokenInvalid
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	err = uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := utils.NewTxContext(ctx, tx)
+
+		verified, err := uc.userRepo.MarkEmailVerified(txCtx, user)
+		if err != nil {
+			return err
+		}
+		if !verified {
+			return model.ErrEmailVerificationTokenInvalid
+		}
+
+		return uc.promoteVerifiedUser(txCtx, user.ID)
+	})
+	if err != nil {
+		if err != model.ErrEmailVerificationTokenInvalid {
+			logger.Error(err.Error())
+		}
+		return err
+	}
+
+	uc.recordEvent(ctx, user.ID, fmt.Sprintf("email %s verified", user.Email))
+
+	return nil
+}
+
+// promoteVerifiedUser swap the unverified group for the default group, users outside the
+// unverified group keep their groups.
+func (uc *emailVerificationUsecase) promoteVerifiedUser(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	unverifiedGroup, err := uc.groupRepo.FindByName(ctx, constant.GroupUnverified)
+	if err != nil {
+		return err
+	}
+	if unverifiedGroup == nil {
+		return nil
+	}
+
+	membership, err := uc.userGroupRepo.FindByUserIDAndGroupID(ctx, userID, unverifiedGroup.ID)
+	if err != nil {
+		return err
+	}
+	if membership == nil {
+		return nil
+	}
+
+	err = uc.userGroupRepo.DeleteByUserIDAndGroupID(ctx, userID, unverifiedGroup.ID)
+	if err != nil {
+		return err
+	}
+
+	defaultGroup, err := uc.groupRepo.FindByName(ctx, constant.GroupDefault)
+	if err != nil {
+		return err
+	}
+	if defaultGroup == nil {
+		return model.ErrGroupNotFound
+	}
+
+	membership, err = uc.userGroupRepo.FindByUserIDAndGroupID(ctx, userID, defaultGroup.ID)
+	if err != nil {
+		return err
+	}
+	if membership != nil {
+		return nil
+	}
+
+	return uc.userGroupRepo.Create(ctx, &model.UserGroup{
+		UserID:  userID,
+		GroupID: defaultGroup.ID,
+	})
+}
+
+func (uc *emailVerificationUsecase) recordEvent(ctx context.Context, userID string, detail string) {
+	metadata := getSessionMetadataFromCtx(ctx)
+	err := uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		EventType: model.SecurityEventEmailVerified,
+		Detail:    fmt.Sprintf("%s from %s (%s)", detail, metadata.IPAddress, metadata.UserAgent),
+	})
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+	}
+}
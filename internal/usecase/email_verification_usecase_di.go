@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"gorm.io/gorm"
+)
+
+func (uc *emailVerificationUsecase) InjectDB(db *gorm.DB) error {
+	if db == nil {
+		return errors.New("invalid db")
+	}
+	uc.db = db
+	return nil
+}
+
+func (uc *emailVerificationUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *emailVerificationUsecase) InjectGroupRepo(repo model.GroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid group repo")
+	}
+	uc.groupRepo = repo
+	return nil
+}
+
+func (uc *emailVerificationUsecase) InjectUserGroupRepo(repo model.UserGroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid user group repo")
+	}
+	uc.userGroupRepo = repo
+	return nil
+}
+
+func (uc *emailVerificationUsecase) InjectEmailVerificationRepo(repo model.EmailVerificationRepository) error {
+	if repo == nil {
+		return errors.New("invalid email verification repo")
+	}
+	uc.emailVerificationRepo = repo
+	return nil
+}
+
+func (uc *emailVerificationUsecase) InjectSecurityEventRepo(repo model.SecurityEventRepository) error {
+	if repo == nil {
+		return errors.New("invalid security event repo")
+	}
+	uc.eventRepo = repo
+	return nil
+}
+
+func (uc *emailVerificationUsecase) InjectMailer(mailer model.Mailer) error {
+	if mailer == nil {
+		return errors.New("invalid mailer")
+	}
+	uc.mailer = mailer
+	return nil
+}
@@ -0,0 +1,347 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+type emailVerificationUsecaseMock struct {
+	db                    sqlmock.Sqlmock
+	userRepo              *mock.MockUserRepository
+	groupRepo             *mock.MockGroupRepository
+	userGroupRepo         *mock.MockUserGroupRepository
+	emailVerificationRepo *mock.MockEmailVerificationRepository
+	eventRepo             *mock.MockSecurityEventRepository
+	mailer                *mock.MockMailer
+}
+
+func newEmailVerificationUsecaseMock(ctrl *gomock.Controller) (model.EmailVerificationUsecase, *emailVerificationUsecaseMock) {
+	dbConn, dbMock := utils.NewDBMock()
+	m := &emailVerificationUsecaseMock{
+		db:                    dbMock,
+		userRepo:              mock.NewMockUserRepository(ctrl),
+		groupRepo:             mock.NewMockGroupRepository(ctrl),
+		userGroupRepo:         mock.NewMockUserGroupRepository(ctrl),
+		emailVerificationRepo: mock.NewMockEmailVerificationRepository(ctrl),
+		eventRepo:             mock.NewMockSecurityEventRepository(ctrl),
+		mailer:                mock.NewMockMailer(ctrl),
+	}
+
+	uc := NewEmailVerificationUsecase()
+	err := uc.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectGroupRepo(m.groupRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserGroupRepo(m.userGroupRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectEmailVerificationRepo(m.emailVerificationRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectSecurityEventRepo(m.eventRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectMailer(m.mailer)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func Test_emailVerificationUsecase_SendVerificationEmail(t *testing.T) {
+	var (
+		userID     = utils.GenerateUUID()
+		userEmail  = "user@gmail.com"
+		verifiedAt = time.Now()
+	)
+	viper.Set("jwt.secret_key", "test-secret")
+	type mockFindUser struct {
+		res *model.User
+		err error
+	}
+	tests := []struct {
+		name            string
+		userID          string
+		payload         *model.SendVerificationEmailPayload
+		mockFindByID    *mockFindUser
+		mockFindByEmail *mockFindUser
+		wantSend        bool
+		mockSendErr     error
+		wantErr         error
+	}{
+		{
+			name:    "success send to current user",
+			userID:  userID,
+			payload: &model.SendVerificationEmailPayload{},
+			mockFindByID: &mockFindUser{
+				res: &model.User{ID: userID, Email: userEmail},
+			},
+			wantSend: true,
+		},
+		{
+			name:    "success send to guest with registered email",
+			userID:  constant.GuestID,
+			payload: &model.SendVerificationEmailPayload{Email: userEmail},
+			mockFindByEmail: &mockFindUser{
+				res: &model.User{ID: userID, Email: userEmail},
+			},
+			wantSend: true,
+		},
+		{
+			name:    "success guest with unknown email get no mail",
+			userID:  constant.GuestID,
+			payload: &model.SendVerificationEmailPayload{Email: userEmail},
+			mockFindByEmail: &mockFindUser{
+				res: nil,
+			},
+		},
+		{
+			name:    "success guest with verified email get no mail",
+			userID:  constant.GuestID,
+			payload: &model.SendVerificationEmailPayload{Email: userEmail},
+			mockFindByEmail: &mockFindUser{
+				res: &model.User{ID: userID, Email: userEmail, EmailVerifiedAt: &verifiedAt},
+			},
+		},
+		{
+			name:    "error guest without email",
+			userID:  constant.GuestID,
+			payload: &model.SendVerificationEmailPayload{},
+			wantErr: model.ErrEmailRequired,
+		},
+		{
+			name:    "error current user already verified",
+			userID:  userID,
+			payload: &model.SendVerificationEmailPayload{},
+			mockFindByID: &mockFindUser{
+				res: &model.User{ID: userID, Email: userEmail, EmailVerifiedAt: &verifiedAt},
+			},
+			wantErr: model.ErrEmailAlreadyVerified,
+		},
+		{
+			name:    "error mailer",
+			userID:  userID,
+			payload: &model.SendVerificationEmailPayload{},
+			mockFindByID: &mockFindUser{
+				res: &model.User{ID: userID, Email: userEmail},
+			},
+			wantSend:    true,
+			mockSendErr: errors.New("smtp error"),
+			wantErr:     errors.New("smtp error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, tt.userID)
+			uc, m := newEmailVerificationUsecaseMock(ctrl)
+
+			if tt.mockFindByID != nil {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), tt.userID).Times(1).Return(tt.mockFindByID.res, tt.mockFindByID.err)
+			}
+			if tt.mockFindByEmail != nil {
+				m.userRepo.EXPECT().FindByEmail(gomock.Any(), tt.payload.Email).Times(1).Return(tt.mockFindByEmail.res, tt.mockFindByEmail.err)
+			}
+			if tt.wantSend {
+				m.emailVerificationRepo.EXPECT().Create(gomock.Any(), gomock.Any(), &model.EmailVerification{
+					UserID: userID,
+					Email:  userEmail,
+				}, gomock.Any()).Times(1).Return(nil)
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, mail *model.Mail) error {
+					if mail.To != userEmail {
+						t.Errorf("emailVerificationUsecase.SendVerificationEmail() sent to %s, want %s", mail.To, userEmail)
+					}
+					if !strings.Contains(mail.Body, model.VerifyEmailPath+"?token=") {
+						t.Errorf("emailVerificationUsecase.SendVerificationEmail() body has no verification link: %s", mail.Body)
+					}
+					return tt.mockSendErr
+				})
+			}
+
+			err := uc.SendVerificationEmail(ctx, tt.payload)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("emailVerificationUsecase.SendVerificationEmail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_emailVerificationUsecase_VerifyEmail(t *testing.T) {
+	var (
+		userID            = utils.GenerateUUID()
+		tokenID           = utils.GenerateUUID()
+		userEmail         = "user@gmail.com"
+		unverifiedGroupID = utils.GenerateUUID()
+		defaultGroupID    = utils.GenerateUUID()
+		verifiedAt        = time.Now()
+	)
+	viper.Set("jwt.secret_key", "test-secret")
+
+	token, err := utils.GenerateToken(tokenID, userID, model.EmailVerificationToken, time.Hour)
+	utils.ContinueOrFatal(err)
+	accessToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, time.Hour)
+	utils.ContinueOrFatal(err)
+
+	type mockMarkEmailVerified struct {
+		res bool
+		err error
+	}
+	tests := []struct {
+		name                  string
+		token                 string
+		mockConsume           *model.EmailVerification
+		mockUser              *model.User
+		mockMarkEmailVerified *mockMarkEmailVerified
+		unverifiedMember      bool
+		wantCommit            bool
+		wantErr               error
+	}{
+		{
+			name:  "success move user out of the unverified group",
+			token: token,
+			mockConsume: &model.EmailVerification{
+				UserID: userID,
+				Email:  userEmail,
+			},
+			mockUser: &model.User{ID: userID, Email: userEmail},
+			mockMarkEmailVerified: &mockMarkEmailVerified{
+				res: true,
+			},
+			unverifiedMember: true,
+			wantCommit:       true,
+		},
+		{
+			name:  "success keep groups of a user outside the unverified group",
+			token: token,
+			mockConsume: &model.EmailVerification{
+				UserID: userID,
+				Email:  userEmail,
+			},
+			mockUser: &model.User{ID: userID, Email: userEmail},
+			mockMarkEmailVerified: &mockMarkEmailVerified{
+				res: true,
+			},
+			wantCommit: true,
+		},
+		{
+			name:  "success already verified",
+			token: token,
+			mockConsume: &model.EmailVerification{
+				UserID: userID,
+				Email:  userEmail,
+			},
+			mockUser: &model.User{ID: userID, Email: userEmail, EmailVerifiedAt: &verifiedAt},
+		},
+		{
+			name:    "error access token",
+			token:   accessToken,
+			wantErr: model.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name:    "error malformed token",
+			token:   "not-a-token",
+			wantErr: model.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name:    "error token already used",
+			token:   token,
+			wantErr: model.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name:  "error email changed since the token was sent",
+			token: token,
+			mockConsume: &model.EmailVerification{
+				UserID: userID,
+				Email:  "old@gmail.com",
+			},
+			mockUser: &model.User{ID: userID, Email: userEmail},
+			wantErr:  model.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name:  "error email changed during the verification",
+			token: token,
+			mockConsume: &model.EmailVerification{
+				UserID: userID,
+				Email:  userEmail,
+			},
+			mockUser: &model.User{ID: userID, Email: userEmail},
+			mockMarkEmailVerified: &mockMarkEmailVerified{
+				res: false,
+			},
+			wantErr: model.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name:  "error db",
+			token: token,
+			mockConsume: &model.EmailVerification{
+				UserID: userID,
+				Email:  userEmail,
+			},
+			mockUser: &model.User{ID: userID, Email: userEmail},
+			mockMarkEmailVerified: &mockMarkEmailVerified{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newEmailVerificationUsecaseMock(ctrl)
+
+			if tt.token == token {
+				m.emailVerificationRepo.EXPECT().Consume(gomock.Any(), tokenID).Times(1).Return(tt.mockConsume, nil)
+			}
+			if tt.mockUser != nil {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Times(1).Return(tt.mockUser, nil)
+			}
+			if tt.mockMarkEmailVerified != nil {
+				m.db.ExpectBegin()
+				m.userRepo.EXPECT().MarkEmailVerified(gomock.Any(), tt.mockUser).Times(1).
+					Return(tt.mockMarkEmailVerified.res, tt.mockMarkEmailVerified.err)
+				if tt.wantCommit {
+					m.db.ExpectCommit()
+				} else {
+					m.db.ExpectRollback()
+				}
+			}
+			if tt.wantCommit {
+				m.groupRepo.EXPECT().FindByName(gomock.Any(), constant.GroupUnverified).Times(1).
+					Return(&model.Group{ID: unverifiedGroupID, Name: constant.GroupUnverified}, nil)
+				if tt.unverifiedMember {
+					m.userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), userID, unverifiedGroupID).Times(1).
+						Return(&model.UserGroup{UserID: userID, GroupID: unverifiedGroupID}, nil)
+					m.userGroupRepo.EXPECT().DeleteByUserIDAndGroupID(gomock.Any(), userID, unverifiedGroupID).Times(1).Return(nil)
+					m.groupRepo.EXPECT().FindByName(gomock.Any(), constant.GroupDefault).Times(1).
+						Return(&model.Group{ID: defaultGroupID, Name: constant.GroupDefault}, nil)
+					m.userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), userID, defaultGroupID).Times(1).Return(nil, nil)
+					m.userGroupRepo.EXPECT().Create(gomock.Any(), &model.UserGroup{UserID: userID, GroupID: defaultGroupID}).Times(1).Return(nil)
+				} else {
+					m.userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), userID, unverifiedGroupID).Times(1).Return(nil, nil)
+				}
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			}
+
+			err := uc.VerifyEmail(context.TODO(), &model.VerifyEmailPayload{Token: tt.token})
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("emailVerificationUsecase.VerifyEmail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := m.db.ExpectationsWereMet(); err != nil {
+				t.Errorf("emailVerificationUsecase.VerifyEmail() %v", err)
+			}
+		})
+	}
+}
@@ -171,3 +171,121 @@ func (uc *groupPermissionUsecase) DeleteByGroupIDAndPermissionID(ctx context.Con
 
 	return nil
 }
+
+func (uc *groupPermissionUsecase) ListGroupPermissions(ctx context.Context, payload *model.ListGroupPermissionsPayload) (*model.ListGroupPermissionsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"groupID": payload.GroupID,
+	})
+
+	currentUserID := getUserIDFromCtx(ctx)
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionGroupPermissionAll,
+			constant.PermissionGroupPermissionRead,
+		},
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	after, err := model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := model.PageSize(payload.PageSize)
+
+	group, err := uc.groupRepo.FindByID(ctx, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if group == nil {
+		return nil, model.ErrGroupNotFound
+	}
+
+	permissions, err := uc.groupPermissionRepo.FindPermissions(ctx, &model.GroupPermissionFilter{
+		GroupID: payload.GroupID,
+		After:   after,
+		Limit:   pageSize + 1,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	permissions, nextPageToken := pageOf(permissions, pageSize, func(permission *model.Permission) *model.PageCursor {
+		return &model.PageCursor{Key: permission.Name, ID: permission.ID}
+	})
+	return &model.ListGroupPermissionsResponse{
+		Permissions:   permissions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (uc *groupPermissionUsecase) ListGroupsWithPermission(ctx context.Context, payload *model.ListGroupsWithPermissionPayload) (*model.ListGroupsWithPermissionResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"permissionID": payload.PermissionID,
+	})
+
+	currentUserID := getUserIDFromCtx(ctx)
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionGroupPermissionAll,
+			constant.PermissionGroupPermissionRead,
+		},
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	after, err := model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := model.PageSize(payload.PageSize)
+
+	permission, err := uc.permissionRepo.FindByID(ctx, payload.PermissionID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if permission == nil {
+		return nil, model.ErrPermissionNotFound
+	}
+
+	groups, err := uc.groupPermissionRepo.FindGroupsWithPermission(ctx, &model.PermissionHolderFilter{
+		PermissionNames: grantingPermissions(permission.Name),
+		After:           after,
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	groups, nextPageToken := pageOf(groups, pageSize, func(group *model.Group) *model.PageCursor {
+		return &model.PageCursor{Key: group.Name, ID: group.ID}
+	})
+	return &model.ListGroupsWithPermissionResponse{
+		Groups:        groups,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -672,3 +672,185 @@ func Test_groupPermissionUsecase_DeleteByGroupIDAndPermissionID(t *testing.T) {
 		})
 	}
 }
+
+func Test_groupPermissionUsecase_ListGroupPermissions(t *testing.T) {
+	userID := utils.GenerateUUID()
+	ownerID := utils.GenerateUUID()
+	cursor := &model.PageCursor{Key: "a", ID: utils.GenerateUUID()}
+	items := []*model.Permission{{ID: utils.GenerateUUID(), Name: "b"}}
+
+	tests := []struct {
+		name          string
+		payload       *model.ListGroupPermissionsPayload
+		mockAccessErr error
+		wantFind      bool
+		mockOwner     *model.Group
+		wantFilter    *model.GroupPermissionFilter
+		mockRes       []*model.Permission
+		mockErr       error
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			payload:    &model.ListGroupPermissionsPayload{GroupID: ownerID, PageSize: 2, PageToken: model.EncodePageToken(cursor)},
+			wantFind:   true,
+			mockOwner:  &model.Group{ID: ownerID, Name: "SUPER_USER"},
+			wantFilter: &model.GroupPermissionFilter{GroupID: ownerID, After: cursor, Limit: 3},
+			mockRes:    items,
+		},
+		{
+			name:          "error unauthorized access",
+			payload:       &model.ListGroupPermissionsPayload{GroupID: ownerID},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListGroupPermissionsPayload{GroupID: ownerID, PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:     "error group not found",
+			payload:  &model.ListGroupPermissionsPayload{GroupID: ownerID},
+			wantFind: true,
+			wantErr:  model.ErrGroupNotFound,
+		},
+		{
+			name:       "error find permissions",
+			payload:    &model.ListGroupPermissionsPayload{GroupID: ownerID},
+			wantFind:   true,
+			mockOwner:  &model.Group{ID: ownerID, Name: "SUPER_USER"},
+			wantFilter: &model.GroupPermissionFilter{GroupID: ownerID, Limit: model.DefaultPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, userID)
+
+			groupRepo := mock.NewMockGroupRepository(ctrl)
+			groupPermissionRepo := mock.NewMockGroupPermissionRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFind {
+				groupRepo.EXPECT().FindByID(gomock.Any(), ownerID).Times(1).Return(tt.mockOwner, nil)
+			}
+			if tt.wantFilter != nil {
+				groupPermissionRepo.EXPECT().FindPermissions(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			uc := NewGroupPermissionUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupPermissionRepo(groupPermissionRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupRepo(groupRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ListGroupPermissions(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("groupPermissionUsecase.ListGroupPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got.Permissions, tt.mockRes) {
+				t.Errorf("groupPermissionUsecase.ListGroupPermissions() = %v, want %v", got.Permissions, tt.mockRes)
+			}
+		})
+	}
+}
+
+func Test_groupPermissionUsecase_ListGroupsWithPermission(t *testing.T) {
+	userID := utils.GenerateUUID()
+	ownerID := utils.GenerateUUID()
+	cursor := &model.PageCursor{Key: "a", ID: utils.GenerateUUID()}
+	items := []*model.Group{{ID: utils.GenerateUUID(), Name: "b"}}
+
+	tests := []struct {
+		name          string
+		payload       *model.ListGroupsWithPermissionPayload
+		mockAccessErr error
+		wantFind      bool
+		mockOwner     *model.Permission
+		wantFilter    *model.PermissionHolderFilter
+		mockRes       []*model.Group
+		mockErr       error
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			payload:    &model.ListGroupsWithPermissionPayload{PermissionID: ownerID, PageSize: 2, PageToken: model.EncodePageToken(cursor)},
+			wantFind:   true,
+			mockOwner:  &model.Permission{ID: ownerID, Name: "USER_READ"},
+			wantFilter: &model.PermissionHolderFilter{PermissionNames: []string{"USER_READ", "USER_ALL", "FULL_ACCESS"}, After: cursor, Limit: 3},
+			mockRes:    items,
+		},
+		{
+			name:          "error unauthorized access",
+			payload:       &model.ListGroupsWithPermissionPayload{PermissionID: ownerID},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListGroupsWithPermissionPayload{PermissionID: ownerID, PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:     "error permission not found",
+			payload:  &model.ListGroupsWithPermissionPayload{PermissionID: ownerID},
+			wantFind: true,
+			wantErr:  model.ErrPermissionNotFound,
+		},
+		{
+			name:       "error find groups",
+			payload:    &model.ListGroupsWithPermissionPayload{PermissionID: ownerID},
+			wantFind:   true,
+			mockOwner:  &model.Permission{ID: ownerID, Name: "USER_READ"},
+			wantFilter: &model.PermissionHolderFilter{PermissionNames: []string{"USER_READ", "USER_ALL", "FULL_ACCESS"}, Limit: model.DefaultPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, userID)
+
+			permissionRepo := mock.NewMockPermissionRepository(ctrl)
+			groupPermissionRepo := mock.NewMockGroupPermissionRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFind {
+				permissionRepo.EXPECT().FindByID(gomock.Any(), ownerID).Times(1).Return(tt.mockOwner, nil)
+			}
+			if tt.wantFilter != nil {
+				groupPermissionRepo.EXPECT().FindGroupsWithPermission(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			uc := NewGroupPermissionUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupPermissionRepo(groupPermissionRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPermisisonRepo(permissionRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ListGroupsWithPermission(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("groupPermissionUsecase.ListGroupsWithPermission() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got.Groups, tt.mockRes) {
+				t.Errorf("groupPermissionUsecase.ListGroupsWithPermission() = %v, want %v", got.Groups, tt.mockRes)
+			}
+		})
+	}
+}
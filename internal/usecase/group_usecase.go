@@ -138,6 +138,56 @@ func (uc *groupUsecase) FindByName(ctx context.Context, payload *model.FindGroup
 	return group, nil
 }
 
+func (uc *groupUsecase) List(ctx context.Context, payload *model.ListGroupsPayload) (*model.ListGroupsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"namePrefix": payload.NamePrefix,
+	})
+
+	currentUserID := getUserIDFromCtx(ctx)
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionGroupAll,
+			constant.PermissionGroupRead,
+		},
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	after, err := model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := model.PageSize(payload.PageSize)
+
+	groups, err := uc.groupRepo.FindAll(ctx, &model.GroupFilter{
+		NamePrefix: payload.NamePrefix,
+		After:      after,
+		Limit:      pageSize + 1,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	groups, nextPageToken := pageOf(groups, pageSize, func(group *model.GroupSummary) *model.PageCursor {
+		return &model.PageCursor{Key: group.Name, ID: group.ID}
+	})
+	return &model.ListGroupsResponse{
+		Groups:        groups,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (uc *groupUsecase) Update(ctx context.Context, payload *model.UpdateGroupPayload) (*model.Group, error) {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
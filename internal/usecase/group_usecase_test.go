@@ -459,6 +459,79 @@ func Test_groupUsecase_FindByName(t *testing.T) {
 	}
 }
 
+func Test_groupUsecase_List(t *testing.T) {
+	userID := utils.GenerateUUID()
+	groupID := utils.GenerateUUID()
+	cursor := &model.PageCursor{Key: "A", ID: utils.GenerateUUID()}
+	items := []*model.GroupSummary{{Group: model.Group{ID: groupID, Name: "AB"}, MemberCount: 1}}
+
+	tests := []struct {
+		name          string
+		payload       *model.ListGroupsPayload
+		mockAccessErr error
+		wantFilter    *model.GroupFilter
+		mockRes       []*model.GroupSummary
+		mockErr       error
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			payload:    &model.ListGroupsPayload{NamePrefix: "A", PageSize: 2, PageToken: model.EncodePageToken(cursor)},
+			wantFilter: &model.GroupFilter{NamePrefix: "A", After: cursor, Limit: 3},
+			mockRes:    items,
+		},
+		{
+			name:          "error unauthorized access",
+			payload:       &model.ListGroupsPayload{},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListGroupsPayload{PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:       "error find groups",
+			payload:    &model.ListGroupsPayload{},
+			wantFilter: &model.GroupFilter{Limit: model.DefaultPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, userID)
+
+			groupRepo := mock.NewMockGroupRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFilter != nil {
+				groupRepo.EXPECT().FindAll(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			uc := NewGroupUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupRepo(groupRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.List(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("groupUsecase.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got.Groups, tt.mockRes) {
+				t.Errorf("groupUsecase.List() = %v, want %v", got.Groups, tt.mockRes)
+			}
+		})
+	}
+}
+
 func Test_groupUsecase_Update(t *testing.T) {
 	var (
 		userID  = utils.GenerateUUID()
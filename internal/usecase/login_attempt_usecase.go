@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type loginAttemptUsecase struct {
+	authUC           model.AuthUsecase
+	userRepo         model.UserRepository
+	loginAttemptRepo model.LoginAttemptRepository
+	eventRepo        model.SecurityEventRepository
+}
+
+func NewLoginAttemptUsecase() model.LoginAttemptUsecase {
+	return new(loginAttemptUsecase)
+}
+
+func (uc *loginAttemptUsecase) Check(ctx context.Context, identifier string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	subject, _, err := uc.subjectOf(ctx, identifier)
+	if err != nil {
+		return err
+	}
+
+	return uc.check(ctx, subject)
+}
+
+func (uc *loginAttemptUsecase) CheckUser(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return uc.check(ctx, model.NewLoginAttemptUserSubject(userID))
+}
+
+func (uc *loginAttemptUsecase) RecordFailure(ctx context.Context, identifier string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	subject, user, err := uc.subjectOf(ctx, identifier)
+	if err != nil {
+		return err
+	}
+
+	return uc.recordFailure(ctx, subject, user)
+}
+
+func (uc *loginAttemptUsecase) RecordSuccess(ctx context.Context, identifier string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	subject, _, err := uc.subjectOf(ctx, identifier)
+	if err != nil {
+		return err
+	}
+
+	return uc.loginAttemptRepo.Reset(ctx, subject)
+}
+
+func (uc *loginAttemptUsecase) RecordUserFailure(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+		return err
+	}
+	if user == nil {
+		return model.ErrUserNotFound
+	}
+
+	return uc.recordFailure(ctx, model.NewLoginAttemptUserSubject(user.ID), user)
+}
+
+func (uc *loginAttemptUsecase) RecordUserSuccess(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return uc.loginAttemptRepo.Reset(ctx, model.NewLoginAttemptUserSubject(userID))
+}
+
+// UnlockUser lift the lockout of the user account.
+func (uc *loginAttemptUsecase) UnlockUser(ctx context.Context, payload *model.UnlockUserPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": currentUserID,
+		"userID":        payload.UserID,
+	})
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserAll,
+			constant.PermissionUserUnlock,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, payload.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if user == nil {
+		return model.ErrUserNotFound
+	}
+
+	err = uc.loginAttemptRepo.Reset(ctx, model.NewLoginAttemptUserSubject(user.ID))
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	uc.recordEvent(ctx, user.ID, model.SecurityEventAccountUnlocked, fmt.Sprintf("account unlocked by %s", currentUserID))
+
+	return nil
+}
+
+// check refuse the attempt while subject or the client address is blocked.
+func (uc *loginAttemptUsecase) check(ctx context.Context, subject string) error {
+	block, err := uc.loginAttemptRepo.FindBlock(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if block != nil {
+		if block.Locked {
+			return model.ErrAccountLocked
+		}
+		return model.ErrTooManyLoginAttempts
+	}
+
+	ipAddress := getSessionMetadataFromCtx(ctx).IPAddress
+	if ipAddress == "" {
+		return nil
+	}
+	block, err = uc.loginAttemptRepo.FindBlock(ctx, model.NewLoginAttemptIPSubject(ipAddress))
+	if err != nil {
+		return err
+	}
+	if block != nil {
+		return model.ErrTooManyLoginAttempts
+	}
+
+	return nil
+}
+
+// recordSubjectFailure count the failure and block subject, for the back-off delay or for the
+// whole lockout once maxAttempts is reached. It report whether the subject got locked.
+func (uc *loginAttemptUsecase) recordSubjectFailure(ctx context.Context, subject string, maxAttempts int64) (bool, error) {
+	logger := logrus.WithFields(logrus.Fields{
+		"subject": subject,
+	})
+
+	failures, err := uc.loginAttemptRepo.IncrementFailures(ctx, subject, config.LoginAttemptFailureWindow())
+	if err != nil {
+		logger.Error(err.Error())
+		return false, err
+	}
+
+	block := &model.LoginBlock{
+		Locked: failures >= maxAttempts,
+		Until:  time.Now().Add(loginBackoff(failures)),
+	}
+	if block.Locked {
+		block.Until = time.Now().Add(config.LoginAttemptLockout())
+	}
+
+	err = uc.loginAttemptRepo.Block(ctx, subject, block)
+	if err != nil {
+		logger.Error(err.Error())
+		return false, err
+	}
+
+	return block.Locked, nil
+}
+
+// loginBackoff double the delay on every failure, up to the configured maximum.
+func loginBackoff(failures int64) time.Duration {
+	backoff := config.LoginAttemptBackoffBase()
+	max := config.LoginAttemptBackoffMax()
+	for i := int64(1); i < failures && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
+
+// subjectOf resolve the login identifier to its account so the username and the email share one
+// failure count, the user is nil for an identifier matching no account.
+func (uc *loginAttemptUsecase) subjectOf(ctx context.Context, identifier string) (string, *model.User, error) {
+	identifier = strings.TrimSpace(identifier)
+	user, err := uc.userRepo.FindByUsername(ctx, identifier)
+	if err == nil && user == nil {
+		user, err = uc.userRepo.FindByEmail(ctx, identifier)
+	}
+	if err != nil {
+		logrus.WithField("identifier", identifier).Error(err.Error())
+		return "", nil, err
+	}
+	if user == nil {
+		return model.NewLoginAttemptIdentifierSubject(identifier), nil, nil
+	}
+	return model.NewLoginAttemptUserSubject(user.ID), user, nil
+}
+
+// recordFailure count the failure against subject and the client address, user is nil when
+// subject is an identifier matching no account.
+func (uc *loginAttemptUsecase) recordFailure(ctx context.Context, subject string, user *model.User) error {
+	locked, err := uc.recordSubjectFailure(ctx, subject, config.LoginAttemptMaxAttempts())
+	if err != nil {
+		return err
+	}
+	if locked {
+		uc.recordLockout(ctx, subject, user)
+	}
+
+	ipAddress := getSessionMetadataFromCtx(ctx).IPAddress
+	if ipAddress == "" {
+		return nil
+	}
+	locked, err = uc.recordSubjectFailure(ctx, model.NewLoginAttemptIPSubject(ipAddress), config.LoginAttemptIPMaxAttempts())
+	if err != nil {
+		return err
+	}
+	if locked {
+		logrus.WithField("ipAddress", ipAddress).Warn("client address blocked after too many failed login attempts")
+	}
+
+	return nil
+}
+
+// recordLockout record the event on the locked account, an identifier matching no account
+// is locked all the same so the answer doesn't tell whether it exist.
+func (uc *loginAttemptUsecase) recordLockout(ctx context.Context, subject string, user *model.User) {
+	metadata := getSessionMetadataFromCtx(ctx)
+	if user == nil {
+		logrus.WithField("subject", subject).WithFields(sessionMetadataLogFields(metadata)).Warn("unknown login identifier locked after too many failed attempts")
+		return
+	}
+
+	uc.recordEvent(ctx, user.ID, model.SecurityEventAccountLocked,
+		fmt.Sprintf("account locked for %s after %d failed login attempts, last from %s (%s)",
+			config.LoginAttemptLockout(), config.LoginAttemptMaxAttempts(), metadata.IPAddress, metadata.UserAgent))
+}
+
+func (uc *loginAttemptUsecase) recordEvent(ctx context.Context, userID string, eventType model.SecurityEventType, detail string) {
+	err := uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		EventType: eventType,
+		Detail:    detail,
+	})
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *loginAttemptUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
+func (uc *loginAttemptUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *loginAttemptUsecase) InjectLoginAttemptRepo(repo model.LoginAttemptRepository) error {
+	if repo == nil {
+		return errors.New("invalid login attempt repo")
+	}
+	uc.loginAttemptRepo = repo
+	return nil
+}
+
+func (uc *loginAttemptUsecase) InjectSecurityEventRepo(repo model.SecurityEventRepository) error {
+	if repo == nil {
+		return errors.New("invalid security event repo")
+	}
+	uc.eventRepo = repo
+	return nil
+}
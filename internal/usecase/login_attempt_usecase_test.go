@@ -0,0 +1,411 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+type loginAttemptUsecaseMock struct {
+	authUC           *mock.MockAuthUsecase
+	userRepo         *mock.MockUserRepository
+	loginAttemptRepo *mock.MockLoginAttemptRepository
+	eventRepo        *mock.MockSecurityEventRepository
+}
+
+func newLoginAttemptUsecaseMock(ctrl *gomock.Controller) (model.LoginAttemptUsecase, *loginAttemptUsecaseMock) {
+	m := &loginAttemptUsecaseMock{
+		authUC:           mock.NewMockAuthUsecase(ctrl),
+		userRepo:         mock.NewMockUserRepository(ctrl),
+		loginAttemptRepo: mock.NewMockLoginAttemptRepository(ctrl),
+		eventRepo:        mock.NewMockSecurityEventRepository(ctrl),
+	}
+
+	uc := NewLoginAttemptUsecase()
+	err := uc.InjectAuthUsecase(m.authUC)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectLoginAttemptRepo(m.loginAttemptRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectSecurityEventRepo(m.eventRepo)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func newLoginAttemptCtx(ipAddress string) context.Context {
+	return context.WithValue(context.TODO(), constant.KeySessionMetadataCtx, &model.SessionMetadata{
+		IPAddress: ipAddress,
+		UserAgent: "test-agent",
+	})
+}
+
+func Test_loginAttemptUsecase_Check(t *testing.T) {
+	user := &model.User{ID: utils.GenerateUUID(), Username: "John"}
+	ipSubject := model.NewLoginAttemptIPSubject("10.0.0.1")
+	lockout := &model.LoginBlock{Locked: true, Until: time.Now().Add(time.Minute)}
+	backoff := &model.LoginBlock{Until: time.Now().Add(time.Second)}
+
+	tests := []struct {
+		name          string
+		ipAddress     string
+		mockUser      *model.User
+		mockUserBlock *model.LoginBlock
+		mockUserErr   error
+		wantIPCheck   bool
+		mockIPBlock   *model.LoginBlock
+		wantErr       error
+	}{
+		{
+			name:        "success",
+			ipAddress:   "10.0.0.1",
+			mockUser:    user,
+			wantIPCheck: true,
+		},
+		{
+			name:        "success unknown identifier",
+			ipAddress:   "10.0.0.1",
+			wantIPCheck: true,
+		},
+		{
+			name:      "success without client address",
+			ipAddress: "",
+		},
+		{
+			name:          "error account locked",
+			ipAddress:     "10.0.0.1",
+			mockUser:      user,
+			mockUserBlock: lockout,
+			wantErr:       model.ErrAccountLocked,
+		},
+		{
+			name:          "error back-off delay",
+			ipAddress:     "10.0.0.1",
+			mockUserBlock: backoff,
+			wantErr:       model.ErrTooManyLoginAttempts,
+		},
+		{
+			name:        "error client address blocked",
+			ipAddress:   "10.0.0.1",
+			wantIPCheck: true,
+			mockIPBlock: lockout,
+			wantErr:     model.ErrTooManyLoginAttempts,
+		},
+		{
+			name:        "error find block",
+			ipAddress:   "10.0.0.1",
+			mockUserErr: errors.New("redis error"),
+			wantErr:     errors.New("redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newLoginAttemptUsecaseMock(ctrl)
+
+			m.userRepo.EXPECT().FindByUsername(gomock.Any(), "John").Times(1).Return(tt.mockUser, nil)
+			subject := model.NewLoginAttemptUserSubject(user.ID)
+			if tt.mockUser == nil {
+				m.userRepo.EXPECT().FindByEmail(gomock.Any(), "John").Times(1).Return(nil, nil)
+				subject = model.NewLoginAttemptIdentifierSubject("John")
+			}
+			m.loginAttemptRepo.EXPECT().FindBlock(gomock.Any(), subject).Times(1).Return(tt.mockUserBlock, tt.mockUserErr)
+			if tt.wantIPCheck {
+				m.loginAttemptRepo.EXPECT().FindBlock(gomock.Any(), ipSubject).Times(1).Return(tt.mockIPBlock, nil)
+			}
+
+			err := uc.Check(newLoginAttemptCtx(tt.ipAddress), " John ")
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("loginAttemptUsecase.Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_loginAttemptUsecase_RecordFailure(t *testing.T) {
+	user := &model.User{ID: utils.GenerateUUID(), Username: "john", Email: "john@example.com"}
+	ipSubject := model.NewLoginAttemptIPSubject("10.0.0.1")
+
+	tests := []struct {
+		name             string
+		identifier       string
+		mockUser         *model.User
+		userFailures     int64
+		ipFailures       int64
+		mockIncrementErr error
+		wantUserLocked   bool
+		wantUserBlockFor time.Duration
+		wantIPLocked     bool
+		wantEvent        bool
+		wantErr          bool
+	}{
+		{
+			name:             "success first failure",
+			identifier:       "john",
+			mockUser:         user,
+			userFailures:     1,
+			ipFailures:       1,
+			wantUserBlockFor: time.Second,
+		},
+		{
+			name:             "success back-off doubled",
+			identifier:       "john",
+			mockUser:         user,
+			userFailures:     4,
+			ipFailures:       4,
+			wantUserBlockFor: 8 * time.Second,
+		},
+		{
+			name:             "success account locked through its email",
+			identifier:       "john@example.com",
+			mockUser:         user,
+			userFailures:     5,
+			ipFailures:       5,
+			wantUserLocked:   true,
+			wantUserBlockFor: 15 * time.Minute,
+			wantEvent:        true,
+		},
+		{
+			name:             "success unknown identifier locked",
+			identifier:       "ghost",
+			userFailures:     6,
+			ipFailures:       6,
+			wantUserLocked:   true,
+			wantUserBlockFor: 15 * time.Minute,
+		},
+		{
+			name:             "success client address locked",
+			identifier:       "john",
+			mockUser:         user,
+			userFailures:     1,
+			ipFailures:       50,
+			wantUserBlockFor: time.Second,
+			wantIPLocked:     true,
+		},
+		{
+			name:             "error increment failures",
+			identifier:       "john",
+			mockUser:         user,
+			mockIncrementErr: errors.New("redis error"),
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newLoginAttemptUsecaseMock(ctrl)
+
+			userSubject := model.NewLoginAttemptIdentifierSubject(tt.identifier)
+			switch {
+			case tt.mockUser == nil:
+				m.userRepo.EXPECT().FindByUsername(gomock.Any(), tt.identifier).Times(1).Return(nil, nil)
+				m.userRepo.EXPECT().FindByEmail(gomock.Any(), tt.identifier).Times(1).Return(nil, nil)
+			case tt.identifier == tt.mockUser.Email:
+				m.userRepo.EXPECT().FindByUsername(gomock.Any(), tt.identifier).Times(1).Return(nil, nil)
+				m.userRepo.EXPECT().FindByEmail(gomock.Any(), tt.identifier).Times(1).Return(tt.mockUser, nil)
+				userSubject = model.NewLoginAttemptUserSubject(tt.mockUser.ID)
+			default:
+				m.userRepo.EXPECT().FindByUsername(gomock.Any(), tt.identifier).Times(1).Return(tt.mockUser, nil)
+				userSubject = model.NewLoginAttemptUserSubject(tt.mockUser.ID)
+			}
+
+			m.loginAttemptRepo.EXPECT().IncrementFailures(gomock.Any(), userSubject, 15*time.Minute).Times(1).Return(tt.userFailures, tt.mockIncrementErr)
+			if tt.mockIncrementErr == nil {
+				m.loginAttemptRepo.EXPECT().IncrementFailures(gomock.Any(), ipSubject, 15*time.Minute).Times(1).Return(tt.ipFailures, nil)
+				m.loginAttemptRepo.EXPECT().Block(gomock.Any(), userSubject, gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, subject string, block *model.LoginBlock) error {
+						blockFor := time.Until(block.Until)
+						if block.Locked != tt.wantUserLocked || blockFor > tt.wantUserBlockFor || blockFor < tt.wantUserBlockFor-time.Second {
+							t.Errorf("loginAttemptUsecase.RecordFailure() user block = %v for %s, want locked %v for %s", block.Locked, blockFor, tt.wantUserLocked, tt.wantUserBlockFor)
+						}
+						return nil
+					})
+				m.loginAttemptRepo.EXPECT().Block(gomock.Any(), ipSubject, gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, subject string, block *model.LoginBlock) error {
+						if block.Locked != tt.wantIPLocked {
+							t.Errorf("loginAttemptUsecase.RecordFailure() client address locked = %v, want %v", block.Locked, tt.wantIPLocked)
+						}
+						return nil
+					})
+			}
+			if tt.wantEvent {
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, event *model.SecurityEvent) error {
+						if event.UserID != user.ID || event.EventType != model.SecurityEventAccountLocked {
+							t.Errorf("loginAttemptUsecase.RecordFailure() recorded %v", event)
+						}
+						return nil
+					})
+			}
+
+			if err := uc.RecordFailure(newLoginAttemptCtx("10.0.0.1"), tt.identifier); (err != nil) != tt.wantErr {
+				t.Errorf("loginAttemptUsecase.RecordFailure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_loginAttemptUsecase_RecordUserFailure(t *testing.T) {
+	user := &model.User{ID: utils.GenerateUUID(), Username: "john"}
+	userSubject := model.NewLoginAttemptUserSubject(user.ID)
+	ipSubject := model.NewLoginAttemptIPSubject("10.0.0.1")
+
+	tests := []struct {
+		name         string
+		mockUser     *model.User
+		userFailures int64
+		wantLocked   bool
+		wantErr      error
+	}{
+		{
+			name:         "success wrong code delay the account",
+			mockUser:     user,
+			userFailures: 1,
+		},
+		{
+			name:         "success wrong codes lock the account",
+			mockUser:     user,
+			userFailures: 5,
+			wantLocked:   true,
+		},
+		{
+			name:    "error user not found",
+			wantErr: model.ErrUserNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newLoginAttemptUsecaseMock(ctrl)
+
+			m.userRepo.EXPECT().FindByID(gomock.Any(), user.ID).Times(1).Return(tt.mockUser, nil)
+			if tt.mockUser != nil {
+				m.loginAttemptRepo.EXPECT().IncrementFailures(gomock.Any(), userSubject, gomock.Any()).Times(1).Return(tt.userFailures, nil)
+				m.loginAttemptRepo.EXPECT().Block(gomock.Any(), userSubject, gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, subject string, block *model.LoginBlock) error {
+						if block.Locked != tt.wantLocked {
+							t.Errorf("loginAttemptUsecase.RecordUserFailure() account locked = %v, want %v", block.Locked, tt.wantLocked)
+						}
+						return nil
+					})
+				m.loginAttemptRepo.EXPECT().IncrementFailures(gomock.Any(), ipSubject, gomock.Any()).Times(1).Return(int64(1), nil)
+				m.loginAttemptRepo.EXPECT().Block(gomock.Any(), ipSubject, gomock.Any()).Times(1).Return(nil)
+			}
+			if tt.wantLocked {
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			}
+
+			if err := uc.RecordUserFailure(newLoginAttemptCtx("10.0.0.1"), user.ID); err != tt.wantErr {
+				t.Errorf("loginAttemptUsecase.RecordUserFailure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_loginBackoff(t *testing.T) {
+	viper.Set("login_attempt.backoff_base", "2s")
+	viper.Set("login_attempt.backoff_max", "10s")
+	t.Cleanup(func() {
+		viper.Set("login_attempt", nil)
+	})
+
+	tests := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{failures: 1, want: 2 * time.Second},
+		{failures: 2, want: 4 * time.Second},
+		{failures: 3, want: 8 * time.Second},
+		{failures: 4, want: 10 * time.Second},
+		{failures: 100, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := loginBackoff(tt.failures); got != tt.want {
+			t.Errorf("loginBackoff(%d) = %s, want %s", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func Test_loginAttemptUsecase_UnlockUser(t *testing.T) {
+	adminID := utils.GenerateUUID()
+	user := &model.User{ID: utils.GenerateUUID(), Username: "john", Email: "John@example.com"}
+
+	tests := []struct {
+		name          string
+		mockAccessErr error
+		mockUser      *model.User
+		mockResetErr  error
+		wantReset     bool
+		wantEvent     bool
+		wantErr       error
+	}{
+		{
+			name:      "success",
+			mockUser:  user,
+			wantReset: true,
+			wantEvent: true,
+		},
+		{
+			name:          "error no access",
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error user not found",
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name:         "error reset",
+			mockUser:     user,
+			mockResetErr: errors.New("redis error"),
+			wantReset:    true,
+			wantErr:      errors.New("redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newLoginAttemptUsecaseMock(ctrl)
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, adminID)
+
+			m.authUC.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.mockAccessErr == nil {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), user.ID).Times(1).Return(tt.mockUser, nil)
+			}
+			if tt.wantReset {
+				m.loginAttemptRepo.EXPECT().Reset(gomock.Any(), model.NewLoginAttemptUserSubject(user.ID)).Times(1).Return(tt.mockResetErr)
+			}
+			if tt.wantEvent {
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, event *model.SecurityEvent) error {
+						if event.UserID != user.ID || event.EventType != model.SecurityEventAccountUnlocked {
+							t.Errorf("loginAttemptUsecase.UnlockUser() recorded %v", event)
+						}
+						return nil
+					})
+			}
+
+			err := uc.UnlockUser(ctx, &model.UnlockUserPayload{UserID: user.ID})
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("loginAttemptUsecase.UnlockUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
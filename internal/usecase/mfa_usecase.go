@@ -0,0 +1,414 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type mfaUsecase struct {
+	userUC           model.UserUsecase
+	userRepo         model.UserRepository
+	userMFARepo      model.UserMFARepository
+	mfaChallengeRepo model.MFAChallengeRepository
+	eventRepo        model.SecurityEventRepository
+	loginAttemptUC   model.LoginAttemptUsecase
+}
+
+func NewMFAUsecase() model.MFAUsecase {
+	return new(mfaUsecase)
+}
+
+// Enroll start a TOTP enrollment for the current user, a pending enrollment is replaced.
+func (uc *mfaUsecase) Enroll(ctx context.Context) (*model.EnrollMFAResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID := getUserIDFromCtx(ctx)
+	if userID == constant.GuestID {
+		return nil, model.ErrUnauthorizeAccess
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if user == nil {
+		return nil, model.ErrUserNotFound
+	}
+
+	mfa, err := uc.userMFARepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if mfa.IsEnabled() {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+	if mfa != nil {
+		err = uc.userMFARepo.DeleteByUserID(ctx, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = uc.userMFARepo.Create(ctx, &model.UserMFA{
+		UserID:        userID,
+		Secret:        secret,
+		RecoveryCodes: []string{},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &model.EnrollMFAResponse{
+		Secret:     secret,
+		OTPAuthURI: utils.NewTOTPURI(config.MFAIssuer(), user.Username, secret),
+	}, nil
+}
+
+// Confirm enable the pending enrollment once the user proved the authenticator app is set up,
+// and hand out the recovery codes.
+func (uc *mfaUsecase) Confirm(ctx context.Context, payload *model.ConfirmMFAPayload) (*model.ConfirmMFAResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID := getUserIDFromCtx(ctx)
+	if userID == constant.GuestID {
+		return nil, model.ErrUnauthorizeAccess
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	if payload.Code == "" {
+		return nil, model.ErrMFACodeRequired
+	}
+
+	mfa, err := uc.userMFARepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if mfa == nil {
+		return nil, model.ErrMFANotEnrolled
+	}
+	if mfa.IsEnabled() {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+
+	step, ok := utils.ValidateTOTPCode(mfa.Secret, payload.Code, time.Now(), mfa.LastUsedStep)
+	if !ok {
+		return nil, model.ErrMFACodeInvalid
+	}
+
+	recoveryCodes := make([]string, 0, model.MFARecoveryCodeCount)
+	hashedRecoveryCodes := make([]string, 0, model.MFARecoveryCodeCount)
+	for i := 0; i < model.MFARecoveryCodeCount; i++ {
+		recoveryCode, err := utils.GenerateRecoveryCode()
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		recoveryCodes = append(recoveryCodes, recoveryCode)
+		hashedRecoveryCodes = append(hashedRecoveryCodes, utils.HashSecret(utils.NormalizeRecoveryCode(recoveryCode)))
+	}
+
+	enabled, err := uc.userMFARepo.Enable(ctx, userID, hashedRecoveryCodes, step)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	// a concurrent confirmation won the race
+	if !enabled {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+
+	uc.recordEvent(ctx, userID, model.SecurityEventMFAEnabled, "totp enabled")
+
+	return &model.ConfirmMFAResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// Disable remove the mfa of the current user, an enabled one can only be removed with a valid code.
+func (uc *mfaUsecase) Disable(ctx context.Context, payload *model.DisableMFAPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID := getUserIDFromCtx(ctx)
+	if userID == constant.GuestID {
+		return model.ErrUnauthorizeAccess
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	mfa, err := uc.userMFARepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if mfa == nil {
+		return model.ErrMFANotEnabled
+	}
+	if mfa.IsEnabled() {
+		if payload.Code == "" {
+			return model.ErrMFACodeRequired
+		}
+		err = uc.verifyCode(ctx, mfa, payload.Code)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = uc.userMFARepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	if mfa.IsEnabled() {
+		uc.recordEvent(ctx, userID, model.SecurityEventMFADisabled, "totp disabled")
+	}
+
+	return nil
+}
+
+func (uc *mfaUsecase) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	mfa, err := uc.userMFARepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+		return false, err
+	}
+
+	return mfa.IsEnabled(), nil
+}
+
+// Verify count a wrong code against the lockout of the account like a wrong password, the code
+// isn't checked at all while the account is locked.
+func (uc *mfaUsecase) Verify(ctx context.Context, userID string, code string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if code == "" {
+		return model.ErrMFACodeRequired
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	err := uc.loginAttemptUC.CheckUser(ctx, userID)
+	if err != nil {
+		logger.Warn(err.Error())
+		return err
+	}
+
+	mfa, err := uc.userMFARepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if !mfa.IsEnabled() {
+		return model.ErrMFANotEnabled
+	}
+
+	err = uc.verifyCode(ctx, mfa, code)
+	switch err {
+	case nil:
+		if err := uc.loginAttemptUC.RecordUserSuccess(ctx, userID); err != nil {
+			logger.Error(err.Error())
+		}
+	case model.ErrMFACodeInvalid:
+		if err := uc.loginAttemptUC.RecordUserFailure(ctx, userID); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+
+	return err
+}
+
+// CreateChallenge return the token the user exchange with VerifyChallenge once the password was verified.
+func (uc *mfaUsecase) CreateChallenge(ctx context.Context, userID string) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	token, err := utils.GenerateSecret(model.MFATokenPrefix)
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+
+	err = uc.mfaChallengeRepo.Create(ctx, token, &model.MFAChallenge{
+		UserID: userID,
+	}, config.MFAChallengeDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+
+	return token, nil
+}
+
+// VerifyChallenge complete the login started with a mfa challenge. The attempt is counted before
+// the code is checked so concurrent guesses can't go past the limit, the challenge is dropped once
+// it is reached.
+func (uc *mfaUsecase) VerifyChallenge(ctx context.Context, payload *model.VerifyMFAPayload) (*model.AuthResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if payload.MFAToken == "" {
+		return nil, model.ErrMFAChallengeInvalid
+	}
+	if payload.Code == "" {
+		return nil, model.ErrMFACodeRequired
+	}
+
+	challenge, err := uc.mfaChallengeRepo.Find(ctx, payload.MFAToken)
+	if err != nil {
+		logrus.Error(err.Error())
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, model.ErrMFAChallengeInvalid
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": challenge.UserID,
+	})
+
+	attempts, err := uc.mfaChallengeRepo.IncrementAttempts(ctx, payload.MFAToken)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if attempts == 0 {
+		return nil, model.ErrMFAChallengeInvalid
+	}
+	if attempts > int64(config.MFAMaxAttempts()) {
+		uc.dropChallenge(ctx, payload.MFAToken, challenge.UserID)
+		return nil, model.ErrMFAChallengeInvalid
+	}
+
+	err = uc.Verify(ctx, challenge.UserID, payload.Code)
+	switch err {
+	case nil:
+	case model.ErrMFACodeInvalid:
+		if attempts == int64(config.MFAMaxAttempts()) {
+			logger.Warn("too many wrong mfa codes, challenge dropped")
+			uc.dropChallenge(ctx, payload.MFAToken, challenge.UserID)
+		}
+		return nil, err
+	case model.ErrMFANotEnabled:
+		uc.dropChallenge(ctx, payload.MFAToken, challenge.UserID)
+		return nil, model.ErrMFAChallengeInvalid
+	default:
+		return nil, err
+	}
+
+	deleted, err := uc.mfaChallengeRepo.Delete(ctx, payload.MFAToken)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if !deleted {
+		return nil, model.ErrMFAChallengeInvalid
+	}
+
+	return uc.userUC.IssueToken(ctx, challenge.UserID)
+}
+
+// dropChallenge delete a challenge that can't be completed anymore. Failures are only logged so
+// the caller keep its own error.
+func (uc *mfaUsecase) dropChallenge(ctx context.Context, token string, userID string) {
+	_, err := uc.mfaChallengeRepo.Delete(ctx, token)
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+	}
+}
+
+// verifyCode accept a TOTP code or an unused recovery code, each one is accepted only once.
+func (uc *mfaUsecase) verifyCode(ctx context.Context, mfa *model.UserMFA, code string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": mfa.UserID,
+	})
+
+	step, ok := utils.ValidateTOTPCode(mfa.Secret, code, time.Now(), mfa.LastUsedStep)
+	if ok {
+		used, err := uc.userMFARepo.UseStep(ctx, mfa.UserID, step)
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+		if !used {
+			return model.ErrMFACodeInvalid
+		}
+		return nil
+	}
+
+	used, err := uc.userMFARepo.UseRecoveryCode(ctx, mfa.UserID, utils.HashSecret(utils.NormalizeRecoveryCode(code)))
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if !used {
+		return model.ErrMFACodeInvalid
+	}
+
+	uc.recordEvent(ctx, mfa.UserID, model.SecurityEventMFARecoveryUsed, "recovery code used")
+
+	return nil
+}
+
+func (uc *mfaUsecase) recordEvent(ctx context.Context, userID string, eventType model.SecurityEventType, detail string) {
+	metadata := getSessionMetadataFromCtx(ctx)
+	err := uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		EventType: eventType,
+		Detail:    fmt.Sprintf("%s from %s (%s)", detail, metadata.IPAddress, metadata.UserAgent),
+	})
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+	}
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *mfaUsecase) InjectUserUsecase(usecase model.UserUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user usecase")
+	}
+	uc.userUC = usecase
+	return nil
+}
+
+func (uc *mfaUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *mfaUsecase) InjectUserMFARepo(repo model.UserMFARepository) error {
+	if repo == nil {
+		return errors.New("invalid user mfa repo")
+	}
+	uc.userMFARepo = repo
+	return nil
+}
+
+func (uc *mfaUsecase) InjectMFAChallengeRepo(repo model.MFAChallengeRepository) error {
+	if repo == nil {
+		return errors.New("invalid mfa challenge repo")
+	}
+	uc.mfaChallengeRepo = repo
+	return nil
+}
+
+func (uc *mfaUsecase) InjectSecurityEventRepo(repo model.SecurityEventRepository) error {
+	if repo == nil {
+		return errors.New("invalid security event repo")
+	}
+	uc.eventRepo = repo
+	return nil
+}
+
+func (uc *mfaUsecase) InjectLoginAttemptUsecase(usecase model.LoginAttemptUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid login attempt usecase")
+	}
+	uc.loginAttemptUC = usecase
+	return nil
+}
@@ -0,0 +1,499 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+type mfaUsecaseMock struct {
+	userUsecase      *mock.MockUserUsecase
+	userRepo         *mock.MockUserRepository
+	userMFARepo      *mock.MockUserMFARepository
+	mfaChallengeRepo *mock.MockMFAChallengeRepository
+	eventRepo        *mock.MockSecurityEventRepository
+	loginAttemptUC   *mock.MockLoginAttemptUsecase
+}
+
+func newMFAUsecaseMock(ctrl *gomock.Controller) (model.MFAUsecase, *mfaUsecaseMock) {
+	m := &mfaUsecaseMock{
+		userUsecase:      mock.NewMockUserUsecase(ctrl),
+		userRepo:         mock.NewMockUserRepository(ctrl),
+		userMFARepo:      mock.NewMockUserMFARepository(ctrl),
+		mfaChallengeRepo: mock.NewMockMFAChallengeRepository(ctrl),
+		eventRepo:        mock.NewMockSecurityEventRepository(ctrl),
+		loginAttemptUC:   mock.NewMockLoginAttemptUsecase(ctrl),
+	}
+
+	uc := NewMFAUsecase()
+	err := uc.InjectUserUsecase(m.userUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserMFARepo(m.userMFARepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectMFAChallengeRepo(m.mfaChallengeRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectSecurityEventRepo(m.eventRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectLoginAttemptUsecase(m.loginAttemptUC)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func newTestTOTPSecret(t *testing.T) (string, string) {
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := utils.GenerateTOTPCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return secret, code
+}
+
+// newWrongTOTPCode return a well formed code that is not accepted right now.
+func newWrongTOTPCode(t *testing.T, secret string) string {
+	for past := time.Hour; ; past += time.Hour {
+		code, err := utils.GenerateTOTPCode(secret, time.Now().Add(-past))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := utils.ValidateTOTPCode(secret, code, time.Now(), 0); !ok {
+			return code
+		}
+	}
+}
+
+func Test_mfaUsecase_Confirm(t *testing.T) {
+	userID := utils.GenerateUUID()
+	secret, code := newTestTOTPSecret(t)
+	enabledAt := time.Now()
+	type mockEnable struct {
+		res bool
+		err error
+	}
+	tests := []struct {
+		name       string
+		userID     string
+		code       string
+		mockMFA    *model.UserMFA
+		mockEnable *mockEnable
+		wantErr    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			code:   code,
+			mockMFA: &model.UserMFA{
+				UserID: userID,
+				Secret: secret,
+			},
+			mockEnable: &mockEnable{
+				res: true,
+			},
+		},
+		{
+			name:    "error guest",
+			userID:  constant.GuestID,
+			code:    code,
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error not enrolled",
+			userID:  userID,
+			code:    code,
+			mockMFA: nil,
+			wantErr: model.ErrMFANotEnrolled,
+		},
+		{
+			name:   "error already enabled",
+			userID: userID,
+			code:   code,
+			mockMFA: &model.UserMFA{
+				UserID:    userID,
+				Secret:    secret,
+				EnabledAt: &enabledAt,
+			},
+			wantErr: model.ErrMFAAlreadyEnabled,
+		},
+		{
+			name:   "error wrong code",
+			userID: userID,
+			code:   newWrongTOTPCode(t, secret),
+			mockMFA: &model.UserMFA{
+				UserID: userID,
+				Secret: secret,
+			},
+			wantErr: model.ErrMFACodeInvalid,
+		},
+		{
+			name:   "error concurrent confirmation",
+			userID: userID,
+			code:   code,
+			mockMFA: &model.UserMFA{
+				UserID: userID,
+				Secret: secret,
+			},
+			mockEnable: &mockEnable{
+				res: false,
+			},
+			wantErr: model.ErrMFAAlreadyEnabled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newMFAUsecaseMock(ctrl)
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, tt.userID)
+
+			if tt.userID != constant.GuestID {
+				m.userMFARepo.EXPECT().FindByUserID(gomock.Any(), tt.userID).
+					Times(1).
+					Return(tt.mockMFA, nil)
+			}
+			var hashedRecoveryCodes []string
+			if tt.mockEnable != nil {
+				m.userMFARepo.EXPECT().Enable(gomock.Any(), tt.userID, gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, userID string, recoveryCodes []string, step int64) (bool, error) {
+						hashedRecoveryCodes = recoveryCodes
+						return tt.mockEnable.res, tt.mockEnable.err
+					})
+			}
+			if tt.wantErr == nil {
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			}
+
+			got, err := uc.Confirm(ctx, &model.ConfirmMFAPayload{Code: tt.code})
+			if err != tt.wantErr {
+				t.Fatalf("mfaUsecase.Confirm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got.RecoveryCodes) != model.MFARecoveryCodeCount || len(hashedRecoveryCodes) != model.MFARecoveryCodeCount {
+				t.Fatalf("mfaUsecase.Confirm() = %d recovery codes, stored %d", len(got.RecoveryCodes), len(hashedRecoveryCodes))
+			}
+			for i, recoveryCode := range got.RecoveryCodes {
+				if hashedRecoveryCodes[i] != utils.HashSecret(utils.NormalizeRecoveryCode(recoveryCode)) {
+					t.Errorf("mfaUsecase.Confirm() stored recovery code %d unhashed or mismatched", i)
+				}
+			}
+		})
+	}
+}
+
+func Test_mfaUsecase_Verify(t *testing.T) {
+	userID := utils.GenerateUUID()
+	secret, code := newTestTOTPSecret(t)
+	enabledAt := time.Now()
+	enabledMFA := &model.UserMFA{
+		UserID:    userID,
+		Secret:    secret,
+		EnabledAt: &enabledAt,
+	}
+	recoveryCode := "abcde-fghij"
+	type mockUse struct {
+		res bool
+		err error
+	}
+	tests := []struct {
+		name                string
+		code                string
+		mockCheckErr        error
+		mockMFA             *model.UserMFA
+		mockUseStep         *mockUse
+		mockUseRecoveryCode *mockUse
+		wantErr             error
+	}{
+		{
+			name:    "success totp",
+			code:    code,
+			mockMFA: enabledMFA,
+			mockUseStep: &mockUse{
+				res: true,
+			},
+		},
+		{
+			name:    "success recovery code",
+			code:    "ABCDE-FGHIJ",
+			mockMFA: enabledMFA,
+			mockUseRecoveryCode: &mockUse{
+				res: true,
+			},
+		},
+		{
+			name:    "error code required",
+			code:    "",
+			wantErr: model.ErrMFACodeRequired,
+		},
+		{
+			name:         "error account locked",
+			code:         code,
+			mockCheckErr: model.ErrAccountLocked,
+			wantErr:      model.ErrAccountLocked,
+		},
+		{
+			name: "error not enabled",
+			code: code,
+			mockMFA: &model.UserMFA{
+				UserID: userID,
+				Secret: secret,
+			},
+			wantErr: model.ErrMFANotEnabled,
+		},
+		{
+			name:    "error totp replayed",
+			code:    code,
+			mockMFA: enabledMFA,
+			mockUseStep: &mockUse{
+				res: false,
+			},
+			wantErr: model.ErrMFACodeInvalid,
+		},
+		{
+			name:    "error recovery code used",
+			code:    recoveryCode,
+			mockMFA: enabledMFA,
+			mockUseRecoveryCode: &mockUse{
+				res: false,
+			},
+			wantErr: model.ErrMFACodeInvalid,
+		},
+		{
+			name:    "error db",
+			code:    code,
+			mockMFA: enabledMFA,
+			mockUseStep: &mockUse{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newMFAUsecaseMock(ctrl)
+
+			if tt.code != "" {
+				m.loginAttemptUC.EXPECT().CheckUser(gomock.Any(), userID).
+					Times(1).
+					Return(tt.mockCheckErr)
+			}
+			if tt.mockMFA != nil {
+				m.userMFARepo.EXPECT().FindByUserID(gomock.Any(), userID).
+					Times(1).
+					Return(tt.mockMFA, nil)
+			}
+			if tt.mockUseStep != nil {
+				m.userMFARepo.EXPECT().UseStep(gomock.Any(), userID, gomock.Any()).
+					Times(1).
+					Return(tt.mockUseStep.res, tt.mockUseStep.err)
+			}
+			if tt.mockUseRecoveryCode != nil {
+				m.userMFARepo.EXPECT().UseRecoveryCode(gomock.Any(), userID, utils.HashSecret(utils.NormalizeRecoveryCode(recoveryCode))).
+					Times(1).
+					Return(tt.mockUseRecoveryCode.res, tt.mockUseRecoveryCode.err)
+				if tt.mockUseRecoveryCode.res {
+					m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				}
+			}
+
+			// a wrong code count toward the lockout of the account, a verified one reset it
+			switch {
+			case tt.mockMFA == nil || tt.wantErr == model.ErrMFANotEnabled:
+			case tt.wantErr == nil:
+				m.loginAttemptUC.EXPECT().RecordUserSuccess(gomock.Any(), userID).Times(1).Return(nil)
+			case tt.wantErr == model.ErrMFACodeInvalid:
+				m.loginAttemptUC.EXPECT().RecordUserFailure(gomock.Any(), userID).Times(1).Return(nil)
+			}
+
+			err := uc.Verify(context.TODO(), userID, tt.code)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("mfaUsecase.Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_mfaUsecase_VerifyChallenge(t *testing.T) {
+	userID := utils.GenerateUUID()
+	mfaToken := model.MFATokenPrefix + "token"
+	secret, code := newTestTOTPSecret(t)
+	wrongCode := newWrongTOTPCode(t, secret)
+	enabledAt := time.Now()
+	enabledMFA := &model.UserMFA{
+		UserID:    userID,
+		Secret:    secret,
+		EnabledAt: &enabledAt,
+	}
+	token := &model.AuthResponse{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+	type mockDelete struct {
+		res bool
+		err error
+	}
+	tests := []struct {
+		name          string
+		code          string
+		mockChallenge *model.MFAChallenge
+		mockAttempts  int64
+		mockDelete    *mockDelete
+		want          *model.AuthResponse
+		wantErr       error
+	}{
+		{
+			name: "success",
+			code: code,
+			mockChallenge: &model.MFAChallenge{
+				UserID: userID,
+			},
+			mockAttempts: 1,
+			mockDelete: &mockDelete{
+				res: true,
+			},
+			want: token,
+		},
+		{
+			name:          "error unknown challenge",
+			code:          code,
+			mockChallenge: nil,
+			wantErr:       model.ErrMFAChallengeInvalid,
+		},
+		{
+			name: "error challenge expired before the attempt",
+			code: code,
+			mockChallenge: &model.MFAChallenge{
+				UserID: userID,
+			},
+			mockAttempts: 0,
+			wantErr:      model.ErrMFAChallengeInvalid,
+		},
+		{
+			name: "error wrong code",
+			code: wrongCode,
+			mockChallenge: &model.MFAChallenge{
+				UserID: userID,
+			},
+			mockAttempts: 1,
+			wantErr:      model.ErrMFACodeInvalid,
+		},
+		{
+			name: "error wrong code drop the challenge after too many attempts",
+			code: wrongCode,
+			mockChallenge: &model.MFAChallenge{
+				UserID:   userID,
+				Attempts: 4,
+			},
+			mockAttempts: 5,
+			mockDelete: &mockDelete{
+				res: true,
+			},
+			wantErr: model.ErrMFACodeInvalid,
+		},
+		{
+			name: "error concurrent guess past the limit is not checked",
+			code: code,
+			mockChallenge: &model.MFAChallenge{
+				UserID:   userID,
+				Attempts: 4,
+			},
+			mockAttempts: 6,
+			mockDelete: &mockDelete{
+				res: true,
+			},
+			wantErr: model.ErrMFAChallengeInvalid,
+		},
+		{
+			name: "error challenge already completed",
+			code: code,
+			mockChallenge: &model.MFAChallenge{
+				UserID: userID,
+			},
+			mockAttempts: 1,
+			mockDelete: &mockDelete{
+				res: false,
+			},
+			wantErr: model.ErrMFAChallengeInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newMFAUsecaseMock(ctrl)
+
+			m.mfaChallengeRepo.EXPECT().Find(gomock.Any(), mfaToken).
+				Times(1).
+				Return(tt.mockChallenge, nil)
+			if tt.mockChallenge != nil {
+				m.mfaChallengeRepo.EXPECT().IncrementAttempts(gomock.Any(), mfaToken).
+					Times(1).
+					Return(tt.mockAttempts, nil)
+			}
+			// the code is only checked for the attempts within the limit
+			if tt.mockAttempts > 0 && tt.mockAttempts <= int64(config.MFAMaxAttempts()) {
+				m.loginAttemptUC.EXPECT().CheckUser(gomock.Any(), userID).
+					Times(1).
+					Return(nil)
+				m.userMFARepo.EXPECT().FindByUserID(gomock.Any(), userID).
+					Times(1).
+					Return(enabledMFA, nil)
+				if tt.code == wrongCode {
+					m.userMFARepo.EXPECT().UseRecoveryCode(gomock.Any(), userID, gomock.Any()).
+						Times(1).
+						Return(false, nil)
+					m.loginAttemptUC.EXPECT().RecordUserFailure(gomock.Any(), userID).
+						Times(1).
+						Return(nil)
+				} else {
+					m.userMFARepo.EXPECT().UseStep(gomock.Any(), userID, gomock.Any()).
+						Times(1).
+						Return(true, nil)
+					m.loginAttemptUC.EXPECT().RecordUserSuccess(gomock.Any(), userID).
+						Times(1).
+						Return(nil)
+				}
+			}
+			if tt.mockDelete != nil {
+				m.mfaChallengeRepo.EXPECT().Delete(gomock.Any(), mfaToken).
+					Times(1).
+					Return(tt.mockDelete.res, tt.mockDelete.err)
+			}
+			if tt.want != nil {
+				m.userUsecase.EXPECT().IssueToken(gomock.Any(), userID).
+					Times(1).
+					Return(tt.want, nil)
+			}
+
+			got, err := uc.VerifyChallenge(context.TODO(), &model.VerifyMFAPayload{
+				MFAToken: mfaToken,
+				Code:     tt.code,
+			})
+			if err != tt.wantErr {
+				t.Fatalf("mfaUsecase.VerifyChallenge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mfaUsecase.VerifyChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
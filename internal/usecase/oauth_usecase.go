@@ -0,0 +1,540 @@
+package usecase
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type oauthUsecase struct {
+	authUC                model.AuthUsecase
+	userUC                model.UserUsecase
+	mfaUC                 model.MFAUsecase
+	oauthClientRepo       model.OAuthClientRepository
+	authorizationCodeRepo model.AuthorizationCodeRepository
+	tokenRepo             model.TokenRepository
+}
+
+func NewOAuthUsecase() model.OAuthUsecase {
+	return new(oauthUsecase)
+}
+
+// ValidateAuthorizeRequest check the client and the redirect uri before anything is shown to the user.
+// Errors other than an invalid client or redirect uri can be reported back to the redirect uri.
+func (uc *oauthUsecase) ValidateAuthorizeRequest(ctx context.Context, payload *model.AuthorizePayload) (*model.OAuthClient, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID":    payload.ClientID,
+		"redirectURI": payload.RedirectURI,
+	})
+
+	if payload.ClientID == "" {
+		return nil, model.ErrOAuthInvalidClient
+	}
+	client, err := uc.oauthClientRepo.FindByClientID(ctx, payload.ClientID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if client == nil {
+		return nil, model.ErrOAuthInvalidClient
+	}
+	if !client.HasRedirectURI(payload.RedirectURI) {
+		return nil, model.ErrOAuthInvalidRedirectURI
+	}
+
+	if payload.ResponseType != model.OAuthResponseTypeCode {
+		return nil, model.ErrOAuthUnsupportedResponseType
+	}
+	// PKCE is required for every client, not only public ones
+	if payload.CodeChallenge == "" || payload.CodeChallengeMethod != model.OAuthCodeChallengeMethodS256 {
+		return nil, model.ErrOAuthInvalidRequest
+	}
+	// no id token can be issued while the service sign with its shared secret
+	if model.HasScope(payload.Scope, model.OIDCScopeOpenID) && !utils.IDTokenSigningSupported() {
+		return nil, model.ErrOAuthInvalidScope
+	}
+
+	return client, nil
+}
+
+func (uc *oauthUsecase) Authorize(ctx context.Context, payload *model.AuthorizePayload) (*model.AuthorizeResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	client, err := uc.ValidateAuthorizeRequest(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userUC.Authenticate(ctx, &model.UserLoginPayload{
+		Username: payload.Username,
+		Password: payload.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := uc.mfaUC.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		err = uc.mfaUC.Verify(ctx, user.ID, payload.MFACode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.issueAuthorizationCode(ctx, client, payload, user.ID)
+}
+
+func (uc *oauthUsecase) AuthorizeUser(ctx context.Context, payload *model.AuthorizePayload, userID string) (*model.AuthorizeResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	client, err := uc.ValidateAuthorizeRequest(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.issueAuthorizationCode(ctx, client, payload, userID)
+}
+
+func (uc *oauthUsecase) issueAuthorizationCode(ctx context.Context, client *model.OAuthClient, payload *model.AuthorizePayload, userID string) (*model.AuthorizeResponse, error) {
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": client.ClientID,
+		"userID":   userID,
+	})
+
+	code, err := utils.GenerateSecret("")
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = uc.authorizationCodeRepo.Create(ctx, code, &model.AuthorizationCode{
+		ClientID:            client.ClientID,
+		UserID:              userID,
+		RedirectURI:         payload.RedirectURI,
+		Scope:               payload.Scope,
+		CodeChallenge:       payload.CodeChallenge,
+		CodeChallengeMethod: payload.CodeChallengeMethod,
+		Nonce:               payload.Nonce,
+	}, config.OAuthAuthorizationCodeDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &model.AuthorizeResponse{
+		RedirectURI: payload.RedirectURI,
+		Code:        code,
+		State:       payload.State,
+	}, nil
+}
+
+func (uc *oauthUsecase) Token(ctx context.Context, payload *model.OAuthTokenPayload) (*model.OAuthTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	client, err := uc.authenticateClient(ctx, payload.ClientID, payload.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	switch payload.GrantType {
+	case model.OAuthGrantTypeAuthorizationCode:
+		return uc.exchangeAuthorizationCode(ctx, client, payload)
+	case model.OAuthGrantTypeRefreshToken:
+		return uc.exchangeRefreshToken(ctx, client, payload)
+	default:
+		return nil, model.ErrOAuthUnsupportedGrantType
+	}
+}
+
+func (uc *oauthUsecase) exchangeAuthorizationCode(ctx context.Context, client *model.OAuthClient, payload *model.OAuthTokenPayload) (*model.OAuthTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": client.ClientID,
+	})
+
+	if payload.Code == "" || payload.CodeVerifier == "" {
+		return nil, model.ErrOAuthInvalidRequest
+	}
+
+	authorizationCode, err := uc.authorizationCodeRepo.Consume(ctx, payload.Code)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if authorizationCode == nil ||
+		authorizationCode.ClientID != client.ClientID ||
+		authorizationCode.RedirectURI != payload.RedirectURI ||
+		!utils.VerifyCodeChallenge(authorizationCode.CodeChallenge, payload.CodeVerifier) {
+		return nil, model.ErrOAuthInvalidGrant
+	}
+
+	ctx = withSessionClient(ctx, client.ClientID, client.Name, authorizationCode.Scope)
+	token, err := uc.userUC.IssueToken(ctx, authorizationCode.UserID)
+	switch err {
+	case nil:
+	// the user was disabled since the code was issued
+	case model.ErrAccountPending, model.ErrAccountSuspended, model.ErrAccountDeactivated, model.ErrUserNotFound:
+		return nil, model.ErrOAuthInvalidGrant
+	default:
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	res := newOAuthTokenResponse(token, authorizationCode.Scope)
+	if !model.HasScope(authorizationCode.Scope, model.OIDCScopeOpenID) {
+		return res, nil
+	}
+
+	res.IDToken, err = uc.generateIDToken(ctx, client, authorizationCode)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (uc *oauthUsecase) generateIDToken(ctx context.Context, client *model.OAuthClient, authorizationCode *model.AuthorizationCode) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	user, err := uc.userUC.GetUserInfo(ctx, &model.GetUserInfoPayload{
+		ID: authorizationCode.UserID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	userInfo := model.NewUserInfoClaims(user, authorizationCode.Scope)
+	return utils.GenerateIDToken(&model.IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    config.OAuthIssuer(),
+			Subject:   userInfo.Subject,
+			Audience:  jwt.ClaimStrings{client.ClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(config.AccessTokenDuration())),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Nonce:             authorizationCode.Nonce,
+		Name:              userInfo.Name,
+		PreferredUsername: userInfo.PreferredUsername,
+		Email:             userInfo.Email,
+	})
+}
+
+func (uc *oauthUsecase) exchangeRefreshToken(ctx context.Context, client *model.OAuthClient, payload *model.OAuthTokenPayload) (*model.OAuthTokenResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if payload.RefreshToken == "" {
+		return nil, model.ErrOAuthInvalidRequest
+	}
+
+	claims, err := utils.ParseTokenClaims(payload.RefreshToken)
+	if err != nil {
+		return nil, model.ErrOAuthInvalidGrant
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": client.ClientID,
+		"userID":   claims.UserID,
+		"tokenID":  claims.ID,
+	})
+
+	// the refresh token is bound to the client it was issued to and keep the scope granted with the code
+	session, err := uc.tokenRepo.FindSession(ctx, claims.UserID, claims.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if session == nil || session.ClientID != client.ClientID {
+		return nil, model.ErrOAuthInvalidGrant
+	}
+
+	ctx = withSessionClient(ctx, client.ClientID, client.Name, session.Scope)
+	token, err := uc.userUC.RefreshToken(ctx, &model.RefreshTokenPayload{
+		RefreshToken: payload.RefreshToken,
+	})
+	switch err {
+	case nil:
+	case model.ErrTokenReused, model.ErrTokenInvalid, model.ErrTokenExpired, model.ErrTokenMalformed, model.ErrInvalidTokenType,
+		model.ErrAccountPending, model.ErrAccountSuspended, model.ErrAccountDeactivated:
+		return nil, model.ErrOAuthInvalidGrant
+	default:
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return newOAuthTokenResponse(token, session.Scope), nil
+}
+
+// authenticateClient verify the client secret of confidential clients, public clients must not send one.
+func (uc *oauthUsecase) authenticateClient(ctx context.Context, clientID string, clientSecret string) (*model.OAuthClient, error) {
+	if clientID == "" {
+		return nil, model.ErrOAuthInvalidClient
+	}
+	client, err := uc.oauthClientRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		logrus.WithField("clientID", clientID).Error(err.Error())
+		return nil, err
+	}
+	if client == nil {
+		return nil, model.ErrOAuthInvalidClient
+	}
+	if client.IsPublic() {
+		if clientSecret != "" {
+			return nil, model.ErrOAuthInvalidClient
+		}
+		return client, nil
+	}
+	if !utils.CompareSecret(client.ClientSecretHash, clientSecret) {
+		return nil, model.ErrOAuthInvalidClient
+	}
+	return client, nil
+}
+
+func newOAuthTokenResponse(token *model.AuthResponse, scope string) *model.OAuthTokenResponse {
+	return &model.OAuthTokenResponse{
+		AccessToken:  token.AccessToken,
+		TokenType:    model.OAuthTokenTypeBearer,
+		ExpiresIn:    int64(config.AccessTokenDuration().Seconds()),
+		RefreshToken: token.RefreshToken,
+		Scope:        scope,
+	}
+}
+
+// UserInfo return the claims about the token owner released for the scope granted to the client.
+func (uc *oauthUsecase) UserInfo(ctx context.Context, accessToken string) (*model.UserInfoClaims, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	token, err := uc.authUC.ValidateToken(ctx, &model.ValidateTokenPayload{
+		AccessToken: accessToken,
+	})
+	switch err {
+	case nil:
+	case model.ErrTokenExpired, model.ErrTokenRevoked, model.ErrTokenInvalid, model.ErrTokenMalformed, model.ErrInvalidTokenType:
+		return nil, model.ErrOAuthInvalidToken
+	default:
+		logrus.Error(err.Error())
+		return nil, err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID":  token.UserID,
+		"tokenID": token.TokenID,
+	})
+
+	session, err := uc.tokenRepo.FindSession(ctx, token.UserID, token.TokenID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if session == nil || !model.HasScope(session.Scope, model.OIDCScopeOpenID) {
+		return nil, model.ErrOAuthInsufficientScope
+	}
+
+	user, err := uc.userUC.GetUserInfo(ctx, &model.GetUserInfoPayload{
+		ID: token.UserID,
+	})
+	switch err {
+	case nil:
+	case model.ErrUserNotFound:
+		return nil, model.ErrOAuthInvalidToken
+	default:
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return model.NewUserInfoClaims(user, session.Scope), nil
+}
+
+func (uc *oauthUsecase) OpenIDConfiguration(ctx context.Context) *model.OpenIDConfiguration {
+	_, _, fn := utils.Trace()
+	_, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	// openid is only offered once id tokens are signed with an asymmetric key
+	scopes := []string{model.OIDCScopeProfile, model.OIDCScopeEmail}
+	idTokenAlgorithms := []string{}
+	if utils.IDTokenSigningSupported() {
+		scopes = append([]string{model.OIDCScopeOpenID}, scopes...)
+		idTokenAlgorithms = append(idTokenAlgorithms, utils.TokenSigningAlgorithm())
+	}
+
+	issuer := config.OAuthIssuer()
+	return &model.OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/authorize",
+		TokenEndpoint:                     issuer + "/token",
+		UserInfoEndpoint:                  issuer + "/userinfo",
+		JWKSURI:                           issuer + "/.well-known/jwks.json",
+		ScopesSupported:                   scopes,
+		ResponseTypesSupported:            []string{model.OAuthResponseTypeCode},
+		GrantTypesSupported:               []string{model.OAuthGrantTypeAuthorizationCode, model.OAuthGrantTypeRefreshToken},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  idTokenAlgorithms,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{model.OAuthCodeChallengeMethodS256},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "nonce", "name", "preferred_username", "email"},
+	}
+}
+
+func (uc *oauthUsecase) CreateClient(ctx context.Context, payload *model.CreateOAuthClientPayload) (*model.CreatedOAuthClient, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"name":         payload.Name,
+		"redirectURIs": payload.RedirectURIs,
+		"public":       payload.Public,
+	})
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: getUserIDFromCtx(ctx),
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionOAuthClientAll,
+			constant.PermissionOAuthClientCreate,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	if payload.Name == "" || len(payload.RedirectURIs) == 0 {
+		return nil, model.ErrOAuthInvalidRequest
+	}
+	for _, redirectURI := range payload.RedirectURIs {
+		if !isValidRedirectURI(redirectURI) {
+			return nil, model.ErrOAuthInvalidRedirectURI
+		}
+	}
+
+	now := time.Now()
+	client := &model.OAuthClient{
+		ID:           utils.GenerateUUID(),
+		ClientID:     model.OAuthClientIDPrefix + utils.GenerateUUID(),
+		Name:         payload.Name,
+		RedirectURIs: payload.RedirectURIs,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	var clientSecret string
+	if !payload.Public {
+		clientSecret, err = utils.GenerateSecret(model.OAuthClientSecretPrefix)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		client.ClientSecretHash = utils.HashSecret(clientSecret)
+	}
+
+	err = uc.oauthClientRepo.Create(ctx, client)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &model.CreatedOAuthClient{
+		OAuthClient:  client,
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+func (uc *oauthUsecase) DeleteClient(ctx context.Context, payload *model.DeleteOAuthClientPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": payload.ClientID,
+	})
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: getUserIDFromCtx(ctx),
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionOAuthClientAll,
+			constant.PermissionOAuthClientDelete,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	client, err := uc.oauthClientRepo.FindByClientID(ctx, payload.ClientID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if client == nil {
+		return model.ErrOAuthClientNotFound
+	}
+
+	err = uc.oauthClientRepo.Delete(ctx, client)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// isValidRedirectURI accept absolute https uris without fragment. Native apps may also use an http
+// loopback uri or a private-use scheme named after a reverse domain (RFC 8252 section 7), so
+// schemes like javascript: or data: are never redirected to.
+func isValidRedirectURI(redirectURI string) bool {
+	u, err := url.Parse(redirectURI)
+	if err != nil || !u.IsAbs() || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return u.Host != ""
+	case "http":
+		return isLoopbackHost(u.Hostname())
+	default:
+		return strings.Contains(u.Scheme, ".")
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *oauthUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
+func (uc *oauthUsecase) InjectUserUsecase(usecase model.UserUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user usecase")
+	}
+	uc.userUC = usecase
+	return nil
+}
+
+func (uc *oauthUsecase) InjectMFAUsecase(usecase model.MFAUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid mfa usecase")
+	}
+	uc.mfaUC = usecase
+	return nil
+}
+
+func (uc *oauthUsecase) InjectOAuthClientRepo(repo model.OAuthClientRepository) error {
+	if repo == nil {
+		return errors.New("invalid oauth client repo")
+	}
+	uc.oauthClientRepo = repo
+	return nil
+}
+
+func (uc *oauthUsecase) InjectAuthorizationCodeRepo(repo model.AuthorizationCodeRepository) error {
+	if repo == nil {
+		return errors.New("invalid authorization code repo")
+	}
+	uc.authorizationCodeRepo = repo
+	return nil
+}
+
+func (uc *oauthUsecase) InjectTokenRepo(repo model.TokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid token repo")
+	}
+	uc.tokenRepo = repo
+	return nil
+}
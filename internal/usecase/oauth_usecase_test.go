@@ -0,0 +1,1042 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+const testCodeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+func newOAuthUsecaseMock(ctrl *gomock.Controller) (model.OAuthUsecase, *mock.MockAuthUsecase, *mock.MockUserUsecase, *mock.MockOAuthClientRepository, *mock.MockAuthorizationCodeRepository, *mock.MockTokenRepository, *mock.MockMFAUsecase) {
+	authUsecase := mock.NewMockAuthUsecase(ctrl)
+	userUsecase := mock.NewMockUserUsecase(ctrl)
+	mfaUsecase := mock.NewMockMFAUsecase(ctrl)
+	oauthClientRepo := mock.NewMockOAuthClientRepository(ctrl)
+	authorizationCodeRepo := mock.NewMockAuthorizationCodeRepository(ctrl)
+	tokenRepo := mock.NewMockTokenRepository(ctrl)
+
+	uc := NewOAuthUsecase()
+	err := uc.InjectAuthUsecase(authUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserUsecase(userUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectMFAUsecase(mfaUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectOAuthClientRepo(oauthClientRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectAuthorizationCodeRepo(authorizationCodeRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectTokenRepo(tokenRepo)
+	utils.ContinueOrFatal(err)
+
+	return uc, authUsecase, userUsecase, oauthClientRepo, authorizationCodeRepo, tokenRepo, mfaUsecase
+}
+
+func Test_oauthUsecase_Authorize(t *testing.T) {
+	var (
+		redirectURI = "https://dashboard.example.com/callback"
+		client      = &model.OAuthClient{
+			ID:           utils.GenerateUUID(),
+			ClientID:     model.OAuthClientIDPrefix + utils.GenerateUUID(),
+			Name:         "dashboard",
+			RedirectURIs: []string{redirectURI},
+		}
+		user = &model.User{
+			ID:       utils.GenerateUUID(),
+			Username: "krobus",
+		}
+		validPayload = func() *model.AuthorizePayload {
+			return &model.AuthorizePayload{
+				ResponseType:        model.OAuthResponseTypeCode,
+				ClientID:            client.ClientID,
+				RedirectURI:         redirectURI,
+				State:               "state",
+				CodeChallenge:       utils.NewCodeChallenge(testCodeVerifier),
+				CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+				Username:            "krobus",
+				Password:            "password",
+			}
+		}
+	)
+	type mockAuthenticate struct {
+		res *model.User
+		err error
+	}
+	type mockCreateCode struct {
+		err error
+	}
+	type mockMFAIsEnabled struct {
+		res bool
+		err error
+	}
+	type mockMFAVerify struct {
+		err error
+	}
+	tests := []struct {
+		name             string
+		payload          func() *model.AuthorizePayload
+		mockClient       *model.OAuthClient
+		mockAuthenticate *mockAuthenticate
+		mockMFAIsEnabled *mockMFAIsEnabled
+		mockMFAVerify    *mockMFAVerify
+		mockCreateCode   *mockCreateCode
+		wantErr          error
+	}{
+		{
+			name:       "success",
+			payload:    validPayload,
+			mockClient: client,
+			mockAuthenticate: &mockAuthenticate{
+				res: user,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
+			mockCreateCode: &mockCreateCode{
+				err: nil,
+			},
+		},
+		{
+			name: "success with mfa",
+			payload: func() *model.AuthorizePayload {
+				payload := validPayload()
+				payload.MFACode = "123456"
+				return payload
+			},
+			mockClient: client,
+			mockAuthenticate: &mockAuthenticate{
+				res: user,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: true,
+			},
+			mockMFAVerify: &mockMFAVerify{
+				err: nil,
+			},
+			mockCreateCode: &mockCreateCode{
+				err: nil,
+			},
+		},
+		{
+			name:       "error mfa code required",
+			payload:    validPayload,
+			mockClient: client,
+			mockAuthenticate: &mockAuthenticate{
+				res: user,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: true,
+			},
+			mockMFAVerify: &mockMFAVerify{
+				err: model.ErrMFACodeRequired,
+			},
+			wantErr: model.ErrMFACodeRequired,
+		},
+		{
+			name:       "error unknown client",
+			payload:    validPayload,
+			mockClient: nil,
+			wantErr:    model.ErrOAuthInvalidClient,
+		},
+		{
+			name: "error unregistered redirect uri",
+			payload: func() *model.AuthorizePayload {
+				payload := validPayload()
+				payload.RedirectURI = "https://evil.example.com/callback"
+				return payload
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthInvalidRedirectURI,
+		},
+		{
+			name: "error unsupported response type",
+			payload: func() *model.AuthorizePayload {
+				payload := validPayload()
+				payload.ResponseType = "token"
+				return payload
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthUnsupportedResponseType,
+		},
+		{
+			name: "error missing code challenge",
+			payload: func() *model.AuthorizePayload {
+				payload := validPayload()
+				payload.CodeChallenge = ""
+				return payload
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthInvalidRequest,
+		},
+		{
+			name: "error plain code challenge method",
+			payload: func() *model.AuthorizePayload {
+				payload := validPayload()
+				payload.CodeChallengeMethod = "plain"
+				return payload
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthInvalidRequest,
+		},
+		{
+			name: "error openid scope while signing with the shared secret",
+			payload: func() *model.AuthorizePayload {
+				payload := validPayload()
+				payload.Scope = "openid profile"
+				return payload
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthInvalidScope,
+		},
+		{
+			name:       "error wrong password",
+			payload:    validPayload,
+			mockClient: client,
+			mockAuthenticate: &mockAuthenticate{
+				err: model.ErrWrongUsernameOrPassword,
+			},
+			wantErr: model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:       "error redis",
+			payload:    validPayload,
+			mockClient: client,
+			mockAuthenticate: &mockAuthenticate{
+				res: user,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
+			mockCreateCode: &mockCreateCode{
+				err: errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, _, userUsecase, oauthClientRepo, authorizationCodeRepo, _, mfaUsecase := newOAuthUsecaseMock(ctrl)
+			payload := tt.payload()
+
+			oauthClientRepo.EXPECT().FindByClientID(gomock.Any(), payload.ClientID).
+				Times(1).
+				Return(tt.mockClient, nil)
+			if tt.mockAuthenticate != nil {
+				userUsecase.EXPECT().Authenticate(gomock.Any(), &model.UserLoginPayload{
+					Username: payload.Username,
+					Password: payload.Password,
+				}).
+					Times(1).
+					Return(tt.mockAuthenticate.res, tt.mockAuthenticate.err)
+			}
+			if tt.mockMFAIsEnabled != nil {
+				mfaUsecase.EXPECT().IsEnabled(gomock.Any(), user.ID).
+					Times(1).
+					Return(tt.mockMFAIsEnabled.res, tt.mockMFAIsEnabled.err)
+			}
+			if tt.mockMFAVerify != nil {
+				mfaUsecase.EXPECT().Verify(gomock.Any(), user.ID, payload.MFACode).
+					Times(1).
+					Return(tt.mockMFAVerify.err)
+			}
+			if tt.mockCreateCode != nil {
+				authorizationCodeRepo.EXPECT().Create(gomock.Any(), gomock.Any(), &model.AuthorizationCode{
+					ClientID:            client.ClientID,
+					UserID:              user.ID,
+					RedirectURI:         redirectURI,
+					CodeChallenge:       payload.CodeChallenge,
+					CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+				}, gomock.Any()).
+					Times(1).
+					Return(tt.mockCreateCode.err)
+			}
+
+			got, err := uc.Authorize(context.TODO(), payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("oauthUsecase.Authorize() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("oauthUsecase.Authorize() unexpected error = %v", err)
+				return
+			}
+			if got.Code == "" || got.State != payload.State || got.RedirectURI != redirectURI {
+				t.Errorf("oauthUsecase.Authorize() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_oauthUsecase_Token(t *testing.T) {
+	viper.Set("jwt.secret_key", "test-secret")
+
+	// id tokens are only signed with an asymmetric key
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	utils.ContinueOrFatal(err)
+	viper.Set("jwt.signing_method", "ES256")
+	viper.Set("jwt.signing_key_id", "current")
+	viper.Set("jwt.signing_key_file", writeTestKeyFile(t, signingKey, true))
+	utils.ContinueOrFatal(utils.LoadTokenKeys())
+	t.Cleanup(func() {
+		viper.Set("jwt.signing_method", "HS256")
+		utils.ContinueOrFatal(utils.LoadTokenKeys())
+	})
+
+	var (
+		redirectURI  = "https://dashboard.example.com/callback"
+		clientSecret = model.OAuthClientSecretPrefix + "secret"
+		client       = &model.OAuthClient{
+			ID:               utils.GenerateUUID(),
+			ClientID:         model.OAuthClientIDPrefix + utils.GenerateUUID(),
+			Name:             "dashboard",
+			ClientSecretHash: utils.HashSecret(clientSecret),
+			RedirectURIs:     []string{redirectURI},
+		}
+		publicClient = &model.OAuthClient{
+			ID:           utils.GenerateUUID(),
+			ClientID:     model.OAuthClientIDPrefix + utils.GenerateUUID(),
+			Name:         "mobile",
+			RedirectURIs: []string{redirectURI},
+		}
+		userID            = utils.GenerateUUID()
+		authorizationCode = &model.AuthorizationCode{
+			ClientID:            client.ClientID,
+			UserID:              userID,
+			RedirectURI:         redirectURI,
+			Scope:               "profile",
+			CodeChallenge:       utils.NewCodeChallenge(testCodeVerifier),
+			CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+		}
+		openIDAuthorizationCode = &model.AuthorizationCode{
+			ClientID:            client.ClientID,
+			UserID:              userID,
+			RedirectURI:         redirectURI,
+			Scope:               "openid email",
+			CodeChallenge:       utils.NewCodeChallenge(testCodeVerifier),
+			CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+			Nonce:               "nonce",
+		}
+		user = &model.UserInfoResponse{
+			ID:       userID,
+			FullName: "Krobus",
+			Username: "krobus",
+			Email:    "krobus@example.com",
+		}
+		publicAuthorizationCode = &model.AuthorizationCode{
+			ClientID:            publicClient.ClientID,
+			UserID:              userID,
+			RedirectURI:         redirectURI,
+			CodeChallenge:       utils.NewCodeChallenge(testCodeVerifier),
+			CodeChallengeMethod: model.OAuthCodeChallengeMethodS256,
+		}
+		token = &model.AuthResponse{
+			AccessToken:  "access-token",
+			RefreshToken: "refresh-token",
+		}
+		tokenID = utils.GenerateUUID()
+	)
+	refreshToken, err := utils.GenerateClientToken(tokenID, userID, client.ClientID, model.RefreshToken, time.Minute)
+	utils.ContinueOrFatal(err)
+
+	type mockFindSession struct {
+		res *model.Session
+		err error
+	}
+	type mockConsume struct {
+		res *model.AuthorizationCode
+		err error
+	}
+	type mockIssueToken struct {
+		res *model.AuthResponse
+		err error
+	}
+	type mockRefreshToken struct {
+		res *model.AuthResponse
+		err error
+	}
+	type mockGetUserInfo struct {
+		res *model.UserInfoResponse
+		err error
+	}
+	tests := []struct {
+		name             string
+		payload          *model.OAuthTokenPayload
+		mockClient       *model.OAuthClient
+		mockConsume      *mockConsume
+		mockIssueToken   *mockIssueToken
+		mockGetUserInfo  *mockGetUserInfo
+		mockFindSession  *mockFindSession
+		mockRefreshToken *mockRefreshToken
+		wantScope        string
+		wantIDToken      *model.IDTokenClaims
+		wantErr          error
+	}{
+		{
+			name: "success authorization code",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: authorizationCode,
+			},
+			mockIssueToken: &mockIssueToken{
+				res: token,
+			},
+			wantScope: "profile",
+		},
+		{
+			name: "success authorization code with openid scope",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: openIDAuthorizationCode,
+			},
+			mockIssueToken: &mockIssueToken{
+				res: token,
+			},
+			mockGetUserInfo: &mockGetUserInfo{
+				res: user,
+			},
+			wantScope: "openid email",
+			wantIDToken: &model.IDTokenClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject:  userID,
+					Audience: jwt.ClaimStrings{client.ClientID},
+				},
+				Nonce: "nonce",
+				Email: user.Email,
+			},
+		},
+		{
+			name: "error id token user not found",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: openIDAuthorizationCode,
+			},
+			mockIssueToken: &mockIssueToken{
+				res: token,
+			},
+			mockGetUserInfo: &mockGetUserInfo{
+				err: model.ErrUserNotFound,
+			},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name: "success authorization code public client",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     publicClient.ClientID,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: publicClient,
+			mockConsume: &mockConsume{
+				res: publicAuthorizationCode,
+			},
+			mockIssueToken: &mockIssueToken{
+				res: token,
+			},
+		},
+		{
+			name: "success refresh token keep the granted scope",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeRefreshToken,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				RefreshToken: refreshToken,
+			},
+			mockClient: client,
+			mockFindSession: &mockFindSession{
+				res: &model.Session{SessionMetadata: model.SessionMetadata{ClientID: client.ClientID, Scope: "openid profile"}},
+			},
+			mockRefreshToken: &mockRefreshToken{
+				res: token,
+			},
+			wantScope: "openid profile",
+		},
+		{
+			name: "error refresh token issued to another client",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeRefreshToken,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				RefreshToken: refreshToken,
+			},
+			mockClient: client,
+			mockFindSession: &mockFindSession{
+				res: &model.Session{SessionMetadata: model.SessionMetadata{ClientID: publicClient.ClientID}},
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error first party refresh token",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeRefreshToken,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				RefreshToken: refreshToken,
+			},
+			mockClient: client,
+			mockFindSession: &mockFindSession{
+				res: &model.Session{},
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error refresh token session gone",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeRefreshToken,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				RefreshToken: refreshToken,
+			},
+			mockClient: client,
+			mockFindSession: &mockFindSession{
+				res: nil,
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error malformed refresh token",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeRefreshToken,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				RefreshToken: "refresh-token",
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error wrong client secret",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: "wrong",
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthInvalidClient,
+		},
+		{
+			name: "error unknown client",
+			payload: &model.OAuthTokenPayload{
+				GrantType: model.OAuthGrantTypeAuthorizationCode,
+				ClientID:  "unknown",
+			},
+			mockClient: nil,
+			wantErr:    model.ErrOAuthInvalidClient,
+		},
+		{
+			name: "error unknown code",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: nil,
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error code issued to another client",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: publicAuthorizationCode,
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error redirect uri mismatch",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  "https://dashboard.example.com/other",
+				CodeVerifier: testCodeVerifier,
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: authorizationCode,
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error wrong code verifier",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeAuthorizationCode,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				Code:         "code",
+				RedirectURI:  redirectURI,
+				CodeVerifier: testCodeVerifier + "x",
+			},
+			mockClient: client,
+			mockConsume: &mockConsume{
+				res: authorizationCode,
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error reused refresh token",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    model.OAuthGrantTypeRefreshToken,
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+				RefreshToken: refreshToken,
+			},
+			mockClient: client,
+			mockFindSession: &mockFindSession{
+				res: &model.Session{SessionMetadata: model.SessionMetadata{ClientID: client.ClientID}},
+			},
+			mockRefreshToken: &mockRefreshToken{
+				err: model.ErrTokenReused,
+			},
+			wantErr: model.ErrOAuthInvalidGrant,
+		},
+		{
+			name: "error unsupported grant type",
+			payload: &model.OAuthTokenPayload{
+				GrantType:    "password",
+				ClientID:     client.ClientID,
+				ClientSecret: clientSecret,
+			},
+			mockClient: client,
+			wantErr:    model.ErrOAuthUnsupportedGrantType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, _, userUsecase, oauthClientRepo, authorizationCodeRepo, tokenRepo, _ := newOAuthUsecaseMock(ctrl)
+
+			oauthClientRepo.EXPECT().FindByClientID(gomock.Any(), tt.payload.ClientID).
+				Times(1).
+				Return(tt.mockClient, nil)
+			if tt.mockConsume != nil {
+				authorizationCodeRepo.EXPECT().Consume(gomock.Any(), tt.payload.Code).
+					Times(1).
+					Return(tt.mockConsume.res, tt.mockConsume.err)
+			}
+			if tt.mockIssueToken != nil {
+				userUsecase.EXPECT().IssueToken(gomock.Any(), userID).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, _ string) (*model.AuthResponse, error) {
+						metadata := getSessionMetadataFromCtx(ctx)
+						if metadata.ClientID != tt.mockClient.ClientID || metadata.ClientName != tt.mockClient.Name ||
+							metadata.Scope != tt.mockConsume.res.Scope {
+							t.Errorf("oauthUsecase.Token() session = %+v, want client %s scope %s", metadata, tt.mockClient.Name, tt.mockConsume.res.Scope)
+						}
+						return tt.mockIssueToken.res, tt.mockIssueToken.err
+					})
+			}
+			if tt.mockGetUserInfo != nil {
+				userUsecase.EXPECT().GetUserInfo(gomock.Any(), &model.GetUserInfoPayload{ID: userID}).
+					Times(1).
+					Return(tt.mockGetUserInfo.res, tt.mockGetUserInfo.err)
+			}
+			if tt.mockFindSession != nil {
+				tokenRepo.EXPECT().FindSession(gomock.Any(), userID, tokenID).
+					Times(1).
+					Return(tt.mockFindSession.res, tt.mockFindSession.err)
+			}
+			if tt.mockRefreshToken != nil {
+				userUsecase.EXPECT().RefreshToken(gomock.Any(), &model.RefreshTokenPayload{
+					RefreshToken: tt.payload.RefreshToken,
+				}).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, _ *model.RefreshTokenPayload) (*model.AuthResponse, error) {
+						metadata := getSessionMetadataFromCtx(ctx)
+						if metadata.ClientID != tt.mockClient.ClientID || metadata.Scope != tt.mockFindSession.res.Scope {
+							t.Errorf("oauthUsecase.Token() session = %+v, want client %s scope %s", metadata, tt.mockClient.ClientID, tt.mockFindSession.res.Scope)
+						}
+						return tt.mockRefreshToken.res, tt.mockRefreshToken.err
+					})
+			}
+
+			got, err := uc.Token(context.TODO(), tt.payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("oauthUsecase.Token() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("oauthUsecase.Token() unexpected error = %v", err)
+				return
+			}
+			if got.AccessToken != token.AccessToken || got.RefreshToken != token.RefreshToken ||
+				got.TokenType != model.OAuthTokenTypeBearer || got.Scope != tt.wantScope {
+				t.Errorf("oauthUsecase.Token() = %v", got)
+			}
+			if tt.wantIDToken == nil {
+				if got.IDToken != "" {
+					t.Errorf("oauthUsecase.Token() unexpected id token")
+				}
+				return
+			}
+			claims := new(model.IDTokenClaims)
+			_, err = jwt.ParseWithClaims(got.IDToken, claims, func(token *jwt.Token) (interface{}, error) {
+				return &signingKey.PublicKey, nil
+			})
+			if err != nil {
+				t.Errorf("oauthUsecase.Token() invalid id token: %v", err)
+				return
+			}
+			if claims.Subject != tt.wantIDToken.Subject || !reflect.DeepEqual(claims.Audience, tt.wantIDToken.Audience) ||
+				claims.Nonce != tt.wantIDToken.Nonce || claims.Email != tt.wantIDToken.Email ||
+				claims.Name != tt.wantIDToken.Name || claims.PreferredUsername != tt.wantIDToken.PreferredUsername {
+				t.Errorf("oauthUsecase.Token() id token claims = %+v, want %+v", claims, tt.wantIDToken)
+			}
+		})
+	}
+}
+
+func Test_oauthUsecase_UserInfo(t *testing.T) {
+	var (
+		userID  = utils.GenerateUUID()
+		tokenID = utils.GenerateUUID()
+		user    = &model.UserInfoResponse{
+			ID:       userID,
+			FullName: "Krobus",
+			Username: "krobus",
+			Email:    "krobus@example.com",
+		}
+	)
+	type mockValidateToken struct {
+		res *model.ValidateTokenResponse
+		err error
+	}
+	type mockFindSession struct {
+		res *model.Session
+		err error
+	}
+	type mockGetUserInfo struct {
+		res *model.UserInfoResponse
+		err error
+	}
+	tests := []struct {
+		name              string
+		mockValidateToken *mockValidateToken
+		mockFindSession   *mockFindSession
+		mockGetUserInfo   *mockGetUserInfo
+		want              *model.UserInfoClaims
+		wantErr           error
+	}{
+		{
+			name: "success profile scope",
+			mockValidateToken: &mockValidateToken{
+				res: &model.ValidateTokenResponse{UserID: userID, TokenID: tokenID},
+			},
+			mockFindSession: &mockFindSession{
+				res: &model.Session{SessionMetadata: model.SessionMetadata{Scope: "openid profile"}},
+			},
+			mockGetUserInfo: &mockGetUserInfo{
+				res: user,
+			},
+			want: &model.UserInfoClaims{
+				Subject:           userID,
+				Name:              user.FullName,
+				PreferredUsername: user.Username,
+			},
+		},
+		{
+			name: "success email scope",
+			mockValidateToken: &mockValidateToken{
+				res: &model.ValidateTokenResponse{UserID: userID, TokenID: tokenID},
+			},
+			mockFindSession: &mockFindSession{
+				res: &model.Session{SessionMetadata: model.SessionMetadata{Scope: "openid email"}},
+			},
+			mockGetUserInfo: &mockGetUserInfo{
+				res: user,
+			},
+			want: &model.UserInfoClaims{
+				Subject: userID,
+				Email:   user.Email,
+			},
+		},
+		{
+			name: "error revoked token",
+			mockValidateToken: &mockValidateToken{
+				err: model.ErrTokenRevoked,
+			},
+			wantErr: model.ErrOAuthInvalidToken,
+		},
+		{
+			name: "error token without openid scope",
+			mockValidateToken: &mockValidateToken{
+				res: &model.ValidateTokenResponse{UserID: userID, TokenID: tokenID},
+			},
+			mockFindSession: &mockFindSession{
+				res: &model.Session{SessionMetadata: model.SessionMetadata{Scope: ""}},
+			},
+			wantErr: model.ErrOAuthInsufficientScope,
+		},
+		{
+			name: "error token without session",
+			mockValidateToken: &mockValidateToken{
+				res: &model.ValidateTokenResponse{UserID: userID, TokenID: tokenID},
+			},
+			mockFindSession: &mockFindSession{
+				res: nil,
+			},
+			wantErr: model.ErrOAuthInsufficientScope,
+		},
+		{
+			name: "error redis",
+			mockValidateToken: &mockValidateToken{
+				res: &model.ValidateTokenResponse{UserID: userID, TokenID: tokenID},
+			},
+			mockFindSession: &mockFindSession{
+				err: errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, authUsecase, userUsecase, _, _, tokenRepo, _ := newOAuthUsecaseMock(ctrl)
+
+			authUsecase.EXPECT().ValidateToken(gomock.Any(), &model.ValidateTokenPayload{AccessToken: "access-token"}).
+				Times(1).
+				Return(tt.mockValidateToken.res, tt.mockValidateToken.err)
+			if tt.mockFindSession != nil {
+				tokenRepo.EXPECT().FindSession(gomock.Any(), userID, tokenID).
+					Times(1).
+					Return(tt.mockFindSession.res, tt.mockFindSession.err)
+			}
+			if tt.mockGetUserInfo != nil {
+				userUsecase.EXPECT().GetUserInfo(gomock.Any(), &model.GetUserInfoPayload{ID: userID}).
+					Times(1).
+					Return(tt.mockGetUserInfo.res, tt.mockGetUserInfo.err)
+			}
+
+			got, err := uc.UserInfo(context.TODO(), "access-token")
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("oauthUsecase.UserInfo() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("oauthUsecase.UserInfo() unexpected error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("oauthUsecase.UserInfo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_oauthUsecase_CreateClient(t *testing.T) {
+	type mockHasAccess struct {
+		err error
+	}
+	type mockCreate struct {
+		err error
+	}
+	tests := []struct {
+		name          string
+		payload       *model.CreateOAuthClientPayload
+		mockHasAccess *mockHasAccess
+		mockCreate    *mockCreate
+		wantPublic    bool
+		wantErr       error
+	}{
+		{
+			name: "success confidential client",
+			payload: &model.CreateOAuthClientPayload{
+				Name:         "dashboard",
+				RedirectURIs: []string{"https://dashboard.example.com/callback"},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockCreate: &mockCreate{
+				err: nil,
+			},
+		},
+		{
+			name: "success public client",
+			payload: &model.CreateOAuthClientPayload{
+				Name:         "mobile",
+				RedirectURIs: []string{"com.example.app:/callback"},
+				Public:       true,
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockCreate: &mockCreate{
+				err: nil,
+			},
+			wantPublic: true,
+		},
+		{
+			name: "error unauthorized access",
+			payload: &model.CreateOAuthClientPayload{
+				Name:         "dashboard",
+				RedirectURIs: []string{"https://dashboard.example.com/callback"},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: model.ErrUnauthorizeAccess,
+			},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name: "error missing redirect uris",
+			payload: &model.CreateOAuthClientPayload{
+				Name: "dashboard",
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			wantErr: model.ErrOAuthInvalidRequest,
+		},
+		{
+			name: "error relative redirect uri",
+			payload: &model.CreateOAuthClientPayload{
+				Name:         "dashboard",
+				RedirectURIs: []string{"/callback"},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			wantErr: model.ErrOAuthInvalidRedirectURI,
+		},
+		{
+			name: "error redirect uri with fragment",
+			payload: &model.CreateOAuthClientPayload{
+				Name:         "dashboard",
+				RedirectURIs: []string{"https://dashboard.example.com/callback#token"},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			wantErr: model.ErrOAuthInvalidRedirectURI,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, utils.GenerateUUID())
+			uc, authUsecase, _, oauthClientRepo, _, _, _ := newOAuthUsecaseMock(ctrl)
+
+			if tt.mockHasAccess != nil {
+				authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockHasAccess.err)
+			}
+			if tt.mockCreate != nil {
+				oauthClientRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockCreate.err)
+			}
+
+			got, err := uc.CreateClient(ctx, tt.payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("oauthUsecase.CreateClient() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("oauthUsecase.CreateClient() unexpected error = %v", err)
+				return
+			}
+			if got.IsPublic() != tt.wantPublic || (got.ClientSecret == "") != tt.wantPublic {
+				t.Errorf("oauthUsecase.CreateClient() = %v, wantPublic %v", got, tt.wantPublic)
+			}
+			if !tt.wantPublic && !utils.CompareSecret(got.ClientSecretHash, got.ClientSecret) {
+				t.Errorf("oauthUsecase.CreateClient() secret hash doesn't match the returned secret")
+			}
+		})
+	}
+}
+
+func Test_isValidRedirectURI(t *testing.T) {
+	tests := []struct {
+		name        string
+		redirectURI string
+		want        bool
+	}{
+		{name: "https", redirectURI: "https://dashboard.example.com/callback", want: true},
+		{name: "http loopback ip", redirectURI: "http://127.0.0.1:8080/callback", want: true},
+		{name: "http loopback ipv6", redirectURI: "http://[::1]:8080/callback", want: true},
+		{name: "http localhost", redirectURI: "http://localhost:8080/callback", want: true},
+		{name: "private-use scheme", redirectURI: "com.example.app:/callback", want: true},
+		{name: "http", redirectURI: "http://dashboard.example.com/callback"},
+		{name: "https without host", redirectURI: "https:/callback"},
+		{name: "javascript", redirectURI: "javascript:alert(document.cookie)"},
+		{name: "javascript with mixed case", redirectURI: "JavaScript://dashboard.example.com/%0Aalert(1)"},
+		{name: "data", redirectURI: "data:text/html,<script>alert(1)</script>"},
+		{name: "scheme without a domain", redirectURI: "myapp://callback"},
+		{name: "relative", redirectURI: "/callback"},
+		{name: "fragment", redirectURI: "https://dashboard.example.com/callback#token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isValidRedirectURI(tt.redirectURI); got != tt.want {
+				t.Errorf("isValidRedirectURI(%q) = %v, want %v", tt.redirectURI, got, tt.want)
+			}
+		})
+	}
+}
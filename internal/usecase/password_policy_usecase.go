@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// identities shorter than this are too common to be matched inside a password
+const minSimilarIdentityLength = 3
+
+type passwordPolicyUsecase struct {
+	passwordHistoryRepo model.PasswordHistoryRepository
+	breachedPasswords   model.BreachedPasswordList
+}
+
+func NewPasswordPolicyUsecase() model.PasswordPolicyUsecase {
+	return new(passwordPolicyUsecase)
+}
+
+func (uc *passwordPolicyUsecase) Validate(ctx context.Context, user *model.User, password string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	violations := make([]*model.PasswordPolicyViolation, 0)
+	addViolation := func(rule model.PasswordPolicyRule, description string) {
+		violations = append(violations, &model.PasswordPolicyViolation{
+			Rule:        rule,
+			Description: description,
+		})
+	}
+
+	if minLength := config.PasswordPolicyMinLength(); len([]rune(password)) < minLength {
+		addViolation(model.PasswordRuleMinLength, fmt.Sprintf("must be at least %d characters long", minLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if config.PasswordPolicyRequireUppercase() && !hasUpper {
+		addViolation(model.PasswordRuleUppercase, "must contain an uppercase letter")
+	}
+	if config.PasswordPolicyRequireLowercase() && !hasLower {
+		addViolation(model.PasswordRuleLowercase, "must contain a lowercase letter")
+	}
+	if config.PasswordPolicyRequireDigit() && !hasDigit {
+		addViolation(model.PasswordRuleDigit, "must contain a digit")
+	}
+	if config.PasswordPolicyRequireSymbol() && !hasSymbol {
+		addViolation(model.PasswordRuleSymbol, "must contain a symbol")
+	}
+
+	if password != "" && uc.breachedPasswords.Contains(password) {
+		addViolation(model.PasswordRuleBreached, "appear in a list of breached passwords")
+	}
+
+	if isSimilarToIdentity(password, user) {
+		addViolation(model.PasswordRuleSimilarToIdentity, "must not contain the username or email")
+	}
+
+	reused, err := uc.isReused(ctx, user, password)
+	if err != nil {
+		return err
+	}
+	if reused {
+		addViolation(model.PasswordRuleReused, fmt.Sprintf("must not be one of the last %d passwords", config.PasswordPolicyHistorySize()))
+	}
+
+	if len(violations) > 0 {
+		return &model.PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+func (uc *passwordPolicyUsecase) RecordPassword(ctx context.Context, userID string, hashedPassword string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if config.PasswordPolicyHistorySize() == 0 {
+		return nil
+	}
+
+	return uc.passwordHistoryRepo.Create(ctx, &model.PasswordHistory{
+		ID:       utils.GenerateUUID(),
+		UserID:   userID,
+		Password: hashedPassword,
+	})
+}
+
+// isReused compare password with the current hash of the user and the latest history entries,
+// users created before the history existed only have the current one.
+func (uc *passwordPolicyUsecase) isReused(ctx context.Context, user *model.User, password string) (bool, error) {
+	historySize := config.PasswordPolicyHistorySize()
+	if user.ID == "" || historySize == 0 || password == "" {
+		return false, nil
+	}
+
+	histories, err := uc.passwordHistoryRepo.FindLastByUserID(ctx, user.ID, historySize)
+	if err != nil {
+		logrus.WithField("userID", user.ID).Error(err.Error())
+		return false, err
+	}
+
+	hashedPasswords := make([]string, 0, len(histories)+1)
+	if user.Password != "" {
+		hashedPasswords = append(hashedPasswords, user.Password)
+	}
+	for _, history := range histories {
+		hashedPasswords = append(hashedPasswords, history.Password)
+	}
+	for _, hashedPassword := range hashedPasswords {
+		if utils.ComparePassword(hashedPassword, password) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isSimilarToIdentity(password string, user *model.User) bool {
+	normalizedPassword := normalizeForSimilarity(password)
+	if normalizedPassword == "" {
+		return false
+	}
+
+	emailLocalPart, _, _ := strings.Cut(user.Email, "@")
+	for _, identity := range []string{user.Username, emailLocalPart} {
+		normalizedIdentity := normalizeForSimilarity(identity)
+		if len(normalizedIdentity) < minSimilarIdentityLength {
+			continue
+		}
+		if strings.Contains(normalizedPassword, normalizedIdentity) || strings.Contains(normalizedIdentity, normalizedPassword) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeForSimilarity lowercase s and drop everything but letters and digits,
+// so separators and casing don't hide the identity.
+func normalizeForSimilarity(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
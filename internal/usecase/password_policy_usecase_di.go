@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *passwordPolicyUsecase) InjectPasswordHistoryRepo(repo model.PasswordHistoryRepository) error {
+	if repo == nil {
+		return errors.New("invalid password history repo")
+	}
+	uc.passwordHistoryRepo = repo
+	return nil
+}
+
+func (uc *passwordPolicyUsecase) InjectBreachedPasswordList(list model.BreachedPasswordList) error {
+	if list == nil {
+		return errors.New("invalid breached password list")
+	}
+	uc.breachedPasswords = list
+	return nil
+}
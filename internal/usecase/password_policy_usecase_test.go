@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+type passwordPolicyUsecaseMock struct {
+	passwordHistoryRepo *mock.MockPasswordHistoryRepository
+	breachedPasswords   *mock.MockBreachedPasswordList
+}
+
+func newPasswordPolicyUsecaseMock(ctrl *gomock.Controller) (model.PasswordPolicyUsecase, *passwordPolicyUsecaseMock) {
+	m := &passwordPolicyUsecaseMock{
+		passwordHistoryRepo: mock.NewMockPasswordHistoryRepository(ctrl),
+		breachedPasswords:   mock.NewMockBreachedPasswordList(ctrl),
+	}
+
+	uc := NewPasswordPolicyUsecase()
+	err := uc.InjectPasswordHistoryRepo(m.passwordHistoryRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectBreachedPasswordList(m.breachedPasswords)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func setPasswordPolicyConfig(t *testing.T, cfg map[string]interface{}) {
+	for key, value := range cfg {
+		viper.Set("password_policy."+key, value)
+	}
+	t.Cleanup(func() {
+		viper.Set("password_policy", nil)
+	})
+}
+
+func Test_passwordPolicyUsecase_Validate(t *testing.T) {
+	userID := utils.GenerateUUID()
+	currentPassword, err := utils.HashPassword("Current-Password-1")
+	utils.ContinueOrFatal(err)
+	previousPassword, err := utils.HashPassword("Previous-Password-1")
+	utils.ContinueOrFatal(err)
+	user := &model.User{ID: userID, Username: "johnny.doe", Email: "john.smith@gmail.com", Password: currentPassword}
+	newUser := &model.User{Username: "johnny.doe", Email: "john.smith@gmail.com"}
+	strictPolicy := map[string]interface{}{
+		"min_length":        12,
+		"require_uppercase": true,
+		"require_lowercase": true,
+		"require_digit":     true,
+		"require_symbol":    true,
+	}
+
+	tests := []struct {
+		name          string
+		config        map[string]interface{}
+		user          *model.User
+		password      string
+		breached      bool
+		mockHistory   []*model.PasswordHistory
+		mockFindErr   error
+		wantFindLimit int
+		wantRules     []model.PasswordPolicyRule
+		wantErr       bool
+	}{
+		{
+			name:     "success new user",
+			user:     newUser,
+			password: "correct horse battery",
+		},
+		{
+			name:          "success existing user",
+			user:          user,
+			password:      "correct horse battery",
+			mockHistory:   []*model.PasswordHistory{{UserID: userID, Password: previousPassword}},
+			wantFindLimit: 5,
+		},
+		{
+			name:     "success strict policy",
+			config:   strictPolicy,
+			user:     newUser,
+			password: "Correct-Horse-42",
+		},
+		{
+			name:     "error every failed rule listed",
+			config:   strictPolicy,
+			user:     newUser,
+			password: "johnny",
+			wantRules: []model.PasswordPolicyRule{
+				model.PasswordRuleMinLength,
+				model.PasswordRuleUppercase,
+				model.PasswordRuleDigit,
+				model.PasswordRuleSymbol,
+				model.PasswordRuleSimilarToIdentity,
+			},
+			wantErr: true,
+		},
+		{
+			name:      "error empty password",
+			user:      newUser,
+			password:  "",
+			wantRules: []model.PasswordPolicyRule{model.PasswordRuleMinLength},
+			wantErr:   true,
+		},
+		{
+			name:      "error breached password",
+			user:      newUser,
+			password:  "password123",
+			breached:  true,
+			wantRules: []model.PasswordPolicyRule{model.PasswordRuleBreached},
+			wantErr:   true,
+		},
+		{
+			name:      "error contain the username",
+			user:      newUser,
+			password:  "my-Johnny_Doe-password",
+			wantRules: []model.PasswordPolicyRule{model.PasswordRuleSimilarToIdentity},
+			wantErr:   true,
+		},
+		{
+			name:      "error contain the email",
+			user:      newUser,
+			password:  "john.smith2023",
+			wantRules: []model.PasswordPolicyRule{model.PasswordRuleSimilarToIdentity},
+			wantErr:   true,
+		},
+		{
+			name:          "error reuse the current password",
+			user:          user,
+			password:      "Current-Password-1",
+			wantFindLimit: 5,
+			wantRules:     []model.PasswordPolicyRule{model.PasswordRuleReused},
+			wantErr:       true,
+		},
+		{
+			name:          "error reuse a previous password",
+			config:        map[string]interface{}{"history_size": 3},
+			user:          user,
+			password:      "Previous-Password-1",
+			mockHistory:   []*model.PasswordHistory{{UserID: userID, Password: previousPassword}},
+			wantFindLimit: 3,
+			wantRules:     []model.PasswordPolicyRule{model.PasswordRuleReused},
+			wantErr:       true,
+		},
+		{
+			name:     "success reuse with history turned off",
+			config:   map[string]interface{}{"history_size": 0},
+			user:     user,
+			password: "Current-Password-1",
+		},
+		{
+			name:          "error find history",
+			user:          user,
+			password:      "correct horse battery",
+			mockFindErr:   errors.New("db error"),
+			wantFindLimit: 5,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			setPasswordPolicyConfig(t, tt.config)
+			uc, m := newPasswordPolicyUsecaseMock(ctrl)
+
+			m.breachedPasswords.EXPECT().Contains(tt.password).AnyTimes().Return(tt.breached)
+			if tt.wantFindLimit > 0 {
+				m.passwordHistoryRepo.EXPECT().FindLastByUserID(gomock.Any(), userID, tt.wantFindLimit).Times(1).Return(tt.mockHistory, tt.mockFindErr)
+			}
+
+			err := uc.Validate(context.TODO(), tt.user, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("passwordPolicyUsecase.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantRules == nil {
+				return
+			}
+			policyErr, ok := err.(*model.PasswordPolicyError)
+			if !ok {
+				t.Fatalf("passwordPolicyUsecase.Validate() error = %T, want *model.PasswordPolicyError", err)
+			}
+			gotRules := make([]model.PasswordPolicyRule, 0, len(policyErr.Violations))
+			for _, violation := range policyErr.Violations {
+				gotRules = append(gotRules, violation.Rule)
+			}
+			if !reflect.DeepEqual(gotRules, tt.wantRules) {
+				t.Errorf("passwordPolicyUsecase.Validate() rules = %v, want %v", gotRules, tt.wantRules)
+			}
+		})
+	}
+}
+
+func Test_passwordPolicyUsecase_RecordPassword(t *testing.T) {
+	userID := utils.GenerateUUID()
+	tests := []struct {
+		name       string
+		config     map[string]interface{}
+		wantCreate bool
+		mockErr    error
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			wantCreate: true,
+		},
+		{
+			name:   "success history turned off",
+			config: map[string]interface{}{"history_size": 0},
+		},
+		{
+			name:       "error create",
+			wantCreate: true,
+			mockErr:    errors.New("db error"),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			setPasswordPolicyConfig(t, tt.config)
+			uc, m := newPasswordPolicyUsecaseMock(ctrl)
+
+			if tt.wantCreate {
+				m.passwordHistoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, history *model.PasswordHistory) error {
+						if history.UserID != userID || history.Password != "hashed-password" || history.ID == "" {
+							t.Errorf("passwordPolicyUsecase.RecordPassword() created %v", history)
+						}
+						return tt.mockErr
+					})
+			}
+
+			if err := uc.RecordPassword(context.TODO(), userID, "hashed-password"); (err != nil) != tt.wantErr {
+				t.Errorf("passwordPolicyUsecase.RecordPassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
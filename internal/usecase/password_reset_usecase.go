@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type passwordResetUsecase struct {
+	userRepo                model.UserRepository
+	tokenRepo               model.TokenRepository
+	personalAccessTokenRepo model.PersonalAccessTokenRepository
+	passwordResetRepo       model.PasswordResetRepository
+	eventRepo               model.SecurityEventRepository
+	mailer                  model.Mailer
+	passwordPolicyUC        model.PasswordPolicyUsecase
+}
+
+func NewPasswordResetUsecase() model.PasswordResetUsecase {
+	return new(passwordResetUsecase)
+}
+
+func (uc *passwordResetUsecase) RequestPasswordReset(ctx context.Context, payload *model.RequestPasswordResetPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if payload.Email == "" {
+		return model.ErrEmailRequired
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"email": payload.Email,
+	})
+
+	user, err := uc.userRepo.FindByEmail(ctx, payload.Email)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	// directory managed users change their password in the directory
+	if user == nil || user.DirectoryManaged {
+		return nil
+	}
+
+	logger = logger.WithField("userID", user.ID)
+
+	token, err := utils.GenerateSecret(model.PasswordResetTokenPrefix)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	link, err := url.Parse(config.PasswordResetURL())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	err = uc.passwordResetRepo.Create(ctx, token, &model.PasswordReset{
+		UserID:              user.ID,
+		PasswordFingerprint: utils.HashSecret(user.Password),
+	}, config.PasswordResetTokenDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = uc.mailer.Send(ctx, &model.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nChoose a new password by opening the link below, it expire in %s.\n\n%s\n\nIgnore this email if you didn't ask for a password reset.\n",
+			user.FullName, config.PasswordResetTokenDuration(), link.String()),
+	})
+	// a failed delivery is only logged so the answer doesn't depend on the account existing
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	return nil
+}
+
+// ResetPassword set the new password of the token owner and sign them out everywhere.
+func (uc *passwordResetUsecase) ResetPassword(ctx context.Context, payload *model.ResetPasswordPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if payload.Token == "" {
+		return model.ErrPasswordResetTokenInvalid
+	}
+	if payload.NewPassword == "" {
+		return model.ErrPasswordRequired
+	}
+
+	reset, err := uc.passwordResetRepo.Find(ctx, payload.Token)
+	if err != nil {
+		logrus.Error(err.Error())
+		return err
+	}
+	if reset == nil {
+		return model.ErrPasswordResetTokenInvalid
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": reset.UserID,
+	})
+
+	user, err := uc.userRepo.FindByID(ctx, reset.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if user == nil || user.DirectoryManaged {
+		return model.ErrPasswordResetTokenInvalid
+	}
+	// the password changed since the token was sent
+	if utils.HashSecret(user.Password) != reset.PasswordFingerprint {
+		return model.ErrPasswordResetTokenInvalid
+	}
+
+	// validated before the token is consumed so a rejected password doesn't burn the token
+	err = uc.passwordPolicyUC.Validate(ctx, user, payload.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	// the token is only used up now, a concurrent reset with the same token get nothing
+	consumed, err := uc.passwordResetRepo.Consume(ctx, payload.Token)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if consumed == nil {
+		return model.ErrPasswordResetTokenInvalid
+	}
+
+	hashedPassword, err := utils.HashPassword(payload.NewPassword)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	user.Password = hashedPassword
+	err = uc.userRepo.UpdateByID(ctx, user)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	// the password is already changed, a missing history entry only weaken the reuse check
+	err = uc.passwordPolicyUC.RecordPassword(ctx, user.ID, user.Password)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	err = uc.tokenRepo.RevokeAllSessions(ctx, user.ID, "")
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	// personal access tokens outlive the sessions, whoever knew the old password may have minted one
+	err = uc.personalAccessTokenRepo.DeleteByUserID(ctx, user.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	uc.recordEvent(ctx, user.ID, "password reset")
+
+	return nil
+}
+
+func (uc *passwordResetUsecase) recordEvent(ctx context.Context, userID string, detail string) {
+	metadata := getSessionMetadataFromCtx(ctx)
+	err := uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		EventType: model.SecurityEventPasswordReset,
+		Detail:    fmt.Sprintf("%s from %s (%s)", detail, metadata.IPAddress, metadata.UserAgent),
+	})
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *passwordResetUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *passwordResetUsecase) InjectTokenRepo(repo model.TokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid token repo")
+	}
+	uc.tokenRepo = repo
+	return nil
+}
+
+func (uc *passwordResetUsecase) InjectPersonalAccessTokenRepo(repo model.PersonalAccessTokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid personal access token repo")
+	}
+	uc.personalAccessTokenRepo = repo
+	return nil
+}
+
+func (uc *passwordResetUsecase) InjectPasswordResetRepo(repo model.PasswordResetRepository) error {
+	if repo == nil {
+		return errors.New("invalid password reset repo")
+	}
+	uc.passwordResetRepo = repo
+	return nil
+}
+
+func (uc *passwordResetUsecase) InjectSecurityEventRepo(repo model.SecurityEventRepository) error {
+	if repo == nil {
+		return errors.New("invalid security event repo")
+	}
+	uc.eventRepo = repo
+	return nil
+}
+
+func (uc *passwordResetUsecase) InjectMailer(mailer model.Mailer) error {
+	if mailer == nil {
+		return errors.New("invalid mailer")
+	}
+	uc.mailer = mailer
+	return nil
+}
+
+func (uc *passwordResetUsecase) InjectPasswordPolicyUsecase(usecase model.PasswordPolicyUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid password policy usecase")
+	}
+	uc.passwordPolicyUC = usecase
+	return nil
+}
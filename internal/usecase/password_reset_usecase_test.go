@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+type passwordResetUsecaseMock struct {
+	userRepo                *mock.MockUserRepository
+	tokenRepo               *mock.MockTokenRepository
+	personalAccessTokenRepo *mock.MockPersonalAccessTokenRepository
+	passwordResetRepo       *mock.MockPasswordResetRepository
+	eventRepo               *mock.MockSecurityEventRepository
+	mailer                  *mock.MockMailer
+	passwordPolicyUC        *mock.MockPasswordPolicyUsecase
+}
+
+func newPasswordResetUsecaseMock(ctrl *gomock.Controller) (model.PasswordResetUsecase, *passwordResetUsecaseMock) {
+	m := &passwordResetUsecaseMock{
+		userRepo:                mock.NewMockUserRepository(ctrl),
+		tokenRepo:               mock.NewMockTokenRepository(ctrl),
+		personalAccessTokenRepo: mock.NewMockPersonalAccessTokenRepository(ctrl),
+		passwordResetRepo:       mock.NewMockPasswordResetRepository(ctrl),
+		eventRepo:               mock.NewMockSecurityEventRepository(ctrl),
+		mailer:                  mock.NewMockMailer(ctrl),
+		passwordPolicyUC:        mock.NewMockPasswordPolicyUsecase(ctrl),
+	}
+
+	uc := NewPasswordResetUsecase()
+	err := uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectTokenRepo(m.tokenRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectPersonalAccessTokenRepo(m.personalAccessTokenRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectPasswordResetRepo(m.passwordResetRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectSecurityEventRepo(m.eventRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectMailer(m.mailer)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectPasswordPolicyUsecase(m.passwordPolicyUC)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func Test_passwordResetUsecase_RequestPasswordReset(t *testing.T) {
+	var (
+		userID    = utils.GenerateUUID()
+		userEmail = "user@gmail.com"
+	)
+	tests := []struct {
+		name        string
+		payload     *model.RequestPasswordResetPayload
+		mockUser    *model.User
+		mockFindErr error
+		wantSend    bool
+		mockSendErr error
+		wantErr     error
+	}{
+		{
+			name:     "success",
+			payload:  &model.RequestPasswordResetPayload{Email: userEmail},
+			mockUser: &model.User{ID: userID, Email: userEmail, Password: "hashed-password"},
+			wantSend: true,
+		},
+		{
+			name:    "success unknown email get no mail",
+			payload: &model.RequestPasswordResetPayload{Email: userEmail},
+		},
+		{
+			name:     "success directory managed user get no mail",
+			payload:  &model.RequestPasswordResetPayload{Email: userEmail},
+			mockUser: &model.User{ID: userID, Email: userEmail, DirectoryManaged: true},
+		},
+		{
+			name:        "success when the mail fail",
+			payload:     &model.RequestPasswordResetPayload{Email: userEmail},
+			mockUser:    &model.User{ID: userID, Email: userEmail, Password: "hashed-password"},
+			wantSend:    true,
+			mockSendErr: errors.New("smtp error"),
+		},
+		{
+			name:    "error email required",
+			payload: &model.RequestPasswordResetPayload{},
+			wantErr: model.ErrEmailRequired,
+		},
+		{
+			name:        "error db",
+			payload:     &model.RequestPasswordResetPayload{Email: userEmail},
+			mockFindErr: errors.New("db error"),
+			wantErr:     errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newPasswordResetUsecaseMock(ctrl)
+
+			if tt.payload.Email != "" {
+				m.userRepo.EXPECT().FindByEmail(gomock.Any(), tt.payload.Email).Times(1).Return(tt.mockUser, tt.mockFindErr)
+			}
+			var sentToken string
+			if tt.wantSend {
+				m.passwordResetRepo.EXPECT().Create(gomock.Any(), gomock.Any(), &model.PasswordReset{
+					UserID:              userID,
+					PasswordFingerprint: utils.HashSecret(tt.mockUser.Password),
+				}, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, token string, data *model.PasswordReset, expiration time.Duration) error {
+					sentToken = token
+					return nil
+				})
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, mail *model.Mail) error {
+					if mail.To != userEmail {
+						t.Errorf("passwordResetUsecase.RequestPasswordReset() sent to %s, want %s", mail.To, userEmail)
+					}
+					if !strings.Contains(mail.Body, "token="+sentToken) {
+						t.Errorf("passwordResetUsecase.RequestPasswordReset() body has no reset link: %s", mail.Body)
+					}
+					return tt.mockSendErr
+				})
+			}
+
+			err := uc.RequestPasswordReset(context.TODO(), tt.payload)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("passwordResetUsecase.RequestPasswordReset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantSend && !strings.HasPrefix(sentToken, model.PasswordResetTokenPrefix) {
+				t.Errorf("passwordResetUsecase.RequestPasswordReset() token = %s, want prefix %s", sentToken, model.PasswordResetTokenPrefix)
+			}
+		})
+	}
+}
+
+func Test_passwordResetUsecase_ResetPassword(t *testing.T) {
+	var (
+		userID    = utils.GenerateUUID()
+		userEmail = "user@gmail.com"
+		token     = model.PasswordResetTokenPrefix + "token"
+	)
+	user := &model.User{ID: userID, Email: userEmail, Password: "old-hashed-password"}
+	validReset := &model.PasswordReset{
+		UserID:              userID,
+		PasswordFingerprint: utils.HashSecret(user.Password),
+	}
+	policyErr := &model.PasswordPolicyError{
+		Violations: []*model.PasswordPolicyViolation{
+			{Rule: model.PasswordRuleReused, Description: "must not be one of the last 5 passwords"},
+		},
+	}
+	tests := []struct {
+		name            string
+		payload         *model.ResetPasswordPayload
+		mockFind        *model.PasswordReset
+		mockUser        *model.User
+		mockPolicyErr   error
+		wantConsume     bool
+		mockConsume     *model.PasswordReset
+		wantUpdate      bool
+		mockRevokeErr   error
+		wantRevokeCalls int
+		mockDeleteErr   error
+		wantDeleteCalls int
+		wantErr         error
+	}{
+		{
+			name:            "success",
+			payload:         &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind:        validReset,
+			mockUser:        user,
+			wantConsume:     true,
+			mockConsume:     validReset,
+			wantUpdate:      true,
+			wantRevokeCalls: 1,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:    "error password required",
+			payload: &model.ResetPasswordPayload{Token: token},
+			wantErr: model.ErrPasswordRequired,
+		},
+		{
+			name:    "error token required",
+			payload: &model.ResetPasswordPayload{NewPassword: "new-password"},
+			wantErr: model.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name:    "error unknown or used token",
+			payload: &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			wantErr: model.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name:    "error password changed since the request",
+			payload: &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind: &model.PasswordReset{
+				UserID:              userID,
+				PasswordFingerprint: utils.HashSecret("older-hashed-password"),
+			},
+			mockUser: user,
+			wantErr:  model.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name:     "error directory managed user",
+			payload:  &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind: validReset,
+			mockUser: &model.User{ID: userID, Email: userEmail, Password: user.Password, DirectoryManaged: true},
+			wantErr:  model.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name:          "error password policy keep the token",
+			payload:       &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind:      validReset,
+			mockUser:      user,
+			mockPolicyErr: policyErr,
+			wantErr:       policyErr,
+		},
+		{
+			name:        "error token used by a concurrent reset",
+			payload:     &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind:    validReset,
+			mockUser:    user,
+			wantConsume: true,
+			wantErr:     model.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name:            "error revoke sessions",
+			payload:         &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind:        validReset,
+			mockUser:        user,
+			wantConsume:     true,
+			mockConsume:     validReset,
+			wantUpdate:      true,
+			mockRevokeErr:   errors.New("redis error"),
+			wantRevokeCalls: 1,
+			wantErr:         errors.New("redis error"),
+		},
+		{
+			name:            "error delete personal access tokens",
+			payload:         &model.ResetPasswordPayload{Token: token, NewPassword: "new-password"},
+			mockFind:        validReset,
+			mockUser:        user,
+			wantConsume:     true,
+			mockConsume:     validReset,
+			wantUpdate:      true,
+			wantRevokeCalls: 1,
+			mockDeleteErr:   errors.New("db error"),
+			wantDeleteCalls: 1,
+			wantErr:         errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newPasswordResetUsecaseMock(ctrl)
+
+			if tt.payload.Token != "" && tt.payload.NewPassword != "" {
+				m.passwordResetRepo.EXPECT().Find(gomock.Any(), tt.payload.Token).Times(1).Return(tt.mockFind, nil)
+			}
+			if tt.mockFind != nil {
+				// a copy, the usecase write the new hash into the user it loaded
+				mockUser := *tt.mockUser
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Times(1).Return(&mockUser, nil)
+			}
+			if tt.mockPolicyErr != nil || tt.wantConsume {
+				m.passwordPolicyUC.EXPECT().Validate(gomock.Any(), gomock.Any(), tt.payload.NewPassword).Times(1).Return(tt.mockPolicyErr)
+			}
+			if tt.wantConsume {
+				m.passwordResetRepo.EXPECT().Consume(gomock.Any(), tt.payload.Token).Times(1).Return(tt.mockConsume, nil)
+			}
+			if tt.wantUpdate {
+				m.userRepo.EXPECT().UpdateByID(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						if err := utils.ComparePassword(user.Password, tt.payload.NewPassword); err != nil {
+							t.Errorf("passwordResetUsecase.ResetPassword() stored a hash that doesn't match the new password")
+						}
+						return nil
+					})
+				m.passwordPolicyUC.EXPECT().RecordPassword(gomock.Any(), userID, gomock.Any()).Times(1).Return(nil)
+			}
+			m.tokenRepo.EXPECT().RevokeAllSessions(gomock.Any(), userID, "").Times(tt.wantRevokeCalls).Return(tt.mockRevokeErr)
+			m.personalAccessTokenRepo.EXPECT().DeleteByUserID(gomock.Any(), userID).Times(tt.wantDeleteCalls).Return(tt.mockDeleteErr)
+			if tt.wantErr == nil {
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			}
+
+			err := uc.ResetPassword(context.TODO(), tt.payload)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("passwordResetUsecase.ResetPassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
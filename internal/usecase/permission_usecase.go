@@ -138,6 +138,56 @@ func (uc *permissionUsecase) FindByName(ctx context.Context, payload *model.Find
 	return permission, nil
 }
 
+func (uc *permissionUsecase) List(ctx context.Context, payload *model.ListPermissionsPayload) (*model.ListPermissionsResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"namePrefix": payload.NamePrefix,
+	})
+
+	currentUserID := getUserIDFromCtx(ctx)
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionPermissionAll,
+			constant.PermissionPermissionRead,
+		},
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	after, err := model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := model.PageSize(payload.PageSize)
+
+	permissions, err := uc.permissionRepo.FindAll(ctx, &model.PermissionFilter{
+		NamePrefix: payload.NamePrefix,
+		After:      after,
+		Limit:      pageSize + 1,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	permissions, nextPageToken := pageOf(permissions, pageSize, func(permission *model.Permission) *model.PageCursor {
+		return &model.PageCursor{Key: permission.Name, ID: permission.ID}
+	})
+	return &model.ListPermissionsResponse{
+		Permissions:   permissions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (uc *permissionUsecase) Update(ctx context.Context, payload *model.UpdatePermissionPayload) (*model.Permission, error) {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
@@ -459,6 +459,79 @@ func Test_permissionUsecase_FindByName(t *testing.T) {
 	}
 }
 
+func Test_permissionUsecase_List(t *testing.T) {
+	userID := utils.GenerateUUID()
+	permissionID := utils.GenerateUUID()
+	cursor := &model.PageCursor{Key: "A", ID: utils.GenerateUUID()}
+	items := []*model.Permission{{ID: permissionID, Name: "AB"}}
+
+	tests := []struct {
+		name          string
+		payload       *model.ListPermissionsPayload
+		mockAccessErr error
+		wantFilter    *model.PermissionFilter
+		mockRes       []*model.Permission
+		mockErr       error
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			payload:    &model.ListPermissionsPayload{NamePrefix: "A", PageSize: 2, PageToken: model.EncodePageToken(cursor)},
+			wantFilter: &model.PermissionFilter{NamePrefix: "A", After: cursor, Limit: 3},
+			mockRes:    items,
+		},
+		{
+			name:          "error unauthorized access",
+			payload:       &model.ListPermissionsPayload{},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListPermissionsPayload{PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:       "error find permissions",
+			payload:    &model.ListPermissionsPayload{},
+			wantFilter: &model.PermissionFilter{Limit: model.DefaultPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, userID)
+
+			permissionRepo := mock.NewMockPermissionRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFilter != nil {
+				permissionRepo.EXPECT().FindAll(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			uc := NewPermissionUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPermissionRepo(permissionRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.List(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("permissionUsecase.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got.Permissions, tt.mockRes) {
+				t.Errorf("permissionUsecase.List() = %v, want %v", got.Permissions, tt.mockRes)
+			}
+		})
+	}
+}
+
 func Test_permissionUsecase_Update(t *testing.T) {
 	var (
 		userID       = utils.GenerateUUID()
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type personalAccessTokenUsecase struct {
+	authUC                  model.AuthUsecase
+	personalAccessTokenRepo model.PersonalAccessTokenRepository
+}
+
+func NewPersonalAccessTokenUsecase() model.PersonalAccessTokenUsecase {
+	return new(personalAccessTokenUsecase)
+}
+
+func (uc *personalAccessTokenUsecase) Create(ctx context.Context, payload *model.CreatePersonalAccessTokenPayload) (*model.CreatedPersonalAccessToken, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": currentUserID,
+		"name":   payload.Name,
+		"scopes": payload.Scopes,
+	})
+
+	if currentUserID == constant.GuestID {
+		return nil, model.ErrUnauthorizeAccess
+	}
+	if payload.Name == "" || len(payload.Scopes) == 0 {
+		return nil, model.ErrInvalidPersonalAccessToken
+	}
+
+	now := time.Now()
+	expiredAt := payload.ExpiredAt
+	if expiredAt.IsZero() {
+		expiredAt = now.Add(config.PersonalAccessTokenDuration())
+	}
+	if !expiredAt.After(now) || expiredAt.After(now.Add(config.PersonalAccessTokenMaxDuration())) {
+		return nil, model.ErrInvalidPersonalAccessToken
+	}
+
+	// a token can only be granted permissions its owner already have
+	for _, scope := range payload.Scopes {
+		err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+			UserID: currentUserID,
+			Permissions: []string{
+				constant.PermissionFullAccess,
+				scope,
+			},
+		})
+		if err != nil {
+			logger.WithField("scope", scope).Error(err.Error())
+			return nil, err
+		}
+	}
+
+	token, err := utils.GenerateSecret(model.PersonalAccessTokenPrefix)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	personalAccessToken := &model.PersonalAccessToken{
+		ID:        utils.GenerateUUID(),
+		UserID:    currentUserID,
+		Name:      payload.Name,
+		TokenHash: utils.HashSecret(token),
+		Scopes:    payload.Scopes,
+		ExpiredAt: expiredAt,
+		CreatedAt: now,
+	}
+
+	err = uc.personalAccessTokenRepo.Create(ctx, personalAccessToken)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &model.CreatedPersonalAccessToken{
+		PersonalAccessToken: personalAccessToken,
+		Token:               token,
+	}, nil
+}
+
+func (uc *personalAccessTokenUsecase) FindByCurrentUser(ctx context.Context) (model.PersonalAccessTokens, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": currentUserID,
+	})
+
+	if currentUserID == constant.GuestID {
+		return nil, model.ErrUnauthorizeAccess
+	}
+
+	personalAccessTokens, err := uc.personalAccessTokenRepo.FindByUserID(ctx, currentUserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return personalAccessTokens, nil
+}
+
+func (uc *personalAccessTokenUsecase) Revoke(ctx context.Context, payload *model.RevokePersonalAccessTokenPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": currentUserID,
+		"id":     payload.ID,
+	})
+
+	if currentUserID == constant.GuestID {
+		return model.ErrUnauthorizeAccess
+	}
+
+	personalAccessToken, err := uc.personalAccessTokenRepo.FindByUserIDAndID(ctx, currentUserID, payload.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if personalAccessToken == nil {
+		return model.ErrPersonalAccessTokenNotFound
+	}
+
+	err = uc.personalAccessTokenRepo.Delete(ctx, personalAccessToken)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *personalAccessTokenUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
+func (uc *personalAccessTokenUsecase) InjectPersonalAccessTokenRepo(repo model.PersonalAccessTokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid personal access token repo")
+	}
+	uc.personalAccessTokenRepo = repo
+	return nil
+}
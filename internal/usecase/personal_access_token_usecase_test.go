@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func Test_personalAccessTokenUsecase_Create(t *testing.T) {
+	var (
+		userID = utils.GenerateUUID()
+	)
+	type mockHasAccess struct {
+		err error
+	}
+	type mockCreate struct {
+		err error
+	}
+	type args struct {
+		userID  string
+		payload *model.CreatePersonalAccessTokenPayload
+	}
+	tests := []struct {
+		name          string
+		args          args
+		mockHasAccess *mockHasAccess
+		mockCreate    *mockCreate
+		wantErr       error
+	}{
+		{
+			name: "success",
+			args: args{
+				userID: userID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name:   "ci",
+					Scopes: []string{constant.PermissionGroupRead},
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockCreate: &mockCreate{
+				err: nil,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error guest",
+			args: args{
+				userID: constant.GuestID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name:   "ci",
+					Scopes: []string{constant.PermissionGroupRead},
+				},
+			},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name: "error without scopes",
+			args: args{
+				userID: userID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name: "ci",
+				},
+			},
+			wantErr: model.ErrInvalidPersonalAccessToken,
+		},
+		{
+			name: "error expiry in the past",
+			args: args{
+				userID: userID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name:      "ci",
+					Scopes:    []string{constant.PermissionGroupRead},
+					ExpiredAt: time.Now().Add(-time.Hour),
+				},
+			},
+			wantErr: model.ErrInvalidPersonalAccessToken,
+		},
+		{
+			name: "error expiry over max duration",
+			args: args{
+				userID: userID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name:      "ci",
+					Scopes:    []string{constant.PermissionGroupRead},
+					ExpiredAt: time.Now().Add(2 * 365 * 24 * time.Hour),
+				},
+			},
+			wantErr: model.ErrInvalidPersonalAccessToken,
+		},
+		{
+			name: "error scope not owned",
+			args: args{
+				userID: userID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name:   "ci",
+					Scopes: []string{constant.PermissionGroupDelete},
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: model.ErrUnauthorizeAccess,
+			},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name: "error db",
+			args: args{
+				userID: userID,
+				payload: &model.CreatePersonalAccessTokenPayload{
+					Name:   "ci",
+					Scopes: []string{constant.PermissionGroupRead},
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockCreate: &mockCreate{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.TODO()
+			ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.args.userID)
+
+			personalAccessTokenRepo := mock.NewMockPersonalAccessTokenRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			if tt.mockHasAccess != nil {
+				authUsecase.EXPECT().HasAccess(gomock.Any(), &model.HasAccessPayload{
+					UserID:      tt.args.userID,
+					Permissions: []string{constant.PermissionFullAccess, tt.args.payload.Scopes[0]},
+				}).Times(1).Return(tt.mockHasAccess.err)
+			}
+
+			if tt.mockCreate != nil {
+				personalAccessTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(tt.mockCreate.err)
+			}
+
+			uc := NewPersonalAccessTokenUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.Create(ctx, tt.args.payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("personalAccessTokenUsecase.Create() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("personalAccessTokenUsecase.Create() unexpected error = %v", err)
+				return
+			}
+			if !model.IsPersonalAccessToken(got.Token) || got.TokenHash != utils.HashSecret(got.Token) || got.UserID != tt.args.userID {
+				t.Errorf("personalAccessTokenUsecase.Create() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_personalAccessTokenUsecase_Revoke(t *testing.T) {
+	var (
+		userID = utils.GenerateUUID()
+		token  = &model.PersonalAccessToken{
+			ID:     utils.GenerateUUID(),
+			UserID: userID,
+		}
+	)
+	type mockFind struct {
+		res *model.PersonalAccessToken
+		err error
+	}
+	type mockDelete struct {
+		err error
+	}
+	tests := []struct {
+		name       string
+		userID     string
+		mockFind   *mockFind
+		mockDelete *mockDelete
+		wantErr    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			mockFind: &mockFind{
+				res: token,
+			},
+			mockDelete: &mockDelete{
+				err: nil,
+			},
+		},
+		{
+			name:    "error guest",
+			userID:  constant.GuestID,
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name:   "error not found",
+			userID: userID,
+			mockFind: &mockFind{
+				res: nil,
+			},
+			wantErr: model.ErrPersonalAccessTokenNotFound,
+		},
+		{
+			name:   "error delete",
+			userID: userID,
+			mockFind: &mockFind{
+				res: token,
+			},
+			mockDelete: &mockDelete{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.TODO()
+			ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.userID)
+
+			personalAccessTokenRepo := mock.NewMockPersonalAccessTokenRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			if tt.mockFind != nil {
+				personalAccessTokenRepo.EXPECT().FindByUserIDAndID(gomock.Any(), tt.userID, token.ID).
+					Times(1).
+					Return(tt.mockFind.res, tt.mockFind.err)
+			}
+			if tt.mockDelete != nil {
+				personalAccessTokenRepo.EXPECT().Delete(gomock.Any(), token).
+					Times(1).
+					Return(tt.mockDelete.err)
+			}
+
+			uc := NewPersonalAccessTokenUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+			utils.ContinueOrFatal(err)
+
+			err = uc.Revoke(ctx, &model.RevokePersonalAccessTokenPayload{ID: token.ID})
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("personalAccessTokenUsecase.Revoke() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type rateLimitUsecase struct {
+	rateLimitRepo model.RateLimitRepository
+
+	// addressRules are counted before the caller is authenticated, callerRules once it is resolved
+	addressRules map[string]*model.RateLimitRule
+	callerRules  map[string]*model.RateLimitRule
+}
+
+// NewRateLimitUsecase load the rules from the config, the invalid ones are skipped.
+func NewRateLimitUsecase() model.RateLimitUsecase {
+	uc := &rateLimitUsecase{
+		addressRules: make(map[string]*model.RateLimitRule),
+		callerRules:  make(map[string]*model.RateLimitRule),
+	}
+	for _, cfg := range config.RateLimitRules() {
+		rule, err := newRateLimitRule(cfg)
+		if err != nil {
+			logrus.WithField("method", cfg.Method).Warn(err.Error())
+			continue
+		}
+		if rule.Key == model.RateLimitKeyIP {
+			uc.addressRules[rule.Method] = rule
+			continue
+		}
+		uc.callerRules[rule.Method] = rule
+	}
+	return uc
+}
+
+func newRateLimitRule(cfg config.RateLimitRule) (*model.RateLimitRule, error) {
+	if cfg.Method == "" || cfg.Requests <= 0 || cfg.Period <= 0 {
+		return nil, errors.New("invalid rate limit rule, method, requests and period are required")
+	}
+
+	rule := &model.RateLimitRule{
+		Method: cfg.Method,
+		Key:    model.RateLimitKey(cfg.Key),
+		RateLimit: model.RateLimit{
+			Requests: cfg.Requests,
+			Period:   cfg.Period,
+			Burst:    cfg.Burst,
+		},
+	}
+	switch rule.Key {
+	case model.RateLimitKeyIP, model.RateLimitKeyUser, model.RateLimitKeyClient:
+	case "":
+		rule.Key = model.RateLimitKeyIP
+	default:
+		return nil, fmt.Errorf("invalid rate limit key %q", cfg.Key)
+	}
+	if rule.Burst <= 0 {
+		rule.Burst = rule.Requests
+	}
+
+	return rule, nil
+}
+
+func (uc *rateLimitUsecase) AllowAddress(ctx context.Context, method string) (*model.RateLimitResult, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return uc.take(ctx, uc.addressRules, method)
+}
+
+func (uc *rateLimitUsecase) Allow(ctx context.Context, method string) (*model.RateLimitResult, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return uc.take(ctx, uc.callerRules, method)
+}
+
+// take count the call in the bucket of the method rule then in the one of the rule for every rpc,
+// the first refusal is returned.
+func (uc *rateLimitUsecase) take(ctx context.Context, rules map[string]*model.RateLimitRule, method string) (*model.RateLimitResult, error) {
+	for _, ruleMethod := range []string{method, model.RateLimitAnyMethod} {
+		rule, ok := rules[ruleMethod]
+		if !ok {
+			continue
+		}
+
+		bucket := fmt.Sprintf("%s:%s", rule.Method, rateLimitSubject(ctx, rule.Key))
+		res, err := uc.rateLimitRepo.Take(ctx, bucket, &rule.RateLimit)
+		if err != nil {
+			logrus.WithField("bucket", bucket).Error(err.Error())
+			return nil, err
+		}
+		if !res.Allowed {
+			return res, nil
+		}
+	}
+
+	return &model.RateLimitResult{Allowed: true}, nil
+}
+
+// rateLimitSubject identify the caller a bucket is counted for from its verified token, callers without
+// an OAuth client, like service accounts, are their own client. Guests are counted by address.
+func rateLimitSubject(ctx context.Context, key model.RateLimitKey) string {
+	userID := getUserIDFromCtx(ctx)
+	switch key {
+	case model.RateLimitKeyUser:
+		if userID != constant.GuestID {
+			return fmt.Sprintf("user:%s", userID)
+		}
+	case model.RateLimitKeyClient:
+		if clientID := getClientIDFromCtx(ctx); clientID != "" {
+			return fmt.Sprintf("client:%s", clientID)
+		}
+		if userID != constant.GuestID {
+			return fmt.Sprintf("client:%s", userID)
+		}
+	}
+	return fmt.Sprintf("ip:%s", getSessionMetadataFromCtx(ctx).IPAddress)
+}
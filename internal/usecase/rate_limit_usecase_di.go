@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *rateLimitUsecase) InjectRateLimitRepo(repo model.RateLimitRepository) error {
+	if repo == nil {
+		return errors.New("invalid rate limit repo")
+	}
+	uc.rateLimitRepo = repo
+	return nil
+}
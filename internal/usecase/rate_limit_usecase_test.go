@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
+)
+
+func Test_rateLimitUsecase_Allow(t *testing.T) {
+	viper.Set("rate_limit.rules", []map[string]interface{}{
+		{"method": "*", "key": "ip", "requests": 600, "period": "1m"},
+		{"method": "/pb.auth.AuthService/Login", "requests": 10, "period": "1m", "burst": 20},
+		{"method": "/pb.auth.AuthService/RefreshToken", "key": "user", "requests": 60, "period": "1m"},
+		{"method": "/pb.auth.AuthService/HasAccess", "key": "client", "requests": 100, "period": "1s"},
+		{"method": "/pb.auth.AuthService/Register", "key": "session", "requests": 5, "period": "1m"},
+		{"method": "/pb.auth.AuthService/GetJWKS", "key": "user", "requests": 5},
+	})
+	t.Cleanup(func() {
+		viper.Set("rate_limit", nil)
+	})
+	var (
+		userID   = utils.GenerateUUID()
+		clientID = model.OAuthClientIDPrefix + utils.GenerateUUID()
+		anyLimit = &model.RateLimit{Requests: 600, Period: time.Minute, Burst: 600}
+	)
+
+	type mockTake struct {
+		bucket string
+		limit  *model.RateLimit
+		res    *model.RateLimitResult
+		err    error
+	}
+	tests := []struct {
+		name       string
+		address    bool
+		method     string
+		userID     string
+		clientID   string
+		clientName string
+		mockTakes  []mockTake
+		want       *model.RateLimitResult
+		wantErr    bool
+	}{
+		{
+			name:    "success address counted by method and every rpc rule",
+			address: true,
+			method:  "/pb.auth.AuthService/Login",
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/Login:ip:10.0.0.1",
+					limit:  &model.RateLimit{Requests: 10, Period: time.Minute, Burst: 20},
+					res:    &model.RateLimitResult{Allowed: true},
+				},
+				{
+					bucket: "*:ip:10.0.0.1",
+					limit:  anyLimit,
+					res:    &model.RateLimitResult{Allowed: true},
+				},
+			},
+			want: &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:    "rejected address by method rule",
+			address: true,
+			method:  "/pb.auth.AuthService/Login",
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/Login:ip:10.0.0.1",
+					limit:  &model.RateLimit{Requests: 10, Period: time.Minute, Burst: 20},
+					res:    &model.RateLimitResult{RetryAfter: time.Second},
+				},
+			},
+			want: &model.RateLimitResult{RetryAfter: time.Second},
+		},
+		{
+			name:    "rejected address by every rpc rule",
+			address: true,
+			method:  "/pb.auth.AuthService/GetUserInfo",
+			mockTakes: []mockTake{
+				{
+					bucket: "*:ip:10.0.0.1",
+					limit:  anyLimit,
+					res:    &model.RateLimitResult{RetryAfter: time.Second},
+				},
+			},
+			want: &model.RateLimitResult{RetryAfter: time.Second},
+		},
+		{
+			name:    "error address take",
+			address: true,
+			method:  "/pb.auth.AuthService/Login",
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/Login:ip:10.0.0.1",
+					limit:  &model.RateLimit{Requests: 10, Period: time.Minute, Burst: 20},
+					err:    errors.New("redis error"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:   "success address rule not counted for the caller",
+			method: "/pb.auth.AuthService/Login",
+			want:   &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:   "success counted by user",
+			method: "/pb.auth.AuthService/RefreshToken",
+			userID: userID,
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/RefreshToken:user:" + userID,
+					limit:  &model.RateLimit{Requests: 60, Period: time.Minute, Burst: 60},
+					res:    &model.RateLimitResult{Allowed: true},
+				},
+			},
+			want: &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:   "success guest counted by address",
+			method: "/pb.auth.AuthService/RefreshToken",
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/RefreshToken:ip:10.0.0.1",
+					limit:  &model.RateLimit{Requests: 60, Period: time.Minute, Burst: 60},
+					res:    &model.RateLimitResult{Allowed: true},
+				},
+			},
+			want: &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:     "rejected counted by oauth client",
+			method:   "/pb.auth.AuthService/HasAccess",
+			userID:   userID,
+			clientID: clientID,
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/HasAccess:client:" + clientID,
+					limit:  &model.RateLimit{Requests: 100, Period: time.Second, Burst: 100},
+					res:    &model.RateLimitResult{RetryAfter: 10 * time.Millisecond},
+				},
+			},
+			want: &model.RateLimitResult{RetryAfter: 10 * time.Millisecond},
+		},
+		{
+			name:       "success service account is its own client",
+			method:     "/pb.auth.AuthService/HasAccess",
+			userID:     userID,
+			clientName: "dashboard",
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/HasAccess:client:" + userID,
+					limit:  &model.RateLimit{Requests: 100, Period: time.Second, Burst: 100},
+					res:    &model.RateLimitResult{Allowed: true},
+				},
+			},
+			want: &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:       "success guest client name ignored",
+			method:     "/pb.auth.AuthService/HasAccess",
+			clientName: "dashboard",
+			mockTakes: []mockTake{
+				{
+					bucket: "/pb.auth.AuthService/HasAccess:ip:10.0.0.1",
+					limit:  &model.RateLimit{Requests: 100, Period: time.Second, Burst: 100},
+					res:    &model.RateLimitResult{Allowed: true},
+				},
+			},
+			want: &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:   "success method without rule",
+			method: "/pb.auth.AuthService/GetUserInfo",
+			want:   &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:   "success invalid key skipped",
+			method: "/pb.auth.AuthService/Register",
+			want:   &model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:   "success missing period skipped",
+			method: "/pb.auth.AuthService/GetJWKS",
+			want:   &model.RateLimitResult{Allowed: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rateLimitRepo := mock.NewMockRateLimitRepository(ctrl)
+			uc := NewRateLimitUsecase()
+			err := uc.InjectRateLimitRepo(rateLimitRepo)
+			utils.ContinueOrFatal(err)
+
+			ctx := context.WithValue(context.TODO(), constant.KeySessionMetadataCtx, &model.SessionMetadata{
+				IPAddress:  "10.0.0.1",
+				ClientName: tt.clientName,
+			})
+			if tt.userID != "" {
+				ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.userID)
+			}
+			if tt.clientID != "" {
+				ctx = context.WithValue(ctx, constant.KeyClientIDCtx, tt.clientID)
+			}
+
+			for _, take := range tt.mockTakes {
+				rateLimitRepo.EXPECT().Take(gomock.Any(), take.bucket, take.limit).Times(1).Return(take.res, take.err)
+			}
+
+			allow := uc.Allow
+			if tt.address {
+				allow = uc.AllowAddress
+			}
+			got, err := allow(ctx, tt.method)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("rateLimitUsecase.Allow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil && *got != *tt.want {
+				t.Errorf("rateLimitUsecase.Allow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type serviceAccountUsecase struct {
+	authUC                  model.AuthUsecase
+	serviceAccountRepo      model.ServiceAccountRepository
+	serviceAccountGroupRepo model.ServiceAccountGroupRepository
+	groupRepo               model.GroupRepository
+	groupPermissionRepo     model.GroupPermissionRepository
+	tokenRepo               model.TokenRepository
+}
+
+func NewServiceAccountUsecase() model.ServiceAccountUsecase {
+	return new(serviceAccountUsecase)
+}
+
+func (uc *serviceAccountUsecase) Create(ctx context.Context, payload *model.CreateServiceAccountPayload) (*model.CreatedServiceAccount, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"name": payload.Name,
+	})
+
+	err := uc.hasServiceAccountAccess(ctx, constant.PermissionServiceAccountCreate)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	if payload.Name == "" {
+		return nil, model.ErrInvalidServiceAccount
+	}
+
+	clientSecret, err := utils.GenerateSecret(model.ServiceAccountClientSecretPrefix)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	now := time.Now()
+	serviceAccount := &model.ServiceAccount{
+		ID:               utils.GenerateUUID(),
+		Name:             payload.Name,
+		ClientID:         model.ServiceAccountClientIDPrefix + utils.GenerateUUID(),
+		ClientSecretHash: utils.HashSecret(clientSecret),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	err = uc.serviceAccountRepo.Create(ctx, serviceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &model.CreatedServiceAccount{
+		ServiceAccount: serviceAccount,
+		ClientSecret:   clientSecret,
+	}, nil
+}
+
+func (uc *serviceAccountUsecase) FindByID(ctx context.Context, payload *model.FindServiceAccountByIDPayload) (*model.ServiceAccount, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id": payload.ID,
+	})
+
+	err := uc.hasServiceAccountAccess(ctx, constant.PermissionServiceAccountRead)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	serviceAccount, err := uc.serviceAccountRepo.FindByID(ctx, payload.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if serviceAccount == nil {
+		return nil, model.ErrServiceAccountNotFound
+	}
+
+	return serviceAccount, nil
+}
+
+func (uc *serviceAccountUsecase) Delete(ctx context.Context, payload *model.DeleteServiceAccountPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"id": payload.ID,
+	})
+
+	err := uc.hasServiceAccountAccess(ctx, constant.PermissionServiceAccountDelete)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	serviceAccount, err := uc.serviceAccountRepo.FindByID(ctx, payload.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if serviceAccount == nil {
+		return model.ErrServiceAccountNotFound
+	}
+
+	err = uc.serviceAccountRepo.Delete(ctx, serviceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = uc.tokenRepo.RevokeAllSessions(ctx, serviceAccount.ID, "")
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ClientCredentials exchange the service account credentials for an access token.
+// No refresh token is issued, the client authenticate again when the token expire.
+func (uc *serviceAccountUsecase) ClientCredentials(ctx context.Context, payload *model.ClientCredentialsPayload) (*model.AuthResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"clientID": payload.ClientID,
+	})
+
+	serviceAccount, err := uc.serviceAccountRepo.FindByClientID(ctx, payload.ClientID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if serviceAccount == nil || !utils.CompareSecret(serviceAccount.ClientSecretHash, payload.ClientSecret) {
+		return nil, model.ErrInvalidClientCredentials
+	}
+
+	// the token open its own session so it can be listed and revoked
+	tokenID := utils.GenerateUUID()
+	metadata := *getSessionMetadataFromCtx(ctx)
+	metadata.ClientName = serviceAccount.Name
+	accessToken, err := uc.tokenRepo.Create(ctx, serviceAccount.ID, tokenID, tokenID, model.AccessToken, &metadata)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.WithFields(sessionMetadataLogFields(&metadata)).Info("service account token issued")
+
+	return &model.AuthResponse{
+		AccessToken: accessToken,
+	}, nil
+}
+
+func (uc *serviceAccountUsecase) AddGroup(ctx context.Context, payload *model.ServiceAccountGroupPayload) (*model.ServiceAccountGroup, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": payload.ServiceAccountID,
+		"groupID":          payload.GroupID,
+	})
+
+	err := uc.hasServiceAccountAccess(ctx, constant.PermissionServiceAccountCreate)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	// adding a group is a membership grant, it is gated like adding a user to a group
+	err = uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: getUserIDFromCtx(ctx),
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserGroupAll,
+			constant.PermissionUserGroupCreate,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	serviceAccount, err := uc.serviceAccountRepo.FindByID(ctx, payload.ServiceAccountID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if serviceAccount == nil {
+		return nil, model.ErrServiceAccountNotFound
+	}
+
+	group, err := uc.groupRepo.FindByID(ctx, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if group == nil {
+		return nil, model.ErrGroupNotFound
+	}
+
+	err = uc.holdGroupPermissions(ctx, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	serviceAccountGroup, err := uc.serviceAccountGroupRepo.FindByServiceAccountIDAndGroupID(ctx, payload.ServiceAccountID, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if serviceAccountGroup != nil {
+		return nil, model.ErrServiceAccountGroupAlreadyExist
+	}
+
+	data := &model.ServiceAccountGroup{
+		ServiceAccountID: payload.ServiceAccountID,
+		GroupID:          payload.GroupID,
+	}
+
+	err = uc.serviceAccountGroupRepo.Create(ctx, data)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (uc *serviceAccountUsecase) RemoveGroup(ctx context.Context, payload *model.ServiceAccountGroupPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": payload.ServiceAccountID,
+		"groupID":          payload.GroupID,
+	})
+
+	err := uc.hasServiceAccountAccess(ctx, constant.PermissionServiceAccountDelete)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	serviceAccountGroup, err := uc.serviceAccountGroupRepo.FindByServiceAccountIDAndGroupID(ctx, payload.ServiceAccountID, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if serviceAccountGroup == nil {
+		return model.ErrServiceAccountGroupNotFound
+	}
+
+	err = uc.serviceAccountGroupRepo.DeleteByServiceAccountIDAndGroupID(ctx, payload.ServiceAccountID, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (uc *serviceAccountUsecase) FindGroups(ctx context.Context, payload *model.FindServiceAccountGroupsPayload) (model.ServiceAccountGroups, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"serviceAccountID": payload.ServiceAccountID,
+	})
+
+	err := uc.hasServiceAccountAccess(ctx, constant.PermissionServiceAccountRead)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	serviceAccountGroups, err := uc.serviceAccountGroupRepo.FindByServiceAccountID(ctx, payload.ServiceAccountID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return serviceAccountGroups, nil
+}
+
+func (uc *serviceAccountUsecase) hasServiceAccountAccess(ctx context.Context, permission string) error {
+	return uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: getUserIDFromCtx(ctx),
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionServiceAccountAll,
+			permission,
+		},
+	})
+}
+
+// holdGroupPermissions refuse a group granting a permission the caller doesn't hold, the service account
+// would otherwise hand the caller those permissions through its client credentials token.
+func (uc *serviceAccountUsecase) holdGroupPermissions(ctx context.Context, groupID string) error {
+	filter := &model.GroupPermissionFilter{GroupID: groupID, Limit: model.MaxPageSize}
+	for {
+		permissions, err := uc.groupPermissionRepo.FindPermissions(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, permission := range permissions {
+			if permission.Name == constant.PermissionAllowGuest {
+				continue
+			}
+			err = uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+				UserID:      getUserIDFromCtx(ctx),
+				Permissions: grantingPermissions(permission.Name),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(permissions) < filter.Limit {
+			return nil
+		}
+		last := permissions[len(permissions)-1]
+		filter.After = &model.PageCursor{Key: last.Name, ID: last.ID}
+	}
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *serviceAccountUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
+func (uc *serviceAccountUsecase) InjectServiceAccountRepo(repo model.ServiceAccountRepository) error {
+	if repo == nil {
+		return errors.New("invalid service account repo")
+	}
+	uc.serviceAccountRepo = repo
+	return nil
+}
+
+func (uc *serviceAccountUsecase) InjectServiceAccountGroupRepo(repo model.ServiceAccountGroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid service account group repo")
+	}
+	uc.serviceAccountGroupRepo = repo
+	return nil
+}
+
+func (uc *serviceAccountUsecase) InjectGroupRepo(repo model.GroupRepository) error {
+	if repo == nil {
+		return errors.New("invalid group repo")
+	}
+	uc.groupRepo = repo
+	return nil
+}
+
+func (uc *serviceAccountUsecase) InjectGroupPermissionRepo(repo model.GroupPermissionRepository) error {
+	if repo == nil {
+		return errors.New("invalid group permission repo")
+	}
+	uc.groupPermissionRepo = repo
+	return nil
+}
+
+func (uc *serviceAccountUsecase) InjectTokenRepo(repo model.TokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid token repo")
+	}
+	uc.tokenRepo = repo
+	return nil
+}
@@ -0,0 +1,440 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func newServiceAccountUsecaseMock(ctrl *gomock.Controller) (model.ServiceAccountUsecase, *mock.MockAuthUsecase, *mock.MockServiceAccountRepository, *mock.MockServiceAccountGroupRepository, *mock.MockGroupRepository, *mock.MockTokenRepository) {
+	authUsecase := mock.NewMockAuthUsecase(ctrl)
+	serviceAccountRepo := mock.NewMockServiceAccountRepository(ctrl)
+	serviceAccountGroupRepo := mock.NewMockServiceAccountGroupRepository(ctrl)
+	groupRepo := mock.NewMockGroupRepository(ctrl)
+	tokenRepo := mock.NewMockTokenRepository(ctrl)
+
+	uc := NewServiceAccountUsecase()
+	err := uc.InjectAuthUsecase(authUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectServiceAccountRepo(serviceAccountRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectServiceAccountGroupRepo(serviceAccountGroupRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectGroupRepo(groupRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectTokenRepo(tokenRepo)
+	utils.ContinueOrFatal(err)
+
+	return uc, authUsecase, serviceAccountRepo, serviceAccountGroupRepo, groupRepo, tokenRepo
+}
+
+func Test_serviceAccountUsecase_Create(t *testing.T) {
+	type mockHasAccess struct {
+		err error
+	}
+	type mockCreate struct {
+		err error
+	}
+	tests := []struct {
+		name          string
+		payload       *model.CreateServiceAccountPayload
+		mockHasAccess *mockHasAccess
+		mockCreate    *mockCreate
+		wantErr       error
+	}{
+		{
+			name: "success",
+			payload: &model.CreateServiceAccountPayload{
+				Name: "billing-service",
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockCreate: &mockCreate{
+				err: nil,
+			},
+		},
+		{
+			name: "error unauthorized access",
+			payload: &model.CreateServiceAccountPayload{
+				Name: "billing-service",
+			},
+			mockHasAccess: &mockHasAccess{
+				err: model.ErrUnauthorizeAccess,
+			},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name: "error empty name",
+			payload: &model.CreateServiceAccountPayload{
+				Name: "",
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			wantErr: model.ErrInvalidServiceAccount,
+		},
+		{
+			name: "error db",
+			payload: &model.CreateServiceAccountPayload{
+				Name: "billing-service",
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockCreate: &mockCreate{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, utils.GenerateUUID())
+			uc, authUsecase, serviceAccountRepo, _, _, _ := newServiceAccountUsecaseMock(ctrl)
+
+			if tt.mockHasAccess != nil {
+				authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockHasAccess.err)
+			}
+			if tt.mockCreate != nil {
+				serviceAccountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockCreate.err)
+			}
+
+			got, err := uc.Create(ctx, tt.payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("serviceAccountUsecase.Create() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("serviceAccountUsecase.Create() unexpected error = %v", err)
+				return
+			}
+			if !utils.CompareSecret(got.ClientSecretHash, got.ClientSecret) || got.ClientID == "" {
+				t.Errorf("serviceAccountUsecase.Create() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_serviceAccountUsecase_ClientCredentials(t *testing.T) {
+	var (
+		clientSecret   = model.ServiceAccountClientSecretPrefix + "secret"
+		serviceAccount = &model.ServiceAccount{
+			ID:               utils.GenerateUUID(),
+			Name:             "billing-service",
+			ClientID:         model.ServiceAccountClientIDPrefix + utils.GenerateUUID(),
+			ClientSecretHash: utils.HashSecret(clientSecret),
+		}
+	)
+	type mockFindByClientID struct {
+		res *model.ServiceAccount
+		err error
+	}
+	type mockCreateToken struct {
+		err error
+	}
+	tests := []struct {
+		name               string
+		payload            *model.ClientCredentialsPayload
+		mockFindByClientID *mockFindByClientID
+		mockCreateToken    *mockCreateToken
+		wantErr            error
+	}{
+		{
+			name: "success",
+			payload: &model.ClientCredentialsPayload{
+				ClientID:     serviceAccount.ClientID,
+				ClientSecret: clientSecret,
+			},
+			mockFindByClientID: &mockFindByClientID{
+				res: serviceAccount,
+			},
+			mockCreateToken: &mockCreateToken{
+				err: nil,
+			},
+		},
+		{
+			name: "error unknown client",
+			payload: &model.ClientCredentialsPayload{
+				ClientID:     "unknown",
+				ClientSecret: clientSecret,
+			},
+			mockFindByClientID: &mockFindByClientID{
+				res: nil,
+			},
+			wantErr: model.ErrInvalidClientCredentials,
+		},
+		{
+			name: "error wrong secret",
+			payload: &model.ClientCredentialsPayload{
+				ClientID:     serviceAccount.ClientID,
+				ClientSecret: "wrong",
+			},
+			mockFindByClientID: &mockFindByClientID{
+				res: serviceAccount,
+			},
+			wantErr: model.ErrInvalidClientCredentials,
+		},
+		{
+			name: "error redis",
+			payload: &model.ClientCredentialsPayload{
+				ClientID:     serviceAccount.ClientID,
+				ClientSecret: clientSecret,
+			},
+			mockFindByClientID: &mockFindByClientID{
+				res: serviceAccount,
+			},
+			mockCreateToken: &mockCreateToken{
+				err: errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, _, serviceAccountRepo, _, _, tokenRepo := newServiceAccountUsecaseMock(ctrl)
+
+			if tt.mockFindByClientID != nil {
+				serviceAccountRepo.EXPECT().FindByClientID(gomock.Any(), tt.payload.ClientID).
+					Times(1).
+					Return(tt.mockFindByClientID.res, tt.mockFindByClientID.err)
+			}
+			if tt.mockCreateToken != nil {
+				tokenRepo.EXPECT().Create(gomock.Any(), serviceAccount.ID, gomock.Any(), gomock.Any(), model.AccessToken, gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, userID string, familyID string, tokenID string, tokenType model.TokenType, metadata *model.SessionMetadata) (string, error) {
+						if familyID != tokenID || metadata.ClientName != serviceAccount.Name {
+							t.Errorf("unexpected session familyID = %v, tokenID = %v, metadata = %+v", familyID, tokenID, metadata)
+						}
+						return "access-token", tt.mockCreateToken.err
+					})
+			}
+
+			got, err := uc.ClientCredentials(context.TODO(), tt.payload)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("serviceAccountUsecase.ClientCredentials() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("serviceAccountUsecase.ClientCredentials() unexpected error = %v", err)
+				return
+			}
+			if got.AccessToken != "access-token" || got.RefreshToken != "" {
+				t.Errorf("serviceAccountUsecase.ClientCredentials() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_serviceAccountUsecase_Delete(t *testing.T) {
+	var (
+		serviceAccount = &model.ServiceAccount{
+			ID:       utils.GenerateUUID(),
+			ClientID: model.ServiceAccountClientIDPrefix + utils.GenerateUUID(),
+		}
+	)
+	type mockFindByID struct {
+		res *model.ServiceAccount
+		err error
+	}
+	tests := []struct {
+		name          string
+		mockFindByID  *mockFindByID
+		mockDelete    bool
+		mockRevokeAll bool
+		wantErr       error
+	}{
+		{
+			name: "success",
+			mockFindByID: &mockFindByID{
+				res: serviceAccount,
+			},
+			mockDelete:    true,
+			mockRevokeAll: true,
+		},
+		{
+			name: "error not found",
+			mockFindByID: &mockFindByID{
+				res: nil,
+			},
+			wantErr: model.ErrServiceAccountNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, utils.GenerateUUID())
+			uc, authUsecase, serviceAccountRepo, _, _, tokenRepo := newServiceAccountUsecaseMock(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			serviceAccountRepo.EXPECT().FindByID(gomock.Any(), serviceAccount.ID).
+				Times(1).
+				Return(tt.mockFindByID.res, tt.mockFindByID.err)
+			if tt.mockDelete {
+				serviceAccountRepo.EXPECT().Delete(gomock.Any(), serviceAccount).Times(1).Return(nil)
+			}
+			if tt.mockRevokeAll {
+				tokenRepo.EXPECT().RevokeAllSessions(gomock.Any(), serviceAccount.ID, "").Times(1).Return(nil)
+			}
+
+			err := uc.Delete(ctx, &model.DeleteServiceAccountPayload{ID: serviceAccount.ID})
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("serviceAccountUsecase.Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_serviceAccountUsecase_AddGroup(t *testing.T) {
+	var (
+		serviceAccountID  = utils.GenerateUUID()
+		groupID           = utils.GenerateUUID()
+		callerPermissions = []string{
+			constant.PermissionServiceAccountCreate,
+			constant.PermissionUserGroupCreate,
+			constant.PermissionGroupRead,
+		}
+	)
+	type mockFindGroup struct {
+		res *model.Group
+	}
+	type mockFindServiceAccountGroup struct {
+		res *model.ServiceAccountGroup
+	}
+	tests := []struct {
+		name                        string
+		callerPermissions           []string
+		mockFindGroup               *mockFindGroup
+		mockGroupPermissions        []*model.Permission
+		mockFindServiceAccountGroup *mockFindServiceAccountGroup
+		mockCreate                  bool
+		wantErr                     error
+	}{
+		{
+			name:              "success",
+			callerPermissions: callerPermissions,
+			mockFindGroup: &mockFindGroup{
+				res: &model.Group{ID: groupID},
+			},
+			mockGroupPermissions: []*model.Permission{
+				{ID: utils.GenerateUUID(), Name: constant.PermissionAllowGuest},
+				{ID: utils.GenerateUUID(), Name: constant.PermissionGroupRead},
+			},
+			mockFindServiceAccountGroup: &mockFindServiceAccountGroup{
+				res: nil,
+			},
+			mockCreate: true,
+		},
+		{
+			name:              "error caller can only create service accounts",
+			callerPermissions: []string{constant.PermissionServiceAccountCreate},
+			wantErr:           model.ErrUnauthorizeAccess,
+		},
+		{
+			name:              "error group grant a permission the caller doesn't hold",
+			callerPermissions: callerPermissions,
+			mockFindGroup: &mockFindGroup{
+				res: &model.Group{ID: groupID},
+			},
+			mockGroupPermissions: []*model.Permission{
+				{ID: utils.GenerateUUID(), Name: constant.PermissionFullAccess},
+			},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name:              "error group not found",
+			callerPermissions: callerPermissions,
+			mockFindGroup: &mockFindGroup{
+				res: nil,
+			},
+			wantErr: model.ErrGroupNotFound,
+		},
+		{
+			name:              "error already exist",
+			callerPermissions: callerPermissions,
+			mockFindGroup: &mockFindGroup{
+				res: &model.Group{ID: groupID},
+			},
+			mockGroupPermissions: []*model.Permission{},
+			mockFindServiceAccountGroup: &mockFindServiceAccountGroup{
+				res: &model.ServiceAccountGroup{
+					ServiceAccountID: serviceAccountID,
+					GroupID:          groupID,
+				},
+			},
+			wantErr: model.ErrServiceAccountGroupAlreadyExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, utils.GenerateUUID())
+			uc, authUsecase, serviceAccountRepo, serviceAccountGroupRepo, groupRepo, _ := newServiceAccountUsecaseMock(ctrl)
+			groupPermissionRepo := mock.NewMockGroupPermissionRepository(ctrl)
+			err := uc.InjectGroupPermissionRepo(groupPermissionRepo)
+			utils.ContinueOrFatal(err)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).AnyTimes().
+				DoAndReturn(func(_ context.Context, payload *model.HasAccessPayload) error {
+					for _, permission := range payload.Permissions {
+						for _, held := range tt.callerPermissions {
+							if permission == held {
+								return nil
+							}
+						}
+					}
+					return model.ErrUnauthorizeAccess
+				})
+			if tt.mockFindGroup != nil {
+				serviceAccountRepo.EXPECT().FindByID(gomock.Any(), serviceAccountID).
+					Times(1).
+					Return(&model.ServiceAccount{ID: serviceAccountID}, nil)
+				groupRepo.EXPECT().FindByID(gomock.Any(), groupID).Times(1).Return(tt.mockFindGroup.res, nil)
+			}
+			if tt.mockGroupPermissions != nil {
+				groupPermissionRepo.EXPECT().FindPermissions(gomock.Any(), &model.GroupPermissionFilter{GroupID: groupID, Limit: model.MaxPageSize}).
+					Times(1).
+					Return(tt.mockGroupPermissions, nil)
+			}
+			if tt.mockFindServiceAccountGroup != nil {
+				serviceAccountGroupRepo.EXPECT().FindByServiceAccountIDAndGroupID(gomock.Any(), serviceAccountID, groupID).
+					Times(1).
+					Return(tt.mockFindServiceAccountGroup.res, nil)
+			}
+			if tt.mockCreate {
+				serviceAccountGroupRepo.EXPECT().Create(gomock.Any(), &model.ServiceAccountGroup{
+					ServiceAccountID: serviceAccountID,
+					GroupID:          groupID,
+				}).Times(1).Return(nil)
+			}
+
+			_, err = uc.AddGroup(ctx, &model.ServiceAccountGroupPayload{
+				ServiceAccountID: serviceAccountID,
+				GroupID:          groupID,
+			})
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("serviceAccountUsecase.AddGroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
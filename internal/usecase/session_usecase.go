@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type sessionUsecase struct {
+	authUC    model.AuthUsecase
+	tokenRepo model.TokenRepository
+}
+
+func NewSessionUsecase() model.SessionUsecase {
+	return new(sessionUsecase)
+}
+
+func (uc *sessionUsecase) ListSessions(ctx context.Context, payload *model.ListSessionsPayload) (model.Sessions, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID, err := uc.authorizeSessionAccess(ctx, payload.UserID, constant.PermissionSessionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	sessions, err := uc.tokenRepo.ListSessions(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (uc *sessionUsecase) RevokeSession(ctx context.Context, payload *model.RevokeSessionPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID, err := uc.authorizeSessionAccess(ctx, payload.UserID, constant.PermissionSessionDelete)
+	if err != nil {
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID":  userID,
+		"tokenID": payload.TokenID,
+	})
+
+	err = uc.tokenRepo.RevokeSession(ctx, userID, payload.TokenID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (uc *sessionUsecase) RevokeAllSessions(ctx context.Context, payload *model.RevokeAllSessionsPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID, err := uc.authorizeSessionAccess(ctx, payload.UserID, constant.PermissionSessionDelete)
+	if err != nil {
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"userID":        userID,
+		"exceptCurrent": payload.ExceptCurrent,
+	})
+
+	// the current session can only be kept when the caller revoke their own sessions
+	var exceptTokenID string
+	if payload.ExceptCurrent && userID == getUserIDFromCtx(ctx) {
+		exceptTokenID = getTokenIDFromCtx(ctx)
+	}
+
+	err = uc.tokenRepo.RevokeAllSessions(ctx, userID, exceptTokenID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// authorizeSessionAccess resolve the target user, defaulting to the caller.
+// A user can always manage their own sessions, managing others require a session permission.
+func (uc *sessionUsecase) authorizeSessionAccess(ctx context.Context, userID string, permission string) (string, error) {
+	currentUserID := getUserIDFromCtx(ctx)
+	if currentUserID == constant.GuestID {
+		return "", model.ErrUnauthorizeAccess
+	}
+	if userID == "" || userID == currentUserID {
+		return currentUserID, nil
+	}
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionSessionAll,
+			permission,
+		},
+	})
+	if err != nil {
+		logrus.WithField("userID", userID).Error(err.Error())
+		return "", err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *sessionUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
+func (uc *sessionUsecase) InjectTokenRepo(repo model.TokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid token repo")
+	}
+	uc.tokenRepo = repo
+	return nil
+}
@@ -0,0 +1,416 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+func Test_sessionUsecase_ListSessions(t *testing.T) {
+	var (
+		userID      = utils.GenerateUUID()
+		otherUserID = utils.GenerateUUID()
+		sessions    = []*model.Session{
+			{
+				SessionID: utils.GenerateUUID(),
+				TokenID:   utils.GenerateUUID(),
+				ExpiredAt: time.Now().Add(time.Hour),
+			},
+		}
+	)
+	type mockHasAccess struct {
+		err error
+	}
+	type mockListSessions struct {
+		res []*model.Session
+		err error
+	}
+	type args struct {
+		userID  string
+		payload *model.ListSessionsPayload
+	}
+	tests := []struct {
+		name             string
+		args             args
+		mockHasAccess    *mockHasAccess
+		mockListSessions *mockListSessions
+		wantUserID       string
+		want             model.Sessions
+		wantErr          bool
+	}{
+		{
+			name: "success own sessions",
+			args: args{
+				userID: userID,
+				payload: &model.ListSessionsPayload{
+					UserID: "",
+				},
+			},
+			mockListSessions: &mockListSessions{
+				res: sessions,
+				err: nil,
+			},
+			wantUserID: userID,
+			want:       sessions,
+			wantErr:    false,
+		},
+		{
+			name: "success other user sessions",
+			args: args{
+				userID: userID,
+				payload: &model.ListSessionsPayload{
+					UserID: otherUserID,
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockListSessions: &mockListSessions{
+				res: sessions,
+				err: nil,
+			},
+			wantUserID: otherUserID,
+			want:       sessions,
+			wantErr:    false,
+		},
+		{
+			name: "error unauthorized access",
+			args: args{
+				userID: userID,
+				payload: &model.ListSessionsPayload{
+					UserID: otherUserID,
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: model.ErrUnauthorizeAccess,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error guest",
+			args: args{
+				userID: constant.GuestID,
+				payload: &model.ListSessionsPayload{
+					UserID: "",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error list sessions",
+			args: args{
+				userID: userID,
+				payload: &model.ListSessionsPayload{
+					UserID: userID,
+				},
+			},
+			mockListSessions: &mockListSessions{
+				res: nil,
+				err: errors.New("redis error"),
+			},
+			wantUserID: userID,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.TODO()
+			ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.args.userID)
+
+			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			if tt.mockHasAccess != nil {
+				authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockHasAccess.err)
+			}
+
+			if tt.mockListSessions != nil {
+				tokenRepo.EXPECT().ListSessions(gomock.Any(), tt.wantUserID).
+					Times(1).
+					Return(tt.mockListSessions.res, tt.mockListSessions.err)
+			}
+
+			uc := NewSessionUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ListSessions(ctx, tt.args.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("sessionUsecase.ListSessions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sessionUsecase.ListSessions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_sessionUsecase_RevokeSession(t *testing.T) {
+	var (
+		userID      = utils.GenerateUUID()
+		otherUserID = utils.GenerateUUID()
+		tokenID     = utils.GenerateUUID()
+	)
+	type mockHasAccess struct {
+		err error
+	}
+	type mockRevokeSession struct {
+		err error
+	}
+	type args struct {
+		userID  string
+		payload *model.RevokeSessionPayload
+	}
+	tests := []struct {
+		name              string
+		args              args
+		mockHasAccess     *mockHasAccess
+		mockRevokeSession *mockRevokeSession
+		wantUserID        string
+		wantErr           bool
+	}{
+		{
+			name: "success own session",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeSessionPayload{
+					TokenID: tokenID,
+				},
+			},
+			mockRevokeSession: &mockRevokeSession{
+				err: nil,
+			},
+			wantUserID: userID,
+			wantErr:    false,
+		},
+		{
+			name: "success other user session",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeSessionPayload{
+					UserID:  otherUserID,
+					TokenID: tokenID,
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockRevokeSession: &mockRevokeSession{
+				err: nil,
+			},
+			wantUserID: otherUserID,
+			wantErr:    false,
+		},
+		{
+			name: "error unauthorized access",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeSessionPayload{
+					UserID:  otherUserID,
+					TokenID: tokenID,
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: model.ErrUnauthorizeAccess,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error session not found",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeSessionPayload{
+					TokenID: tokenID,
+				},
+			},
+			mockRevokeSession: &mockRevokeSession{
+				err: model.ErrSessionNotFound,
+			},
+			wantUserID: userID,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.TODO()
+			ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.args.userID)
+
+			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			if tt.mockHasAccess != nil {
+				authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockHasAccess.err)
+			}
+
+			if tt.mockRevokeSession != nil {
+				tokenRepo.EXPECT().RevokeSession(gomock.Any(), tt.wantUserID, tokenID).
+					Times(1).
+					Return(tt.mockRevokeSession.err)
+			}
+
+			uc := NewSessionUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+
+			if err := uc.RevokeSession(ctx, tt.args.payload); (err != nil) != tt.wantErr {
+				t.Errorf("sessionUsecase.RevokeSession() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_sessionUsecase_RevokeAllSessions(t *testing.T) {
+	var (
+		userID      = utils.GenerateUUID()
+		otherUserID = utils.GenerateUUID()
+		tokenID     = utils.GenerateUUID()
+	)
+	type mockHasAccess struct {
+		err error
+	}
+	type mockRevokeAllSessions struct {
+		err error
+	}
+	type args struct {
+		userID  string
+		payload *model.RevokeAllSessionsPayload
+	}
+	tests := []struct {
+		name                  string
+		args                  args
+		mockHasAccess         *mockHasAccess
+		mockRevokeAllSessions *mockRevokeAllSessions
+		wantUserID            string
+		wantExceptTokenID     string
+		wantErr               bool
+	}{
+		{
+			name: "success own sessions except current",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeAllSessionsPayload{
+					ExceptCurrent: true,
+				},
+			},
+			mockRevokeAllSessions: &mockRevokeAllSessions{
+				err: nil,
+			},
+			wantUserID:        userID,
+			wantExceptTokenID: tokenID,
+			wantErr:           false,
+		},
+		{
+			name: "success own sessions",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeAllSessionsPayload{
+					ExceptCurrent: false,
+				},
+			},
+			mockRevokeAllSessions: &mockRevokeAllSessions{
+				err: nil,
+			},
+			wantUserID:        userID,
+			wantExceptTokenID: "",
+			wantErr:           false,
+		},
+		{
+			name: "success other user sessions ignore except current",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeAllSessionsPayload{
+					UserID:        otherUserID,
+					ExceptCurrent: true,
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: nil,
+			},
+			mockRevokeAllSessions: &mockRevokeAllSessions{
+				err: nil,
+			},
+			wantUserID:        otherUserID,
+			wantExceptTokenID: "",
+			wantErr:           false,
+		},
+		{
+			name: "error unauthorized access",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeAllSessionsPayload{
+					UserID: otherUserID,
+				},
+			},
+			mockHasAccess: &mockHasAccess{
+				err: model.ErrUnauthorizeAccess,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error revoke sessions",
+			args: args{
+				userID: userID,
+				payload: &model.RevokeAllSessionsPayload{
+					ExceptCurrent: false,
+				},
+			},
+			mockRevokeAllSessions: &mockRevokeAllSessions{
+				err: errors.New("redis error"),
+			},
+			wantUserID: userID,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.TODO()
+			ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.args.userID)
+			ctx = context.WithValue(ctx, constant.KeyTokenIDCtx, tokenID)
+
+			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			if tt.mockHasAccess != nil {
+				authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockHasAccess.err)
+			}
+
+			if tt.mockRevokeAllSessions != nil {
+				tokenRepo.EXPECT().RevokeAllSessions(gomock.Any(), tt.wantUserID, tt.wantExceptTokenID).
+					Times(1).
+					Return(tt.mockRevokeAllSessions.err)
+			}
+
+			uc := NewSessionUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+
+			if err := uc.RevokeAllSessions(ctx, tt.args.payload); (err != nil) != tt.wantErr {
+				t.Errorf("sessionUsecase.RevokeAllSessions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
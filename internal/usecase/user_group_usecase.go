@@ -10,10 +10,11 @@ import (
 )
 
 type userGroupUsecase struct {
-	authUC        model.AuthUsecase
-	userGroupRepo model.UserGroupRepository
-	userRepo      model.UserRepository
-	groupRepo     model.GroupRepository
+	authUC         model.AuthUsecase
+	userGroupRepo  model.UserGroupRepository
+	userRepo       model.UserRepository
+	groupRepo      model.GroupRepository
+	permissionRepo model.PermissionRepository
 }
 
 func NewUserGroupUsecase() model.UserGroupUsecase {
@@ -204,3 +205,131 @@ func (uc *userGroupUsecase) FindByUserID(ctx context.Context, payload *model.Fin
 
 	return userGroups, nil
 }
+
+func (uc *userGroupUsecase) ListGroupMembers(ctx context.Context, payload *model.ListGroupMembersPayload) (*model.ListGroupMembersResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"groupID": payload.GroupID,
+	})
+
+	currentUserID := getUserIDFromCtx(ctx)
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserGroupAll,
+			constant.PermissionUserGroupRead,
+		},
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	after, err := model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := model.PageSize(payload.PageSize)
+
+	group, err := uc.groupRepo.FindByID(ctx, payload.GroupID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if group == nil {
+		return nil, model.ErrGroupNotFound
+	}
+
+	users, err := uc.userGroupRepo.FindMembers(ctx, &model.GroupMemberFilter{
+		GroupID: payload.GroupID,
+		After:   after,
+		Limit:   pageSize + 1,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	users, nextPageToken := pageOf(users, pageSize, func(user *model.User) *model.PageCursor {
+		return &model.PageCursor{Key: user.Username, ID: user.ID}
+	})
+	res := &model.ListGroupMembersResponse{
+		Users:         make([]*model.UserInfoResponse, 0, len(users)),
+		NextPageToken: nextPageToken,
+	}
+	for _, user := range users {
+		res.Users = append(res.Users, user.ToUserInfoResponse())
+	}
+
+	return res, nil
+}
+
+func (uc *userGroupUsecase) ListUsersWithPermission(ctx context.Context, payload *model.ListUsersWithPermissionPayload) (*model.ListUsersWithPermissionResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"permissionID": payload.PermissionID,
+	})
+
+	currentUserID := getUserIDFromCtx(ctx)
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserGroupAll,
+			constant.PermissionUserGroupRead,
+		},
+	})
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	after, err := model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := model.PageSize(payload.PageSize)
+
+	permission, err := uc.permissionRepo.FindByID(ctx, payload.PermissionID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if permission == nil {
+		return nil, model.ErrPermissionNotFound
+	}
+
+	users, err := uc.userGroupRepo.FindUsersWithPermission(ctx, &model.PermissionHolderFilter{
+		PermissionNames: grantingPermissions(permission.Name),
+		After:           after,
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	users, nextPageToken := pageOf(users, pageSize, func(user *model.User) *model.PageCursor {
+		return &model.PageCursor{Key: user.Username, ID: user.ID}
+	})
+	res := &model.ListUsersWithPermissionResponse{
+		Users:         make([]*model.UserInfoResponse, 0, len(users)),
+		NextPageToken: nextPageToken,
+	}
+	for _, user := range users {
+		res.Users = append(res.Users, user.ToUserInfoResponse())
+	}
+
+	return res, nil
+}
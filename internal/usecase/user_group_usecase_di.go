@@ -37,3 +37,11 @@ func (uc *userGroupUsecase) InjectGroupRepo(repo model.GroupRepository) error {
 	uc.groupRepo = repo
 	return nil
 }
+
+func (uc *userGroupUsecase) InjectPermissionRepo(repo model.PermissionRepository) error {
+	if repo == nil {
+		return errors.New("invalid permission repository")
+	}
+	uc.permissionRepo = repo
+	return nil
+}
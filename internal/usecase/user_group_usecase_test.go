@@ -690,3 +690,185 @@ func Test_userGroupUsecase_DeleteByUserIDAndGroupID(t *testing.T) {
 		})
 	}
 }
+
+func Test_userGroupUsecase_ListGroupMembers(t *testing.T) {
+	userID := utils.GenerateUUID()
+	ownerID := utils.GenerateUUID()
+	cursor := &model.PageCursor{Key: "a", ID: utils.GenerateUUID()}
+	items := []*model.User{{ID: utils.GenerateUUID(), Username: "b"}}
+
+	tests := []struct {
+		name          string
+		payload       *model.ListGroupMembersPayload
+		mockAccessErr error
+		wantFind      bool
+		mockOwner     *model.Group
+		wantFilter    *model.GroupMemberFilter
+		mockRes       []*model.User
+		mockErr       error
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			payload:    &model.ListGroupMembersPayload{GroupID: ownerID, PageSize: 2, PageToken: model.EncodePageToken(cursor)},
+			wantFind:   true,
+			mockOwner:  &model.Group{ID: ownerID, Name: "SUPER_USER"},
+			wantFilter: &model.GroupMemberFilter{GroupID: ownerID, After: cursor, Limit: 3},
+			mockRes:    items,
+		},
+		{
+			name:          "error unauthorized access",
+			payload:       &model.ListGroupMembersPayload{GroupID: ownerID},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListGroupMembersPayload{GroupID: ownerID, PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:     "error group not found",
+			payload:  &model.ListGroupMembersPayload{GroupID: ownerID},
+			wantFind: true,
+			wantErr:  model.ErrGroupNotFound,
+		},
+		{
+			name:       "error find members",
+			payload:    &model.ListGroupMembersPayload{GroupID: ownerID},
+			wantFind:   true,
+			mockOwner:  &model.Group{ID: ownerID, Name: "SUPER_USER"},
+			wantFilter: &model.GroupMemberFilter{GroupID: ownerID, Limit: model.DefaultPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, userID)
+
+			groupRepo := mock.NewMockGroupRepository(ctrl)
+			userGroupRepo := mock.NewMockUserGroupRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFind {
+				groupRepo.EXPECT().FindByID(gomock.Any(), ownerID).Times(1).Return(tt.mockOwner, nil)
+			}
+			if tt.wantFilter != nil {
+				userGroupRepo.EXPECT().FindMembers(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			uc := NewUserGroupUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserGroupRepo(userGroupRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupRepo(groupRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ListGroupMembers(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userGroupUsecase.ListGroupMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && len(got.Users) != len(tt.mockRes) {
+				t.Errorf("userGroupUsecase.ListGroupMembers() = %d items, want %d items", len(got.Users), len(tt.mockRes))
+			}
+		})
+	}
+}
+
+func Test_userGroupUsecase_ListUsersWithPermission(t *testing.T) {
+	userID := utils.GenerateUUID()
+	ownerID := utils.GenerateUUID()
+	cursor := &model.PageCursor{Key: "a", ID: utils.GenerateUUID()}
+	items := []*model.User{{ID: utils.GenerateUUID(), Username: "b"}}
+
+	tests := []struct {
+		name          string
+		payload       *model.ListUsersWithPermissionPayload
+		mockAccessErr error
+		wantFind      bool
+		mockOwner     *model.Permission
+		wantFilter    *model.PermissionHolderFilter
+		mockRes       []*model.User
+		mockErr       error
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			payload:    &model.ListUsersWithPermissionPayload{PermissionID: ownerID, PageSize: 2, PageToken: model.EncodePageToken(cursor)},
+			wantFind:   true,
+			mockOwner:  &model.Permission{ID: ownerID, Name: "USER_READ"},
+			wantFilter: &model.PermissionHolderFilter{PermissionNames: []string{"USER_READ", "USER_ALL", "FULL_ACCESS"}, After: cursor, Limit: 3},
+			mockRes:    items,
+		},
+		{
+			name:          "error unauthorized access",
+			payload:       &model.ListUsersWithPermissionPayload{PermissionID: ownerID},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListUsersWithPermissionPayload{PermissionID: ownerID, PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:     "error permission not found",
+			payload:  &model.ListUsersWithPermissionPayload{PermissionID: ownerID},
+			wantFind: true,
+			wantErr:  model.ErrPermissionNotFound,
+		},
+		{
+			name:       "error find users",
+			payload:    &model.ListUsersWithPermissionPayload{PermissionID: ownerID},
+			wantFind:   true,
+			mockOwner:  &model.Permission{ID: ownerID, Name: "USER_READ"},
+			wantFilter: &model.PermissionHolderFilter{PermissionNames: []string{"USER_READ", "USER_ALL", "FULL_ACCESS"}, Limit: model.DefaultPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, userID)
+
+			permissionRepo := mock.NewMockPermissionRepository(ctrl)
+			userGroupRepo := mock.NewMockUserGroupRepository(ctrl)
+			authUsecase := mock.NewMockAuthUsecase(ctrl)
+
+			authUsecase.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFind {
+				permissionRepo.EXPECT().FindByID(gomock.Any(), ownerID).Times(1).Return(tt.mockOwner, nil)
+			}
+			if tt.wantFilter != nil {
+				userGroupRepo.EXPECT().FindUsersWithPermission(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockRes, tt.mockErr)
+			}
+
+			uc := NewUserGroupUsecase()
+			err := uc.InjectAuthUsecase(authUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserGroupRepo(userGroupRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPermissionRepo(permissionRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ListUsersWithPermission(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userGroupUsecase.ListUsersWithPermission() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && len(got.Users) != len(tt.mockRes) {
+				t.Errorf("userGroupUsecase.ListUsersWithPermission() = %d items, want %d items", len(got.Users), len(tt.mockRes))
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type userIdentityUsecase struct {
+	db                   *gorm.DB
+	oauthUC              model.OAuthUsecase
+	userUC               model.UserUsecase
+	userRepo             model.UserRepository
+	userIdentityRepo     model.UserIdentityRepository
+	federationStateRepo  model.FederationStateRepository
+	identityProviderRepo model.IdentityProviderRepository
+}
+
+func NewUserIdentityUsecase() model.UserIdentityUsecase {
+	return new(userIdentityUsecase)
+}
+
+func (uc *userIdentityUsecase) Providers(ctx context.Context) []string {
+	_, _, fn := utils.Trace()
+	_, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	return uc.identityProviderRepo.Providers()
+}
+
+// StartLogin validate the pending authorize request and return the upstream url to sign in at.
+// The state is bound to the user agent so a callback url can't be replayed in another browser.
+func (uc *userIdentityUsecase) StartLogin(ctx context.Context, payload *model.StartFederatedLoginPayload) (*model.StartFederatedLoginResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	_, err := uc.oauthUC.ValidateAuthorizeRequest(ctx, payload.Authorize)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := utils.GenerateSecret("")
+	if err != nil {
+		logrus.WithField("provider", payload.Provider).Error(err.Error())
+		return nil, err
+	}
+
+	authorizationURL, err := uc.startFederation(ctx, &model.FederationState{
+		Provider:    payload.Provider,
+		Authorize:   payload.Authorize,
+		BindingHash: utils.HashSecret(binding),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.StartFederatedLoginResponse{
+		AuthorizationURL: authorizationURL,
+		Binding:          binding,
+	}, nil
+}
+
+// StartLink return the upstream url the current user sign in at to link the identity to the account.
+func (uc *userIdentityUsecase) StartLink(ctx context.Context, payload *model.LinkUserIdentityPayload) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	if currentUserID == constant.GuestID {
+		return "", model.ErrUnauthorizeAccess
+	}
+
+	return uc.startFederation(ctx, &model.FederationState{
+		Provider: payload.Provider,
+		UserID:   currentUserID,
+	})
+}
+
+func (uc *userIdentityUsecase) startFederation(ctx context.Context, state *model.FederationState) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"provider": state.Provider,
+		"userID":   state.UserID,
+	})
+
+	stateID, err := utils.GenerateSecret("")
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+	state.CodeVerifier, err = utils.GenerateSecret("")
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+
+	authorizationURL, err := uc.identityProviderRepo.AuthorizationURL(ctx, state.Provider, stateID, utils.NewCodeChallenge(state.CodeVerifier))
+	if err != nil {
+		return "", err
+	}
+
+	err = uc.federationStateRepo.Create(ctx, stateID, state, config.OAuthFederationStateDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+
+	return authorizationURL, nil
+}
+
+// Callback complete the sign in at the upstream provider. A login resume the pending
+// authorize request, provisioning the user on first sign in, a link attach the identity
+// to the user who started it.
+func (uc *userIdentityUsecase) Callback(ctx context.Context, payload *model.FederatedCallbackPayload) (*model.FederatedCallbackResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	state, err := uc.federationStateRepo.Consume(ctx, payload.State)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, model.ErrFederationStateInvalid
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		"provider": state.Provider,
+		"userID":   state.UserID,
+	})
+
+	if state.Authorize != nil && !utils.CompareSecret(state.BindingHash, payload.Binding) {
+		logger.Warn("federated login completed by another user agent")
+		return nil, model.ErrFederationStateInvalid
+	}
+
+	if payload.Error != "" {
+		logger.WithField("error", payload.Error).Warn("identity provider returned an error")
+		return nil, model.ErrIdentityProviderExchange
+	}
+
+	externalIdentity, err := uc.identityProviderRepo.Exchange(ctx, state.Provider, payload.Code, state.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.UserID != "" {
+		identity, err := uc.link(ctx, state.UserID, externalIdentity)
+		if err != nil {
+			return nil, err
+		}
+		return &model.FederatedCallbackResponse{Identity: identity}, nil
+	}
+
+	identity, err := uc.userIdentityRepo.FindByProviderAndSubject(ctx, externalIdentity.Provider, externalIdentity.Subject)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if identity == nil {
+		identity, err = uc.provision(ctx, externalIdentity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	authorizeResponse, err := uc.oauthUC.AuthorizeUser(ctx, state.Authorize, identity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FederatedCallbackResponse{Authorize: authorizeResponse}, nil
+}
+
+func (uc *userIdentityUsecase) link(ctx context.Context, userID string, externalIdentity *model.ExternalIdentity) (*model.UserIdentity, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"provider": externalIdentity.Provider,
+		"userID":   userID,
+	})
+
+	identity, err := uc.userIdentityRepo.FindByProviderAndSubject(ctx, externalIdentity.Provider, externalIdentity.Subject)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if identity != nil {
+		return nil, model.ErrUserIdentityAlreadyLinked
+	}
+
+	identities, err := uc.userIdentityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	for _, identity := range identities {
+		if identity.Provider == externalIdentity.Provider {
+			return nil, model.ErrUserIdentityAlreadyLinked
+		}
+	}
+
+	identity = newUserIdentity(userID, externalIdentity)
+	err = uc.userIdentityRepo.Create(ctx, identity)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// provision create the local user and its identity on first sign in. An email already
+// used by a local account is rejected, the owner has to sign in and link it instead.
+func (uc *userIdentityUsecase) provision(ctx context.Context, externalIdentity *model.ExternalIdentity) (*model.UserIdentity, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"provider": externalIdentity.Provider,
+		"email":    externalIdentity.Email,
+	})
+
+	if !externalIdentity.EmailVerified {
+		return nil, model.ErrExternalEmailNotVerified
+	}
+
+	var identity *model.UserIdentity
+	err := uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := utils.NewTxContext(ctx, tx)
+
+		user, err := uc.userUC.ProvisionUser(txCtx, &model.ProvisionUserPayload{
+			FullName: externalIdentity.Name,
+			Username: externalIdentity.PreferredUsername,
+			Email:    externalIdentity.Email,
+		})
+		if err != nil {
+			return err
+		}
+
+		identity = newUserIdentity(user.ID, externalIdentity)
+		return uc.userIdentityRepo.Create(txCtx, identity)
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (uc *userIdentityUsecase) FindByCurrentUser(ctx context.Context) (model.UserIdentities, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"userID": currentUserID,
+	})
+
+	if currentUserID == constant.GuestID {
+		return nil, model.ErrUnauthorizeAccess
+	}
+
+	identities, err := uc.userIdentityRepo.FindByUserID(ctx, currentUserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+// Unlink remove the identity of the current user, unless it is the only way left to sign in.
+func (uc *userIdentityUsecase) Unlink(ctx context.Context, payload *model.UnlinkUserIdentityPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"userID":   currentUserID,
+		"provider": payload.Provider,
+	})
+
+	if currentUserID == constant.GuestID {
+		return model.ErrUnauthorizeAccess
+	}
+
+	identities, err := uc.userIdentityRepo.FindByUserID(ctx, currentUserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	var identity *model.UserIdentity
+	for _, userIdentity := range identities {
+		if userIdentity.Provider == payload.Provider {
+			identity = userIdentity
+			break
+		}
+	}
+	if identity == nil {
+		return model.ErrUserIdentityNotFound
+	}
+	// the directory link is what the directory managed user sign in with
+	if identity.Provider == model.IdentityProviderLDAP {
+		return model.ErrUserIdentityRequired
+	}
+
+	if len(identities) == 1 {
+		user, err := uc.userRepo.FindByID(ctx, currentUserID)
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+		if user == nil {
+			return model.ErrUserNotFound
+		}
+		if user.Password == "" {
+			return model.ErrUserIdentityRequired
+		}
+	}
+
+	err = uc.userIdentityRepo.Delete(ctx, identity)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func newUserIdentity(userID string, externalIdentity *model.ExternalIdentity) *model.UserIdentity {
+	return &model.UserIdentity{
+		ID:       utils.GenerateUUID(),
+		UserID:   userID,
+		Provider: externalIdentity.Provider,
+		Subject:  externalIdentity.Subject,
+		Email:    externalIdentity.Email,
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+	"gorm.io/gorm"
+)
+
+func (uc *userIdentityUsecase) InjectDB(db *gorm.DB) error {
+	if db == nil {
+		return errors.New("invalid db")
+	}
+	uc.db = db
+	return nil
+}
+
+func (uc *userIdentityUsecase) InjectOAuthUsecase(usecase model.OAuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid oauth usecase")
+	}
+	uc.oauthUC = usecase
+	return nil
+}
+
+func (uc *userIdentityUsecase) InjectUserUsecase(usecase model.UserUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid user usecase")
+	}
+	uc.userUC = usecase
+	return nil
+}
+
+func (uc *userIdentityUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *userIdentityUsecase) InjectUserIdentityRepo(repo model.UserIdentityRepository) error {
+	if repo == nil {
+		return errors.New("invalid user identity repo")
+	}
+	uc.userIdentityRepo = repo
+	return nil
+}
+
+func (uc *userIdentityUsecase) InjectFederationStateRepo(repo model.FederationStateRepository) error {
+	if repo == nil {
+		return errors.New("invalid federation state repo")
+	}
+	uc.federationStateRepo = repo
+	return nil
+}
+
+func (uc *userIdentityUsecase) InjectIdentityProviderRepo(repo model.IdentityProviderRepository) error {
+	if repo == nil {
+		return errors.New("invalid identity provider repo")
+	}
+	uc.identityProviderRepo = repo
+	return nil
+}
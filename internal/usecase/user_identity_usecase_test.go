@@ -0,0 +1,415 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+type userIdentityUsecaseMock struct {
+	dbMock               sqlmock.Sqlmock
+	oauthUsecase         *mock.MockOAuthUsecase
+	userUsecase          *mock.MockUserUsecase
+	userRepo             *mock.MockUserRepository
+	userIdentityRepo     *mock.MockUserIdentityRepository
+	federationStateRepo  *mock.MockFederationStateRepository
+	identityProviderRepo *mock.MockIdentityProviderRepository
+}
+
+func newUserIdentityUsecaseMock(ctrl *gomock.Controller) (model.UserIdentityUsecase, *userIdentityUsecaseMock) {
+	dbConn, dbMock := utils.NewDBMock()
+	m := &userIdentityUsecaseMock{
+		dbMock:               dbMock,
+		oauthUsecase:         mock.NewMockOAuthUsecase(ctrl),
+		userUsecase:          mock.NewMockUserUsecase(ctrl),
+		userRepo:             mock.NewMockUserRepository(ctrl),
+		userIdentityRepo:     mock.NewMockUserIdentityRepository(ctrl),
+		federationStateRepo:  mock.NewMockFederationStateRepository(ctrl),
+		identityProviderRepo: mock.NewMockIdentityProviderRepository(ctrl),
+	}
+
+	uc := NewUserIdentityUsecase()
+	err := uc.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectOAuthUsecase(m.oauthUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserUsecase(m.userUsecase)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserIdentityRepo(m.userIdentityRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectFederationStateRepo(m.federationStateRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectIdentityProviderRepo(m.identityProviderRepo)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func Test_userIdentityUsecase_StartLink(t *testing.T) {
+	userID := utils.GenerateUUID()
+	tests := []struct {
+		name    string
+		userID  string
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			want:   "https://idp.example.com/authorize?state=state",
+		},
+		{
+			name:    "guest",
+			userID:  constant.GuestID,
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newUserIdentityUsecaseMock(ctrl)
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, tt.userID)
+
+			if tt.wantErr == nil {
+				var stateID string
+				m.identityProviderRepo.EXPECT().AuthorizationURL(gomock.Any(), "corporate", gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, provider string, state string, codeChallenge string) (string, error) {
+						stateID = state
+						return tt.want, nil
+					})
+				m.federationStateRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, state string, data *model.FederationState, _ interface{}) error {
+						if state != stateID || data.UserID != tt.userID || data.CodeVerifier == "" {
+							t.Errorf("userIdentityUsecase.StartLink() stored state = %v", data)
+						}
+						return nil
+					})
+			}
+
+			got, err := uc.StartLink(ctx, &model.LinkUserIdentityPayload{Provider: "corporate"})
+			if err != tt.wantErr {
+				t.Errorf("userIdentityUsecase.StartLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("userIdentityUsecase.StartLink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userIdentityUsecase_Callback(t *testing.T) {
+	var (
+		userID    = utils.GenerateUUID()
+		authorize = &model.AuthorizePayload{
+			ClientID:    model.OAuthClientIDPrefix + utils.GenerateUUID(),
+			RedirectURI: "https://dashboard.example.com/callback",
+			State:       "client-state",
+		}
+		loginState = &model.FederationState{
+			Provider:     "corporate",
+			CodeVerifier: "verifier",
+			Authorize:    authorize,
+			BindingHash:  utils.HashSecret("binding"),
+		}
+		linkState = &model.FederationState{
+			Provider:     "corporate",
+			CodeVerifier: "verifier",
+			UserID:       userID,
+		}
+		externalIdentity = &model.ExternalIdentity{
+			Provider:      "corporate",
+			Subject:       "248289761001",
+			Email:         "jane@example.com",
+			EmailVerified: true,
+		}
+		identity = &model.UserIdentity{
+			ID:       utils.GenerateUUID(),
+			UserID:   userID,
+			Provider: "corporate",
+			Subject:  "248289761001",
+		}
+		authorizeResponse = &model.AuthorizeResponse{
+			RedirectURI: authorize.RedirectURI,
+			Code:        "code",
+			State:       authorize.State,
+		}
+	)
+	type mockExchange struct {
+		res *model.ExternalIdentity
+		err error
+	}
+	tests := []struct {
+		name               string
+		payload            *model.FederatedCallbackPayload
+		mockState          *model.FederationState
+		mockExchange       *mockExchange
+		mockIdentity       *model.UserIdentity
+		mockUserIdentities model.UserIdentities
+		mockProvision      bool
+		mockProvisionErr   error
+		mockAuthorize      bool
+		want               *model.FederatedCallbackResponse
+		wantErr            error
+	}{
+		{
+			name:          "success login with linked identity",
+			payload:       &model.FederatedCallbackPayload{State: "state", Code: "code", Binding: "binding"},
+			mockState:     loginState,
+			mockExchange:  &mockExchange{res: externalIdentity},
+			mockIdentity:  identity,
+			mockAuthorize: true,
+			want:          &model.FederatedCallbackResponse{Authorize: authorizeResponse},
+		},
+		{
+			name:          "success login provision user",
+			payload:       &model.FederatedCallbackPayload{State: "state", Code: "code", Binding: "binding"},
+			mockState:     loginState,
+			mockExchange:  &mockExchange{res: externalIdentity},
+			mockProvision: true,
+			mockAuthorize: true,
+			want:          &model.FederatedCallbackResponse{Authorize: authorizeResponse},
+		},
+		{
+			name:             "login email already used by a local account",
+			payload:          &model.FederatedCallbackPayload{State: "state", Code: "code", Binding: "binding"},
+			mockState:        loginState,
+			mockExchange:     &mockExchange{res: externalIdentity},
+			mockProvision:    true,
+			mockProvisionErr: model.ErrUsernameOrEmailAlreadyTaken,
+			wantErr:          model.ErrUsernameOrEmailAlreadyTaken,
+		},
+		{
+			name:      "login unverified email",
+			payload:   &model.FederatedCallbackPayload{State: "state", Code: "code", Binding: "binding"},
+			mockState: loginState,
+			mockExchange: &mockExchange{res: &model.ExternalIdentity{
+				Provider: "corporate",
+				Subject:  "248289761001",
+				Email:    "jane@example.com",
+			}},
+			wantErr: model.ErrExternalEmailNotVerified,
+		},
+		{
+			name:         "success link",
+			payload:      &model.FederatedCallbackPayload{State: "state", Code: "code"},
+			mockState:    linkState,
+			mockExchange: &mockExchange{res: externalIdentity},
+			want: &model.FederatedCallbackResponse{Identity: &model.UserIdentity{
+				UserID:   userID,
+				Provider: "corporate",
+				Subject:  "248289761001",
+				Email:    "jane@example.com",
+			}},
+		},
+		{
+			name:         "link identity owned by other user",
+			payload:      &model.FederatedCallbackPayload{State: "state", Code: "code"},
+			mockState:    linkState,
+			mockExchange: &mockExchange{res: externalIdentity},
+			mockIdentity: &model.UserIdentity{ID: utils.GenerateUUID(), UserID: utils.GenerateUUID()},
+			wantErr:      model.ErrUserIdentityAlreadyLinked,
+		},
+		{
+			name:               "link provider already linked",
+			payload:            &model.FederatedCallbackPayload{State: "state", Code: "code"},
+			mockState:          linkState,
+			mockExchange:       &mockExchange{res: externalIdentity},
+			mockUserIdentities: model.UserIdentities{{ID: utils.GenerateUUID(), UserID: userID, Provider: "corporate", Subject: "other"}},
+			wantErr:            model.ErrUserIdentityAlreadyLinked,
+		},
+		{
+			name:      "login completed by another user agent",
+			payload:   &model.FederatedCallbackPayload{State: "state", Code: "code", Binding: "other"},
+			mockState: loginState,
+			wantErr:   model.ErrFederationStateInvalid,
+		},
+		{
+			name:      "login without binding",
+			payload:   &model.FederatedCallbackPayload{State: "state", Code: "code"},
+			mockState: loginState,
+			wantErr:   model.ErrFederationStateInvalid,
+		},
+		{
+			name:    "unknown state",
+			payload: &model.FederatedCallbackPayload{State: "state", Code: "code"},
+			wantErr: model.ErrFederationStateInvalid,
+		},
+		{
+			name:      "upstream error",
+			payload:   &model.FederatedCallbackPayload{State: "state", Error: "access_denied", Binding: "binding"},
+			mockState: loginState,
+			wantErr:   model.ErrIdentityProviderExchange,
+		},
+		{
+			name:         "exchange error",
+			payload:      &model.FederatedCallbackPayload{State: "state", Code: "code", Binding: "binding"},
+			mockState:    loginState,
+			mockExchange: &mockExchange{err: model.ErrIdentityProviderExchange},
+			wantErr:      model.ErrIdentityProviderExchange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newUserIdentityUsecaseMock(ctrl)
+
+			m.federationStateRepo.EXPECT().Consume(gomock.Any(), tt.payload.State).Times(1).Return(tt.mockState, nil)
+
+			if tt.mockExchange != nil {
+				m.identityProviderRepo.EXPECT().Exchange(gomock.Any(), "corporate", tt.payload.Code, "verifier").Times(1).
+					Return(tt.mockExchange.res, tt.mockExchange.err)
+
+				if tt.mockExchange.res != nil {
+					m.userIdentityRepo.EXPECT().FindByProviderAndSubject(gomock.Any(), "corporate", "248289761001").Times(1).
+						Return(tt.mockIdentity, nil)
+				}
+			}
+
+			if tt.mockState == linkState && tt.mockIdentity == nil && tt.mockExchange != nil {
+				m.userIdentityRepo.EXPECT().FindByUserID(gomock.Any(), userID).Times(1).Return(tt.mockUserIdentities, nil)
+				if len(tt.mockUserIdentities) == 0 {
+					m.userIdentityRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				}
+			}
+
+			if tt.mockProvision {
+				m.dbMock.ExpectBegin()
+				m.userUsecase.EXPECT().ProvisionUser(gomock.Any(), &model.ProvisionUserPayload{Email: "jane@example.com"}).Times(1).
+					DoAndReturn(func(ctx context.Context, payload *model.ProvisionUserPayload) (*model.User, error) {
+						if tt.mockProvisionErr != nil {
+							return nil, tt.mockProvisionErr
+						}
+						return &model.User{ID: userID, Email: payload.Email}, nil
+					})
+				if tt.mockProvisionErr != nil {
+					m.dbMock.ExpectRollback()
+				} else {
+					m.userIdentityRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+					m.dbMock.ExpectCommit()
+				}
+			}
+
+			if tt.mockAuthorize {
+				m.oauthUsecase.EXPECT().AuthorizeUser(gomock.Any(), authorize, userID).Times(1).Return(authorizeResponse, nil)
+			}
+
+			got, err := uc.Callback(context.TODO(), tt.payload)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("userIdentityUsecase.Callback() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != nil && got.Identity != nil {
+				got.Identity.ID = ""
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("userIdentityUsecase.Callback() = %v, want %v", got, tt.want)
+			}
+			if err := m.dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("userIdentityUsecase.Callback() transaction: %v", err)
+			}
+		})
+	}
+}
+
+func Test_userIdentityUsecase_Unlink(t *testing.T) {
+	var (
+		userID   = utils.GenerateUUID()
+		identity = &model.UserIdentity{
+			ID:       utils.GenerateUUID(),
+			UserID:   userID,
+			Provider: "corporate",
+			Subject:  "248289761001",
+		}
+		otherIdentity = &model.UserIdentity{
+			ID:       utils.GenerateUUID(),
+			UserID:   userID,
+			Provider: "partner",
+			Subject:  "42",
+		}
+	)
+	tests := []struct {
+		name           string
+		userID         string
+		provider       string
+		mockIdentities model.UserIdentities
+		mockUser       *model.User
+		wantDelete     bool
+		wantErr        error
+	}{
+		{
+			name:           "success user with password",
+			userID:         userID,
+			provider:       "corporate",
+			mockIdentities: model.UserIdentities{identity},
+			mockUser:       &model.User{ID: userID, Password: "hashed"},
+			wantDelete:     true,
+		},
+		{
+			name:           "success other identity left",
+			userID:         userID,
+			provider:       "corporate",
+			mockIdentities: model.UserIdentities{identity, otherIdentity},
+			wantDelete:     true,
+		},
+		{
+			name:           "only way to sign in",
+			userID:         userID,
+			provider:       "corporate",
+			mockIdentities: model.UserIdentities{identity},
+			mockUser:       &model.User{ID: userID},
+			wantErr:        model.ErrUserIdentityRequired,
+		},
+		{
+			name:           "not linked",
+			userID:         userID,
+			provider:       "partner",
+			mockIdentities: model.UserIdentities{identity},
+			wantErr:        model.ErrUserIdentityNotFound,
+		},
+		{
+			name:     "guest",
+			userID:   constant.GuestID,
+			provider: "corporate",
+			wantErr:  model.ErrUnauthorizeAccess,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newUserIdentityUsecaseMock(ctrl)
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, tt.userID)
+
+			if tt.userID != constant.GuestID {
+				m.userIdentityRepo.EXPECT().FindByUserID(gomock.Any(), tt.userID).Times(1).Return(tt.mockIdentities, nil)
+			}
+			if tt.mockUser != nil {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), tt.userID).Times(1).Return(tt.mockUser, nil)
+			}
+			if tt.wantDelete {
+				m.userIdentityRepo.EXPECT().Delete(gomock.Any(), identity).Times(1).Return(nil)
+			}
+
+			err := uc.Unlink(ctx, &model.UnlinkUserIdentityPayload{Provider: tt.provider})
+			if err != tt.wantErr {
+				t.Errorf("userIdentityUsecase.Unlink() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// userStatusPermissions is the permission granting each status change, on top of the user wide ones.
+var userStatusPermissions = map[model.UserStatus]string{
+	model.UserStatusActive:      constant.PermissionUserReactivate,
+	model.UserStatusSuspended:   constant.PermissionUserSuspend,
+	model.UserStatusDeactivated: constant.PermissionUserDeactivate,
+	model.UserStatusDeleted:     constant.PermissionUserDelete,
+}
+
+type userStatusUsecase struct {
+	authUC                  model.AuthUsecase
+	userRepo                model.UserRepository
+	tokenRepo               model.TokenRepository
+	personalAccessTokenRepo model.PersonalAccessTokenRepository
+	eventRepo               model.SecurityEventRepository
+}
+
+func NewUserStatusUsecase() model.UserStatusUsecase {
+	return new(userStatusUsecase)
+}
+
+func (uc *userStatusUsecase) ChangeStatus(ctx context.Context, payload *model.ChangeUserStatusPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := logrus.WithFields(logrus.Fields{
+		"sessionUserID": currentUserID,
+		"userID":        payload.UserID,
+		"status":        payload.Status,
+	})
+
+	permission, ok := userStatusPermissions[payload.Status]
+	if !ok {
+		return model.ErrInvalidUserStatusTransition
+	}
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserAll,
+			permission,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	// an admin locking themselves out can't undo it
+	if payload.UserID == currentUserID {
+		return model.ErrChangeOwnStatus
+	}
+	if payload.Status != model.UserStatusActive && payload.Reason == "" {
+		return model.ErrUserStatusReasonRequired
+	}
+	now := time.Now()
+	if payload.SuspendedUntil != nil && (payload.Status != model.UserStatusSuspended || !payload.SuspendedUntil.After(now)) {
+		return model.ErrInvalidSuspensionEnd
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, payload.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if user == nil {
+		return model.ErrUserNotFound
+	}
+
+	previousStatus := user.CurrentStatus(now)
+	if !previousStatus.CanChangeTo(payload.Status) {
+		return model.ErrInvalidUserStatusTransition
+	}
+
+	user.Status = payload.Status
+	user.StatusReason = payload.Reason
+	user.SuspendedUntil = payload.SuspendedUntil
+	if payload.Status == model.UserStatusDeleted {
+		user.DeletedAt = &now
+	}
+	err = uc.userRepo.UpdateStatus(ctx, user)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	// a reactivated user start from a clean slate too
+	err = uc.tokenRepo.RevokeAllSessions(ctx, user.ID, "")
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	err = uc.personalAccessTokenRepo.DeleteByUserID(ctx, user.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	detail := fmt.Sprintf("status changed from %s to %s by %s", previousStatus, payload.Status, currentUserID)
+	if payload.Reason != "" {
+		detail = fmt.Sprintf("%s: %s", detail, payload.Reason)
+	}
+	if payload.SuspendedUntil != nil {
+		detail = fmt.Sprintf("%s, until %s", detail, payload.SuspendedUntil.Format(time.RFC3339))
+	}
+	err = uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    user.ID,
+		EventType: model.SecurityEventStatusChanged,
+		Detail:    detail,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+func (uc *userStatusUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
+func (uc *userStatusUsecase) InjectUserRepo(repo model.UserRepository) error {
+	if repo == nil {
+		return errors.New("invalid user repo")
+	}
+	uc.userRepo = repo
+	return nil
+}
+
+func (uc *userStatusUsecase) InjectTokenRepo(repo model.TokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid token repo")
+	}
+	uc.tokenRepo = repo
+	return nil
+}
+
+func (uc *userStatusUsecase) InjectPersonalAccessTokenRepo(repo model.PersonalAccessTokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid personal access token repo")
+	}
+	uc.personalAccessTokenRepo = repo
+	return nil
+}
+
+func (uc *userStatusUsecase) InjectSecurityEventRepo(repo model.SecurityEventRepository) error {
+	if repo == nil {
+		return errors.New("invalid security event repo")
+	}
+	uc.eventRepo = repo
+	return nil
+}
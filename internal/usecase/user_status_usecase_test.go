@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/utils"
+)
+
+type userStatusUsecaseMock struct {
+	authUC                  *mock.MockAuthUsecase
+	userRepo                *mock.MockUserRepository
+	tokenRepo               *mock.MockTokenRepository
+	personalAccessTokenRepo *mock.MockPersonalAccessTokenRepository
+	eventRepo               *mock.MockSecurityEventRepository
+}
+
+func newUserStatusUsecaseMock(ctrl *gomock.Controller) (model.UserStatusUsecase, *userStatusUsecaseMock) {
+	m := &userStatusUsecaseMock{
+		authUC:                  mock.NewMockAuthUsecase(ctrl),
+		userRepo:                mock.NewMockUserRepository(ctrl),
+		tokenRepo:               mock.NewMockTokenRepository(ctrl),
+		personalAccessTokenRepo: mock.NewMockPersonalAccessTokenRepository(ctrl),
+		eventRepo:               mock.NewMockSecurityEventRepository(ctrl),
+	}
+
+	uc := NewUserStatusUsecase()
+	err := uc.InjectAuthUsecase(m.authUC)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectUserRepo(m.userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectTokenRepo(m.tokenRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectPersonalAccessTokenRepo(m.personalAccessTokenRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectSecurityEventRepo(m.eventRepo)
+	utils.ContinueOrFatal(err)
+
+	return uc, m
+}
+
+func Test_userStatusUsecase_ChangeStatus(t *testing.T) {
+	adminID := utils.GenerateUUID()
+	userID := utils.GenerateUUID()
+	suspendedUntil := time.Now().Add(time.Hour)
+	pastSuspension := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		payload       *model.ChangeUserStatusPayload
+		mockAccessErr error
+		wantFind      bool
+		mockUser      *model.User
+		wantUpdate    bool
+		mockUpdateErr error
+		wantRevoke    bool
+		mockRevokeErr error
+		wantDeletePAT bool
+		mockDeleteErr error
+		wantEvent     bool
+		mockEventErr  error
+		wantErr       error
+	}{
+		{
+			name: "success suspend",
+			payload: &model.ChangeUserStatusPayload{
+				UserID:         userID,
+				Status:         model.UserStatusSuspended,
+				Reason:         "spam",
+				SuspendedUntil: &suspendedUntil,
+			},
+			wantFind:      true,
+			mockUser:      &model.User{ID: userID, Status: model.UserStatusActive},
+			wantUpdate:    true,
+			wantRevoke:    true,
+			wantDeletePAT: true,
+			wantEvent:     true,
+		},
+		{
+			name: "success reactivate without reason",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusActive,
+			},
+			wantFind:      true,
+			mockUser:      &model.User{ID: userID, Status: model.UserStatusSuspended, SuspendedUntil: &suspendedUntil},
+			wantUpdate:    true,
+			wantRevoke:    true,
+			wantDeletePAT: true,
+			wantEvent:     true,
+		},
+		{
+			name: "success when the event can't be recorded",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusDeleted,
+				Reason: "requested by the user",
+			},
+			wantFind:      true,
+			mockUser:      &model.User{ID: userID, Status: model.UserStatusDeactivated},
+			wantUpdate:    true,
+			wantRevoke:    true,
+			wantDeletePAT: true,
+			wantEvent:     true,
+			mockEventErr:  errors.New("db error"),
+		},
+		{
+			name: "error unknown status",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusPending,
+				Reason: "reason",
+			},
+			wantErr: model.ErrInvalidUserStatusTransition,
+		},
+		{
+			name: "error no access",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusDeactivated,
+				Reason: "reason",
+			},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name: "error change own status",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: adminID,
+				Status: model.UserStatusDeactivated,
+				Reason: "reason",
+			},
+			wantErr: model.ErrChangeOwnStatus,
+		},
+		{
+			name: "error reason required",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusSuspended,
+			},
+			wantErr: model.ErrUserStatusReasonRequired,
+		},
+		{
+			name: "error suspension end in the past",
+			payload: &model.ChangeUserStatusPayload{
+				UserID:         userID,
+				Status:         model.UserStatusSuspended,
+				Reason:         "spam",
+				SuspendedUntil: &pastSuspension,
+			},
+			wantErr: model.ErrInvalidSuspensionEnd,
+		},
+		{
+			name: "error suspension end on a deactivation",
+			payload: &model.ChangeUserStatusPayload{
+				UserID:         userID,
+				Status:         model.UserStatusDeactivated,
+				Reason:         "reason",
+				SuspendedUntil: &suspendedUntil,
+			},
+			wantErr: model.ErrInvalidSuspensionEnd,
+		},
+		{
+			name: "error user not found",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusDeactivated,
+				Reason: "reason",
+			},
+			wantFind: true,
+			wantErr:  model.ErrUserNotFound,
+		},
+		{
+			name: "error deleted user can't be reactivated",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusActive,
+			},
+			wantFind: true,
+			mockUser: &model.User{ID: userID, Status: model.UserStatusDeleted},
+			wantErr:  model.ErrInvalidUserStatusTransition,
+		},
+		{
+			name: "error reactivate an expired suspension",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusActive,
+			},
+			wantFind: true,
+			mockUser: &model.User{ID: userID, Status: model.UserStatusSuspended, SuspendedUntil: &pastSuspension},
+			wantErr:  model.ErrInvalidUserStatusTransition,
+		},
+		{
+			name: "error update status",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusDeactivated,
+				Reason: "reason",
+			},
+			wantFind:      true,
+			mockUser:      &model.User{ID: userID, Status: model.UserStatusActive},
+			wantUpdate:    true,
+			mockUpdateErr: errors.New("db error"),
+			wantErr:       errors.New("db error"),
+		},
+		{
+			name: "error revoke sessions",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusDeactivated,
+				Reason: "reason",
+			},
+			wantFind:      true,
+			mockUser:      &model.User{ID: userID, Status: model.UserStatusActive},
+			wantUpdate:    true,
+			wantRevoke:    true,
+			mockRevokeErr: errors.New("redis error"),
+			wantErr:       errors.New("redis error"),
+		},
+		{
+			name: "error revoke personal access tokens",
+			payload: &model.ChangeUserStatusPayload{
+				UserID: userID,
+				Status: model.UserStatusSuspended,
+				Reason: "reason",
+			},
+			wantFind:      true,
+			mockUser:      &model.User{ID: userID, Status: model.UserStatusActive},
+			wantUpdate:    true,
+			wantRevoke:    true,
+			wantDeletePAT: true,
+			mockDeleteErr: errors.New("db error"),
+			wantErr:       errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newUserStatusUsecaseMock(ctrl)
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, adminID)
+
+			if _, ok := userStatusPermissions[tt.payload.Status]; ok {
+				m.authUC.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			}
+			if tt.wantFind {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), tt.payload.UserID).Times(1).Return(tt.mockUser, nil)
+			}
+			if tt.wantUpdate {
+				m.userRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						if user.Status != tt.payload.Status || user.StatusReason != tt.payload.Reason || user.SuspendedUntil != tt.payload.SuspendedUntil {
+							t.Errorf("userStatusUsecase.ChangeStatus() updated %v", user)
+						}
+						if (user.DeletedAt != nil) != (tt.payload.Status == model.UserStatusDeleted) {
+							t.Errorf("userStatusUsecase.ChangeStatus() deleted at = %v", user.DeletedAt)
+						}
+						return tt.mockUpdateErr
+					})
+			}
+			if tt.wantRevoke {
+				m.tokenRepo.EXPECT().RevokeAllSessions(gomock.Any(), tt.payload.UserID, "").Times(1).Return(tt.mockRevokeErr)
+			}
+			if tt.wantDeletePAT {
+				m.personalAccessTokenRepo.EXPECT().DeleteByUserID(gomock.Any(), tt.payload.UserID).Times(1).Return(tt.mockDeleteErr)
+			}
+			if tt.wantEvent {
+				m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, event *model.SecurityEvent) error {
+						if event.UserID != tt.payload.UserID || event.EventType != model.SecurityEventStatusChanged {
+							t.Errorf("userStatusUsecase.ChangeStatus() recorded %v", event)
+						}
+						return tt.mockEventErr
+					})
+			}
+
+			err := uc.ChangeStatus(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userStatusUsecase.ChangeStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/krobus00/auth-service/internal/config"
 	"github.com/krobus00/auth-service/internal/constant"
 	"github.com/krobus00/auth-service/internal/model"
 	"github.com/krobus00/auth-service/internal/utils"
@@ -12,12 +16,24 @@ import (
 	"gorm.io/gorm"
 )
 
+const maxUsernameAttempts = 5
+
 type userUsecase struct {
-	userRepo      model.UserRepository
-	tokenRepo     model.TokenRepository
-	groupRepo     model.GroupRepository
-	userGroupRepo model.UserGroupRepository
-	db            *gorm.DB
+	authUC                  model.AuthUsecase
+	userRepo                model.UserRepository
+	tokenRepo               model.TokenRepository
+	personalAccessTokenRepo model.PersonalAccessTokenRepository
+	groupRepo               model.GroupRepository
+	userGroupRepo           model.UserGroupRepository
+	eventRepo               model.SecurityEventRepository
+	mfaUC                   model.MFAUsecase
+	emailUC                 model.EmailVerificationUsecase
+	db                      *gorm.DB
+
+	passwordPolicyUC model.PasswordPolicyUsecase
+	loginAttemptUC   model.LoginAttemptUsecase
+
+	authenticators []model.Authenticator
 }
 
 func NewUserUsecase() model.UserUsecase {
@@ -33,6 +49,15 @@ func (uc *userUsecase) Register(ctx context.Context, payload *model.UserRegistra
 		"username": payload.Username,
 		"email":    payload.Email,
 	})
+
+	err := uc.passwordPolicyUC.Validate(ctx, &model.User{
+		Username: payload.Username,
+		Email:    payload.Email,
+	}, payload.Password)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := uc.db.Begin(&sql.TxOptions{Isolation: sql.LevelRepeatableRead})
 	defer func() {
 		if p := recover(); p != nil {
@@ -40,7 +65,7 @@ func (uc *userUsecase) Register(ctx context.Context, payload *model.UserRegistra
 		}
 		_ = tx.Commit()
 	}()
-	err := tx.Error
+	err = tx.Error
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +92,7 @@ func (uc *userUsecase) Register(ctx context.Context, payload *model.UserRegistra
 		Username: payload.Username,
 		Email:    payload.Email,
 		Password: hashedPassword,
+		Status:   model.UserStatusActive,
 	}
 
 	err = uc.userRepo.Create(ctx, newUser)
@@ -75,13 +101,37 @@ func (uc *userUsecase) Register(ctx context.Context, payload *model.UserRegistra
 		return nil, err
 	}
 
-	err = uc.addDefaultGroup(ctx, newUser.ID)
+	err = uc.passwordPolicyUC.RecordPassword(ctx, newUser.ID, newUser.Password)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
-	token, err := uc.generateToken(ctx, newUser.ID)
+	// with the restrict policy the default group is only granted once the email is verified
+	group := constant.GroupDefault
+	if config.EmailVerificationPolicy() == config.EmailVerificationPolicyRestrict {
+		group = constant.GroupUnverified
+	}
+	err = uc.addGroup(ctx, newUser.ID, group)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	// the user can ask for a new link, a failed delivery doesn't fail the registration
+	err = uc.emailUC.SendToUser(ctx, newUser)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	// with the block policy the first session is only issued by a login after the email is verified
+	if config.EmailVerificationPolicy() == config.EmailVerificationPolicyBlock {
+		return &model.AuthResponse{
+			EmailVerificationRequired: true,
+		}, nil
+	}
+
+	token, err := uc.generateToken(ctx, newUser.ID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +144,123 @@ func (uc *userUsecase) Login(ctx context.Context, payload *model.UserLoginPayloa
 	ctx, span := utils.NewSpan(ctx, fn)
 	defer span.End()
 
+	user, err := uc.Authenticate(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := uc.mfaUC.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		// the session is only issued once the mfa code is verified
+		mfaToken, err := uc.mfaUC.CreateChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &model.AuthResponse{
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	token, err := uc.generateToken(ctx, user.ID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// Authenticate verify the user credentials without issuing any token. The attempt is refused
+// while the identifier or the client address is blocked, wrong credentials count toward the lockout.
+func (uc *userUsecase) Authenticate(ctx context.Context, payload *model.UserLoginPayload) (*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"username": payload.Username,
+	}).WithFields(sessionMetadataLogFields(getSessionMetadataFromCtx(ctx)))
+
+	err := uc.loginAttemptUC.Check(ctx, payload.Username)
+	if err != nil {
+		logger.Warn(err.Error())
+		return nil, err
+	}
+
+	user, err := uc.authenticate(ctx, payload)
+	switch err {
+	case nil:
+		// with mfa the failures are forgotten only once the code is verified as well, a known
+		// password must not reset the count of wrong codes
+		enabled, err := uc.mfaUC.IsEnabled(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			if err := uc.loginAttemptUC.RecordSuccess(ctx, payload.Username); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+	case model.ErrWrongUsernameOrPassword:
+		if err := uc.loginAttemptUC.RecordFailure(ctx, payload.Username); err != nil {
+			logger.Error(err.Error())
+		}
+		return nil, err
+	default:
+		return nil, err
+	}
+
+	// the status is only told to callers who proved they know the credentials
+	err = user.StatusError()
+	switch err {
+	case nil:
+	case model.ErrUserNotFound:
+		return nil, model.ErrWrongUsernameOrPassword
+	default:
+		logger.WithField("userID", user.ID).Warn(err.Error())
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// authenticate check the local password first, then every injected authenticator in order.
+func (uc *userUsecase) authenticate(ctx context.Context, payload *model.UserLoginPayload) (*model.User, error) {
+	user, err := uc.authenticateWithPassword(ctx, payload)
+	if err != model.ErrWrongUsernameOrPassword {
+		return uc.checkEmailVerified(user, err)
+	}
+
+	for _, authenticator := range uc.authenticators {
+		user, err = authenticator.Authenticate(ctx, payload)
+		if err != model.ErrWrongUsernameOrPassword {
+			return uc.checkEmailVerified(user, err)
+		}
+	}
+
+	return nil, model.ErrWrongUsernameOrPassword
+}
+
+// checkEmailVerified reject an authenticated user whose email isn't verified yet when
+// the block policy is set.
+func (uc *userUsecase) checkEmailVerified(user *model.User, err error) (*model.User, error) {
+	if err != nil {
+		return user, err
+	}
+	if config.EmailVerificationPolicy() == config.EmailVerificationPolicyBlock && !user.IsEmailVerified() {
+		return nil, model.ErrEmailNotVerified
+	}
+	return user, nil
+}
+
+func (uc *userUsecase) authenticateWithPassword(ctx context.Context, payload *model.UserLoginPayload) (*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
 	logger := log.WithFields(log.Fields{
 		"username": payload.Username,
 	})
@@ -106,18 +273,145 @@ func (uc *userUsecase) Login(ctx context.Context, payload *model.UserLoginPayloa
 		}
 		return nil, err
 	}
+	if user.DirectoryManaged {
+		return nil, model.ErrWrongUsernameOrPassword
+	}
 
 	err = utils.ComparePassword(user.Password, payload.Password)
 	if err != nil {
 		return nil, model.ErrWrongUsernameOrPassword
 	}
 
-	token, err := uc.generateToken(ctx, user.ID)
+	if utils.PasswordNeedsRehash(user.Password) {
+		uc.rehashPassword(ctx, user, payload.Password)
+	}
+
+	return user, nil
+}
+
+// rehashPassword upgrade the stored hash to the current scheme while the plain password is known.
+// Failures are only logged, the old hash keep working.
+func (uc *userUsecase) rehashPassword(ctx context.Context, user *model.User, password string) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"userID": user.ID,
+	})
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	rehashedUser := *user
+	rehashedUser.Password = hashedPassword
+	err = uc.userRepo.UpdateByID(ctx, &rehashedUser)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	user.Password = hashedPassword
+}
+
+// IssueToken start a new session for an already authenticated user.
+func (uc *userUsecase) IssueToken(ctx context.Context, userID string) (*model.AuthResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	err := uc.checkUserStatus(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return token, nil
+	return uc.generateToken(ctx, userID, "")
+}
+
+// checkUserStatus refuse a new session to a user who isn't active, or whose email isn't verified
+// under the block policy.
+func (uc *userUsecase) checkUserStatus(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		log.WithField("userID", userID).Error(err.Error())
+		return err
+	}
+	if user == nil {
+		return model.ErrUserNotFound
+	}
+
+	err = user.StatusError()
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.checkEmailVerified(user, nil)
+	return err
+}
+
+// ProvisionUser create a passwordless user for a first time external sign in,
+// the caller own the transaction.
+func (uc *userUsecase) ProvisionUser(ctx context.Context, payload *model.ProvisionUserPayload) (*model.User, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	logger := log.WithFields(log.Fields{
+		"username": payload.Username,
+		"email":    payload.Email,
+	})
+
+	user, err := uc.userRepo.FindByEmail(ctx, payload.Email)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if user != nil {
+		return nil, model.ErrUsernameOrEmailAlreadyTaken
+	}
+
+	username, err := uc.findAvailableUsername(ctx, payload.Username, payload.Email)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	fullName := payload.FullName
+	if fullName == "" {
+		fullName = username
+	}
+
+	// the email of an external identity is already verified by the provider or the directory
+	verifiedAt := time.Now()
+	newUser := &model.User{
+		ID:               utils.GenerateUUID(),
+		FullName:         fullName,
+		Username:         username,
+		Email:            payload.Email,
+		DirectoryManaged: payload.DirectoryManaged,
+		EmailVerifiedAt:  &verifiedAt,
+		Status:           model.UserStatusActive,
+	}
+
+	err = uc.userRepo.Create(ctx, newUser)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	err = uc.addGroup(ctx, newUser.ID, constant.GroupDefault)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return newUser, nil
 }
 
 func (uc *userUsecase) GetUserInfo(ctx context.Context, payload *model.GetUserInfoPayload) (*model.UserInfoResponse, error) {
@@ -136,15 +430,7 @@ func (uc *userUsecase) GetUserInfo(ctx context.Context, payload *model.GetUserIn
 	if user == nil {
 		return nil, model.ErrUserNotFound
 	}
-	return &model.UserInfoResponse{
-		ID:        user.ID,
-		FullName:  user.FullName,
-		Username:  user.Username,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-		DeletedAt: user.DeletedAt,
-	}, nil
+	return user.ToUserInfoResponse(), nil
 }
 
 func (uc *userUsecase) RefreshToken(ctx context.Context, payload *model.RefreshTokenPayload) (*model.AuthResponse, error) {
@@ -152,32 +438,56 @@ func (uc *userUsecase) RefreshToken(ctx context.Context, payload *model.RefreshT
 	ctx, span := utils.NewSpan(ctx, fn)
 	defer span.End()
 
-	logger := log.WithFields(log.Fields{
-		"userID":  payload.UserID,
-		"tokenID": payload.TokenID,
-	})
-
-	isValidToken, err := uc.tokenRepo.IsValidToken(ctx, payload.UserID, payload.TokenID, model.RefreshToken)
+	claims, err := utils.ParseTokenClaims(payload.RefreshToken)
 	if err != nil {
-		logger.Error(err.Error())
 		return nil, err
 	}
-	if !isValidToken {
+	// untyped tokens are still refreshed so the upgrade doesn't sign every user out, they all
+	// expire within one refresh token duration of the upgrade and their successors are typed
+	if claims.TokenType != model.RefreshToken.String() && !claims.IsUntyped() {
+		return nil, model.ErrInvalidTokenType
+	}
+	// a refresh token is only rotated by the client it was issued to
+	if claims.ClientID != getSessionMetadataFromCtx(ctx).ClientID {
 		return nil, model.ErrTokenInvalid
 	}
 
-	err = uc.tokenRepo.Revoke(ctx, payload.UserID, payload.TokenID, model.AccessToken)
-	if err != nil {
+	logger := log.WithFields(log.Fields{
+		"userID":  claims.UserID,
+		"tokenID": claims.ID,
+	})
+
+	// checked before the claim so a refused refresh doesn't burn the token
+	err = uc.checkUserStatus(ctx, claims.UserID)
+	switch err {
+	case nil:
+	case model.ErrUserNotFound:
+		return nil, model.ErrTokenInvalid
+	default:
+		return nil, err
+	}
+
+	familyID, err := uc.tokenRepo.ClaimRefreshToken(ctx, claims.UserID, claims.ID)
+	switch err {
+	case nil:
+	case model.ErrTokenReused:
+		logger.WithField("familyID", familyID).Warn("refresh token reuse detected, revoking token family")
+		uc.revokeTokenFamily(ctx, claims.UserID, claims.ID, familyID)
+		return nil, err
+	case model.ErrTokenInvalid:
+		return nil, err
+	default:
 		logger.Error(err.Error())
 		return nil, err
 	}
-	err = uc.tokenRepo.Revoke(ctx, payload.UserID, payload.TokenID, model.RefreshToken)
+
+	err = uc.tokenRepo.Revoke(ctx, claims.UserID, claims.ID, model.AccessToken)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
-	token, err := uc.generateToken(ctx, payload.UserID)
+	token, err := uc.generateToken(ctx, claims.UserID, familyID)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
@@ -206,30 +516,362 @@ func (uc *userUsecase) Logout(ctx context.Context, payload *model.UserLogoutPayl
 		logger.Error(err.Error())
 		return err
 	}
+	// tokens issued before session tracking have no session to end
+	err = uc.tokenRepo.RevokeSession(ctx, payload.UserID, payload.TokenID)
+	if err != nil && err != model.ErrSessionNotFound {
+		logger.Error(err.Error())
+		return err
+	}
 
 	return nil
 }
 
-func (uc *userUsecase) generateToken(ctx context.Context, userID string) (*model.AuthResponse, error) {
+// ChangePassword replace the password of the current user after checking the current one.
+// Other sessions and the personal access tokens are dropped on request, the calling session is kept.
+func (uc *userUsecase) ChangePassword(ctx context.Context, payload *model.ChangePasswordPayload) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID := getUserIDFromCtx(ctx)
+	if userID == constant.GuestID {
+		return model.ErrUnauthorizeAccess
+	}
+	if payload.NewPassword == "" {
+		return model.ErrPasswordRequired
+	}
+
+	metadata := getSessionMetadataFromCtx(ctx)
+	logger := log.WithFields(log.Fields{
+		"userID": userID,
+	}).WithFields(sessionMetadataLogFields(metadata))
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	if user == nil {
+		return model.ErrUserNotFound
+	}
+	if user.DirectoryManaged {
+		return model.ErrDirectoryManagedPassword
+	}
+	if err := utils.ComparePassword(user.Password, payload.CurrentPassword); err != nil {
+		return model.ErrWrongPassword
+	}
+	err = uc.passwordPolicyUC.Validate(ctx, user, payload.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	user.Password, err = utils.HashPassword(payload.NewPassword)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	err = uc.userRepo.UpdateByID(ctx, user)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	// the password is already changed, a missing history entry only weaken the reuse check
+	err = uc.passwordPolicyUC.RecordPassword(ctx, userID, user.Password)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	if payload.RevokeOtherSessions {
+		err = uc.tokenRepo.RevokeAllSessions(ctx, userID, getTokenIDFromCtx(ctx))
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+		err = uc.personalAccessTokenRepo.DeleteByUserID(ctx, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+	}
+
+	err = uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		EventType: model.SecurityEventPasswordChanged,
+		Detail:    fmt.Sprintf("password changed from %s (%s)", metadata.IPAddress, metadata.UserAgent),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+	}
+
+	return nil
+}
+
+// UpdateProfile change the full name of the current user, the username and email are
+// only changed by an admin.
+func (uc *userUsecase) UpdateProfile(ctx context.Context, payload *model.UpdateProfilePayload) (*model.UserInfoResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	userID := getUserIDFromCtx(ctx)
+	if userID == constant.GuestID {
+		return nil, model.ErrUnauthorizeAccess
+	}
+	fullName := strings.TrimSpace(payload.FullName)
+	if fullName == "" {
+		return nil, model.ErrFullNameRequired
+	}
+
+	logger := log.WithFields(log.Fields{
+		"userID": userID,
+	})
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if user == nil {
+		return nil, model.ErrUserNotFound
+	}
+
+	user.FullName = fullName
+	err = uc.userRepo.UpdateByID(ctx, user)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	return user.ToUserInfoResponse(), nil
+}
+
+func (uc *userUsecase) UpdateUser(ctx context.Context, payload *model.UpdateUserPayload) (*model.UserInfoResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := log.WithFields(log.Fields{
+		"sessionUserID": currentUserID,
+		"userID":        payload.UserID,
+	})
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserAll,
+			constant.PermissionUserUpdate,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	var username, email string
+	if payload.Username != "" {
+		username, err = model.NormalizeUsername(payload.Username)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if payload.Email != "" {
+		email, err = model.NormalizeEmail(payload.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, payload.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if user == nil || user.CurrentStatus(time.Now()) == model.UserStatusDeleted {
+		return nil, model.ErrUserNotFound
+	}
+
+	if fullName := strings.TrimSpace(payload.FullName); fullName != "" {
+		user.FullName = fullName
+	}
+	if username != "" && username != user.Username {
+		existingUser, err := uc.userRepo.FindByUsername(ctx, username)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		if existingUser != nil {
+			return nil, model.ErrUsernameOrEmailAlreadyTaken
+		}
+		user.Username = username
+	}
+	emailChanged := email != "" && email != user.Email
+	if emailChanged {
+		existingUser, err := uc.userRepo.FindByEmail(ctx, email)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		if existingUser != nil {
+			return nil, model.ErrUsernameOrEmailAlreadyTaken
+		}
+		user.Email = email
+	}
+
+	err = uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := utils.NewTxContext(ctx, tx)
+
+		err := uc.userRepo.UpdateByID(txCtx, user)
+		if err != nil || !emailChanged {
+			return err
+		}
+
+		// nobody proved the new address belong to the user yet
+		err = uc.userRepo.ResetEmailVerification(txCtx, user)
+		if err != nil {
+			return err
+		}
+		if config.EmailVerificationPolicy() != config.EmailVerificationPolicyRestrict {
+			return nil
+		}
+		return uc.demoteUnverifiedUser(txCtx, user.ID)
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	if emailChanged {
+		// the user can ask for a new link, a failed delivery doesn't fail the update
+		err = uc.emailUC.SendToUser(ctx, user)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+	}
+
+	return user.ToUserInfoResponse(), nil
+}
+
+func (uc *userUsecase) ListUsers(ctx context.Context, payload *model.ListUsersPayload) (*model.ListUsersResponse, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	currentUserID := getUserIDFromCtx(ctx)
+	logger := log.WithFields(log.Fields{
+		"sessionUserID": currentUserID,
+	})
+
+	err := uc.authUC.HasAccess(ctx, &model.HasAccessPayload{
+		UserID: currentUserID,
+		Permissions: []string{
+			constant.PermissionFullAccess,
+			constant.PermissionUserAll,
+			constant.PermissionUserRead,
+		},
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	filter := payload.Filter
+	filter.After, err = model.DecodePageToken(payload.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	// one more user tell whether there is a next page
+	pageSize := model.PageSize(payload.PageSize)
+	filter.Limit = pageSize + 1
+
+	users, err := uc.userRepo.FindAll(ctx, &filter)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	users, nextPageToken := pageOf(users, pageSize, func(user *model.User) *model.PageCursor {
+		return &model.PageCursor{Key: user.CreatedAt.Format(time.RFC3339Nano), ID: user.ID}
+	})
+	res := &model.ListUsersResponse{
+		Users:         make([]*model.UserInfoResponse, 0, len(users)),
+		NextPageToken: nextPageToken,
+	}
+	for _, user := range users {
+		res.Users = append(res.Users, user.ToUserInfoResponse())
+	}
+
+	return res, nil
+}
+
+// generateToken issue a new token pair in the given refresh token family,
+// an empty familyID start a new family.
+func (uc *userUsecase) generateToken(ctx context.Context, userID string, familyID string) (*model.AuthResponse, error) {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
 	defer span.End()
 
 	tokenID := utils.GenerateUUID()
-	accessToken, err := uc.tokenRepo.Create(ctx, userID, tokenID, model.AccessToken)
+	if familyID == "" {
+		familyID = tokenID
+	}
+	metadata := getSessionMetadataFromCtx(ctx)
+	logger := log.WithFields(log.Fields{
+		"userID":   userID,
+		"tokenID":  tokenID,
+		"familyID": familyID,
+	}).WithFields(sessionMetadataLogFields(metadata))
+
+	accessToken, err := uc.tokenRepo.Create(ctx, userID, familyID, tokenID, model.AccessToken, metadata)
 	if err != nil {
 		return nil, err
 	}
-	refreshToken, err := uc.tokenRepo.Create(ctx, userID, tokenID, model.RefreshToken)
+	refreshToken, err := uc.tokenRepo.Create(ctx, userID, familyID, tokenID, model.RefreshToken, metadata)
 	if err != nil {
 		return nil, err
 	}
+	logger.Info("session token issued")
 	return &model.AuthResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 	}, nil
 }
 
+// revokeTokenFamily revoke every token of a family after one of its rotated refresh tokens was replayed.
+// Failures are only logged so the caller always receive ErrTokenReused.
+func (uc *userUsecase) revokeTokenFamily(ctx context.Context, userID string, tokenID string, familyID string) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	metadata := getSessionMetadataFromCtx(ctx)
+	logger := log.WithFields(log.Fields{
+		"userID":   userID,
+		"tokenID":  tokenID,
+		"familyID": familyID,
+	}).WithFields(sessionMetadataLogFields(metadata))
+
+	if familyID != "" {
+		err := uc.tokenRepo.RevokeFamily(ctx, userID, familyID)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+	}
+
+	err := uc.eventRepo.Create(ctx, &model.SecurityEvent{
+		ID:        utils.GenerateUUID(),
+		UserID:    userID,
+		EventType: model.SecurityEventRefreshTokenReuse,
+		Detail: fmt.Sprintf("refresh token %s reused from %s (%s), token family %s revoked",
+			tokenID, metadata.IPAddress, metadata.UserAgent, familyID),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+	}
+}
+
 func (uc *userUsecase) findUserByUsernameOrEmail(ctx context.Context, username string) (*model.User, error) {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
@@ -274,12 +916,90 @@ func (uc *userUsecase) isUsernameOrEmailExist(ctx context.Context, username stri
 	return false, nil
 }
 
-func (uc *userUsecase) addDefaultGroup(ctx context.Context, userID string) error {
+// findAvailableUsername prefer the given username, then the email local part,
+// and append a random suffix when it is already taken.
+func (uc *userUsecase) findAvailableUsername(ctx context.Context, username string, email string) (string, error) {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	if username == "" {
+		username, _, _ = strings.Cut(email, "@")
+	}
+	if username == "" {
+		username = "user"
+	}
+
+	candidate := username
+	for i := 0; i < maxUsernameAttempts; i++ {
+		user, err := uc.userRepo.FindByUsername(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if user == nil {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%s", username, utils.GenerateUUID()[:8])
+	}
+	return "", model.ErrUsernameOrEmailAlreadyTaken
+}
+
+// demoteUnverifiedUser swap the default group for the unverified group, users outside the
+// default group keep their groups.
+func (uc *userUsecase) demoteUnverifiedUser(ctx context.Context, userID string) error {
+	_, _, fn := utils.Trace()
+	ctx, span := utils.NewSpan(ctx, fn)
+	defer span.End()
+
+	defaultGroup, err := uc.groupRepo.FindByName(ctx, constant.GroupDefault)
+	if err != nil {
+		return err
+	}
+	if defaultGroup == nil {
+		return nil
+	}
+
+	membership, err := uc.userGroupRepo.FindByUserIDAndGroupID(ctx, userID, defaultGroup.ID)
+	if err != nil {
+		return err
+	}
+	if membership == nil {
+		return nil
+	}
+
+	err = uc.userGroupRepo.DeleteByUserIDAndGroupID(ctx, userID, defaultGroup.ID)
+	if err != nil {
+		return err
+	}
+
+	unverifiedGroup, err := uc.groupRepo.FindByName(ctx, constant.GroupUnverified)
+	if err != nil {
+		return err
+	}
+	if unverifiedGroup == nil {
+		return model.ErrGroupNotFound
+	}
+
+	membership, err = uc.userGroupRepo.FindByUserIDAndGroupID(ctx, userID, unverifiedGroup.ID)
+	if err != nil {
+		return err
+	}
+	if membership != nil {
+		return nil
+	}
+
+	return uc.userGroupRepo.Create(ctx, &model.UserGroup{
+		UserID:  userID,
+		GroupID: unverifiedGroup.ID,
+	})
+}
+
+func (uc *userUsecase) addGroup(ctx context.Context, userID string, groupName string) error {
 	_, _, fn := utils.Trace()
 	ctx, span := utils.NewSpan(ctx, fn)
 	defer span.End()
 
-	group, err := uc.groupRepo.FindByName(ctx, constant.GroupDefault)
+	group, err := uc.groupRepo.FindByName(ctx, groupName)
 	if err != nil {
 		return err
 	}
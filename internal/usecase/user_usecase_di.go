@@ -7,6 +7,14 @@ import (
 	"gorm.io/gorm"
 )
 
+func (uc *userUsecase) InjectAuthUsecase(usecase model.AuthUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid auth usecase")
+	}
+	uc.authUC = usecase
+	return nil
+}
+
 func (uc *userUsecase) InjectUserRepo(repo model.UserRepository) error {
 	if repo == nil {
 		return errors.New("invalid user repo")
@@ -23,6 +31,14 @@ func (uc *userUsecase) InjectTokenRepo(repo model.TokenRepository) error {
 	return nil
 }
 
+func (uc *userUsecase) InjectPersonalAccessTokenRepo(repo model.PersonalAccessTokenRepository) error {
+	if repo == nil {
+		return errors.New("invalid personal access token repo")
+	}
+	uc.personalAccessTokenRepo = repo
+	return nil
+}
+
 func (uc *userUsecase) InjectDB(db *gorm.DB) error {
 	if db == nil {
 		return errors.New("invalid db")
@@ -46,3 +62,53 @@ func (uc *userUsecase) InjectUserGroupRepo(repo model.UserGroupRepository) error
 	uc.userGroupRepo = repo
 	return nil
 }
+
+func (uc *userUsecase) InjectSecurityEventRepo(repo model.SecurityEventRepository) error {
+	if repo == nil {
+		return errors.New("invalid security event repo")
+	}
+	uc.eventRepo = repo
+	return nil
+}
+
+func (uc *userUsecase) InjectAuthenticators(authenticators ...model.Authenticator) error {
+	for _, authenticator := range authenticators {
+		if authenticator == nil {
+			return errors.New("invalid authenticator")
+		}
+	}
+	uc.authenticators = authenticators
+	return nil
+}
+
+func (uc *userUsecase) InjectMFAUsecase(usecase model.MFAUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid mfa usecase")
+	}
+	uc.mfaUC = usecase
+	return nil
+}
+
+func (uc *userUsecase) InjectEmailVerificationUsecase(usecase model.EmailVerificationUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid email verification usecase")
+	}
+	uc.emailUC = usecase
+	return nil
+}
+
+func (uc *userUsecase) InjectPasswordPolicyUsecase(usecase model.PasswordPolicyUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid password policy usecase")
+	}
+	uc.passwordPolicyUC = usecase
+	return nil
+}
+
+func (uc *userUsecase) InjectLoginAttemptUsecase(usecase model.LoginAttemptUsecase) error {
+	if usecase == nil {
+		return errors.New("invalid login attempt usecase")
+	}
+	uc.loginAttemptUC = usecase
+	return nil
+}
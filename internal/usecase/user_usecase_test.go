@@ -3,14 +3,23 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/mock/gomock"
 	"github.com/krobus00/auth-service/internal/constant"
+	"github.com/krobus00/auth-service/internal/infrastructure"
 	"github.com/krobus00/auth-service/internal/model"
 	"github.com/krobus00/auth-service/internal/model/mock"
+	"github.com/krobus00/auth-service/internal/repository"
 	"github.com/krobus00/auth-service/internal/utils"
+	"github.com/spf13/viper"
 )
 
 func Test_userUsecase_Register(t *testing.T) {
@@ -43,12 +52,22 @@ func Test_userUsecase_Register(t *testing.T) {
 		res string
 		err error
 	}
+	type mockSendVerificationEmail struct {
+		err error
+	}
+	type mockValidatePassword struct {
+		err error
+	}
+	type mockRecordPassword struct {
+		err error
+	}
 	type args struct {
 		payload *model.UserRegistrationPayload
 	}
 	tests := []struct {
 		name                   string
 		args                   args
+		verificationPolicy     string
 		mockFindByUsername     *mockFindByUsername
 		mockFindByEmail        *mockFindByEmail
 		mockCreateUser         *mockCreateUser
@@ -56,6 +75,9 @@ func Test_userUsecase_Register(t *testing.T) {
 		mockCreateUserGroup    *mockCreateUserGroup
 		mockCreateAccessToken  *mockCreateToken
 		mockCreateRefreshToken *mockCreateToken
+		mockSendVerification   *mockSendVerificationEmail
+		mockValidatePassword   *mockValidatePassword
+		mockRecordPassword     *mockRecordPassword
 		wantCommit             bool
 		want                   *model.AuthResponse
 		wantErr                bool
@@ -106,6 +128,170 @@ func Test_userUsecase_Register(t *testing.T) {
 				res: "refresh-token",
 				err: nil,
 			},
+			mockSendVerification: &mockSendVerificationEmail{
+				err: nil,
+			},
+			wantCommit: true,
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: false,
+		},
+		{
+			name:               "success with block policy issue no token",
+			verificationPolicy: "block",
+			args: args{
+				payload: &model.UserRegistrationPayload{
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: nil,
+				err: nil,
+			},
+			mockFindByEmail: &mockFindByEmail{
+				res: nil,
+				err: nil,
+			},
+			mockCreateUser: &mockCreateUser{
+				res: &model.User{
+					ID:       userID,
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+				err: nil,
+			},
+			mockFindGroupByName: &mockFindGroupByName{
+				res: &model.Group{
+					ID:   groupID,
+					Name: constant.GroupDefault,
+				},
+				err: nil,
+			},
+			mockCreateUserGroup: &mockCreateUserGroup{
+				err: nil,
+			},
+			mockSendVerification: &mockSendVerificationEmail{
+				err: nil,
+			},
+			wantCommit: true,
+			want: &model.AuthResponse{
+				EmailVerificationRequired: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:               "success with restrict policy",
+			verificationPolicy: "restrict",
+			args: args{
+				payload: &model.UserRegistrationPayload{
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: nil,
+				err: nil,
+			},
+			mockFindByEmail: &mockFindByEmail{
+				res: nil,
+				err: nil,
+			},
+			mockCreateUser: &mockCreateUser{
+				res: &model.User{
+					ID:       userID,
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+				err: nil,
+			},
+			mockFindGroupByName: &mockFindGroupByName{
+				res: &model.Group{
+					ID:   groupID,
+					Name: constant.GroupUnverified,
+				},
+				err: nil,
+			},
+			mockCreateUserGroup: &mockCreateUserGroup{
+				err: nil,
+			},
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			mockSendVerification: &mockSendVerificationEmail{
+				err: nil,
+			},
+			wantCommit: true,
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success when the verification email fail",
+			args: args{
+				payload: &model.UserRegistrationPayload{
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: nil,
+				err: nil,
+			},
+			mockFindByEmail: &mockFindByEmail{
+				res: nil,
+				err: nil,
+			},
+			mockCreateUser: &mockCreateUser{
+				res: &model.User{
+					ID:       userID,
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+				err: nil,
+			},
+			mockFindGroupByName: &mockFindGroupByName{
+				res: &model.Group{
+					ID:   groupID,
+					Name: constant.GroupDefault,
+				},
+				err: nil,
+			},
+			mockCreateUserGroup: &mockCreateUserGroup{
+				err: nil,
+			},
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			mockSendVerification: &mockSendVerificationEmail{
+				err: errors.New("smtp error"),
+			},
 			wantCommit: true,
 			want: &model.AuthResponse{
 				AccessToken:  "access-token",
@@ -177,6 +363,60 @@ func Test_userUsecase_Register(t *testing.T) {
 			wantCommit: false,
 			wantErr:    true,
 		},
+		{
+			name: "error password policy",
+			args: args{
+				payload: &model.UserRegistrationPayload{
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "user1",
+				},
+			},
+			mockValidatePassword: &mockValidatePassword{
+				err: &model.PasswordPolicyError{
+					Violations: []*model.PasswordPolicyViolation{
+						{Rule: model.PasswordRuleMinLength, Description: "must be at least 8 characters long"},
+						{Rule: model.PasswordRuleSimilarToIdentity, Description: "must not contain the username or email"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error record password",
+			args: args{
+				payload: &model.UserRegistrationPayload{
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: nil,
+				err: nil,
+			},
+			mockFindByEmail: &mockFindByEmail{
+				res: nil,
+				err: nil,
+			},
+			mockCreateUser: &mockCreateUser{
+				res: &model.User{
+					ID:       userID,
+					FullName: "new user",
+					Username: username,
+					Email:    userEmail,
+					Password: "strongpassword",
+				},
+				err: nil,
+			},
+			mockRecordPassword: &mockRecordPassword{
+				err: errors.New("db error"),
+			},
+			wantCommit: false,
+			wantErr:    true,
+		},
 		{
 			name: "error find user by email",
 			args: args{
@@ -376,6 +616,9 @@ func Test_userUsecase_Register(t *testing.T) {
 				res: "",
 				err: errors.New("redis error"),
 			},
+			mockSendVerification: &mockSendVerificationEmail{
+				err: nil,
+			},
 			wantCommit: false,
 			wantErr:    true,
 		},
@@ -425,6 +668,9 @@ func Test_userUsecase_Register(t *testing.T) {
 				res: "",
 				err: errors.New("redis error"),
 			},
+			mockSendVerification: &mockSendVerificationEmail{
+				err: nil,
+			},
 			wantCommit: false,
 			wantErr:    true,
 		},
@@ -435,18 +681,37 @@ func Test_userUsecase_Register(t *testing.T) {
 			defer ctrl.Finish()
 
 			ctx := context.TODO()
+			viper.Set("email_verification.policy", tt.verificationPolicy)
+			defer viper.Set("email_verification.policy", "")
 
 			dbConn, dbMock := utils.NewDBMock()
 			userRepo := mock.NewMockUserRepository(ctrl)
 			tokenRepo := mock.NewMockTokenRepository(ctrl)
 			groupRepo := mock.NewMockGroupRepository(ctrl)
 			userGroupRepo := mock.NewMockUserGroupRepository(ctrl)
+			emailVerificationUC := mock.NewMockEmailVerificationUsecase(ctrl)
+			passwordPolicyUC := mock.NewMockPasswordPolicyUsecase(ctrl)
 
-			dbMock.ExpectBegin()
-			if tt.wantCommit {
-				dbMock.ExpectCommit()
-			} else {
-				dbMock.ExpectRollback()
+			var validateErr error
+			if tt.mockValidatePassword != nil {
+				validateErr = tt.mockValidatePassword.err
+			}
+			passwordPolicyUC.EXPECT().Validate(gomock.Any(), gomock.Any(), tt.args.payload.Password).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, user *model.User, password string) error {
+					if user.Username != tt.args.payload.Username || user.Email != tt.args.payload.Email {
+						t.Errorf("userUsecase.Register() validated the password against %v", user)
+					}
+					return validateErr
+				})
+
+			if validateErr == nil {
+				dbMock.ExpectBegin()
+				if tt.wantCommit {
+					dbMock.ExpectCommit()
+				} else {
+					dbMock.ExpectRollback()
+				}
 			}
 
 			if tt.mockFindByUsername != nil {
@@ -474,9 +739,21 @@ func Test_userUsecase_Register(t *testing.T) {
 					})
 			}
 
+			if tt.mockCreateUser != nil && tt.mockCreateUser.err == nil {
+				var recordErr error
+				if tt.mockRecordPassword != nil {
+					recordErr = tt.mockRecordPassword.err
+				}
+				passwordPolicyUC.EXPECT().RecordPassword(gomock.Any(), userID, gomock.Any()).Times(1).Return(recordErr)
+			}
+
 			if tt.mockFindGroupByName != nil {
+				groupName := constant.GroupDefault
+				if tt.verificationPolicy == "restrict" {
+					groupName = constant.GroupUnverified
+				}
 				groupRepo.EXPECT().
-					FindByName(gomock.Any(), constant.GroupDefault).
+					FindByName(gomock.Any(), groupName).
 					Times(1).
 					Return(tt.mockFindGroupByName.res, tt.mockFindGroupByName.err)
 			}
@@ -486,17 +763,21 @@ func Test_userUsecase_Register(t *testing.T) {
 			}
 
 			if tt.mockCreateAccessToken != nil {
-				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), model.AccessToken).Times(1).DoAndReturn(func(ctx context.Context, userID string, tokenID string, tokenType model.TokenType) (string, error) {
+				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), gomock.Any(), model.AccessToken, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, userID string, familyID string, tokenID string, tokenType model.TokenType, metadata *model.SessionMetadata) (string, error) {
 					return tt.mockCreateAccessToken.res, tt.mockCreateAccessToken.err
 				})
 			}
 
 			if tt.mockCreateRefreshToken != nil {
-				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), model.RefreshToken).Times(1).DoAndReturn(func(ctx context.Context, userID string, tokenID string, tokenType model.TokenType) (string, error) {
+				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), gomock.Any(), model.RefreshToken, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, userID string, familyID string, tokenID string, tokenType model.TokenType, metadata *model.SessionMetadata) (string, error) {
 					return tt.mockCreateRefreshToken.res, tt.mockCreateRefreshToken.err
 				})
 			}
 
+			if tt.mockSendVerification != nil {
+				emailVerificationUC.EXPECT().SendToUser(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockSendVerification.err)
+			}
+
 			uc := NewUserUsecase()
 			err := uc.InjectDB(dbConn)
 			utils.ContinueOrFatal(err)
@@ -508,6 +789,10 @@ func Test_userUsecase_Register(t *testing.T) {
 			utils.ContinueOrFatal(err)
 			err = uc.InjectUserGroupRepo(userGroupRepo)
 			utils.ContinueOrFatal(err)
+			err = uc.InjectEmailVerificationUsecase(emailVerificationUC)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPasswordPolicyUsecase(passwordPolicyUC)
+			utils.ContinueOrFatal(err)
 
 			got, err := uc.Register(ctx, tt.args.payload)
 			if (err != nil) != tt.wantErr {
@@ -528,6 +813,9 @@ func Test_userUsecase_Login(t *testing.T) {
 		username  = "user1"
 	)
 	userPassword, _ := utils.HashPassword("strongpassword")
+	legacyPassword, _ := utils.NewBcryptHasher(4, "").Hash("strongpassword")
+	outdatedPassword, _ := utils.NewArgon2idHasher(8*1024, 1, 1, "").Hash("strongpassword")
+	verifiedAt := time.Now()
 	type mockFindByUsername struct {
 		res *model.User
 		err error
@@ -540,16 +828,31 @@ func Test_userUsecase_Login(t *testing.T) {
 		res string
 		err error
 	}
+	type mockMFAIsEnabled struct {
+		res bool
+		err error
+	}
+	type mockCreateChallenge struct {
+		res string
+		err error
+	}
+	type mockRehash struct {
+		err error
+	}
 	type args struct {
 		payload *model.UserLoginPayload
 	}
 	tests := []struct {
 		name                   string
 		args                   args
+		verificationPolicy     string
 		mockFindByUsername     *mockFindByUsername
 		mockFindByEmail        *mockFindByEmail
+		mockMFAIsEnabled       *mockMFAIsEnabled
+		mockCreateChallenge    *mockCreateChallenge
 		mockCreateAccessToken  *mockCreateToken
 		mockCreateRefreshToken *mockCreateToken
+		mockRehash             *mockRehash
 		want                   *model.AuthResponse
 		wantErr                bool
 	}{
@@ -571,6 +874,9 @@ func Test_userUsecase_Login(t *testing.T) {
 				},
 				err: nil,
 			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
 			mockCreateAccessToken: &mockCreateToken{
 				res: "access-token",
 				err: nil,
@@ -586,27 +892,29 @@ func Test_userUsecase_Login(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "success get user by email",
+			name: "success rehash legacy bcrypt password",
 			args: args{
 				payload: &model.UserLoginPayload{
-					Username: userEmail,
+					Username: username,
 					Password: "strongpassword",
 				},
 			},
 			mockFindByUsername: &mockFindByUsername{
-				res: nil,
-				err: nil,
-			},
-			mockFindByEmail: &mockFindByEmail{
 				res: &model.User{
 					ID:       userID,
 					FullName: "user",
 					Username: username,
 					Email:    userEmail,
-					Password: userPassword,
+					Password: legacyPassword,
 				},
 				err: nil,
 			},
+			mockRehash: &mockRehash{
+				err: nil,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
 			mockCreateAccessToken: &mockCreateToken{
 				res: "access-token",
 				err: nil,
@@ -622,27 +930,231 @@ func Test_userUsecase_Login(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "error user not found",
+			name: "success rehash outdated argon2id parameters",
 			args: args{
 				payload: &model.UserLoginPayload{
-					Username: userEmail,
+					Username: username,
 					Password: "strongpassword",
 				},
 			},
 			mockFindByUsername: &mockFindByUsername{
-				res: nil,
+				res: &model.User{
+					ID:       userID,
+					FullName: "user",
+					Username: username,
+					Email:    userEmail,
+					Password: outdatedPassword,
+				},
 				err: nil,
 			},
-			mockFindByEmail: &mockFindByEmail{
-				res: nil,
+			mockRehash: &mockRehash{
 				err: nil,
 			},
-			wantErr: true,
-		},
-		{
-			name: "error password not match",
-			args: args{
-				payload: &model.UserLoginPayload{
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success when the rehash fail",
+			args: args{
+				payload: &model.UserLoginPayload{
+					Username: username,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: &model.User{
+					ID:       userID,
+					FullName: "user",
+					Username: username,
+					Email:    userEmail,
+					Password: legacyPassword,
+				},
+				err: nil,
+			},
+			mockRehash: &mockRehash{
+				err: errors.New("db error"),
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success get user by email",
+			args: args{
+				payload: &model.UserLoginPayload{
+					Username: userEmail,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: nil,
+				err: nil,
+			},
+			mockFindByEmail: &mockFindByEmail{
+				res: &model.User{
+					ID:       userID,
+					FullName: "user",
+					Username: username,
+					Email:    userEmail,
+					Password: userPassword,
+				},
+				err: nil,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success mfa required",
+			args: args{
+				payload: &model.UserLoginPayload{
+					Username: username,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: &model.User{
+					ID:       userID,
+					FullName: "user",
+					Username: username,
+					Email:    userEmail,
+					Password: userPassword,
+				},
+				err: nil,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: true,
+			},
+			mockCreateChallenge: &mockCreateChallenge{
+				res: "mfa-token",
+				err: nil,
+			},
+			want: &model.AuthResponse{
+				MFARequired: true,
+				MFAToken:    "mfa-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success block policy with verified email",
+			args: args{
+				payload: &model.UserLoginPayload{
+					Username: username,
+					Password: "strongpassword",
+				},
+			},
+			verificationPolicy: "block",
+			mockFindByUsername: &mockFindByUsername{
+				res: &model.User{
+					ID:              userID,
+					FullName:        "user",
+					Username:        username,
+					Email:           userEmail,
+					Password:        userPassword,
+					EmailVerifiedAt: &verifiedAt,
+				},
+				err: nil,
+			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "error block policy with unverified email",
+			args: args{
+				payload: &model.UserLoginPayload{
+					Username: username,
+					Password: "strongpassword",
+				},
+			},
+			verificationPolicy: "block",
+			mockFindByUsername: &mockFindByUsername{
+				res: &model.User{
+					ID:       userID,
+					FullName: "user",
+					Username: username,
+					Email:    userEmail,
+					Password: userPassword,
+				},
+				err: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error user not found",
+			args: args{
+				payload: &model.UserLoginPayload{
+					Username: userEmail,
+					Password: "strongpassword",
+				},
+			},
+			mockFindByUsername: &mockFindByUsername{
+				res: nil,
+				err: nil,
+			},
+			mockFindByEmail: &mockFindByEmail{
+				res: nil,
+				err: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error password not match",
+			args: args{
+				payload: &model.UserLoginPayload{
 					Username: username,
 					Password: "strongpassword",
 				},
@@ -677,6 +1189,9 @@ func Test_userUsecase_Login(t *testing.T) {
 				},
 				err: nil,
 			},
+			mockMFAIsEnabled: &mockMFAIsEnabled{
+				res: false,
+			},
 			mockCreateAccessToken: &mockCreateToken{
 				res: "",
 				err: errors.New("redis error"),
@@ -690,9 +1205,17 @@ func Test_userUsecase_Login(t *testing.T) {
 			defer ctrl.Finish()
 
 			ctx := context.TODO()
+			viper.Set("email_verification.policy", tt.verificationPolicy)
+			defer viper.Set("email_verification.policy", "")
 
 			userRepo := mock.NewMockUserRepository(ctrl)
 			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			mfaUsecase := mock.NewMockMFAUsecase(ctrl)
+			loginAttemptUsecase := mock.NewMockLoginAttemptUsecase(ctrl)
+
+			loginAttemptUsecase.EXPECT().Check(gomock.Any(), tt.args.payload.Username).Times(1).Return(nil)
+			loginAttemptUsecase.EXPECT().RecordSuccess(gomock.Any(), tt.args.payload.Username).AnyTimes().Return(nil)
+			loginAttemptUsecase.EXPECT().RecordFailure(gomock.Any(), tt.args.payload.Username).AnyTimes().Return(nil)
 
 			if tt.mockFindByUsername != nil {
 				userRepo.EXPECT().FindByUsername(gomock.Any(), tt.args.payload.Username).
@@ -706,14 +1229,41 @@ func Test_userUsecase_Login(t *testing.T) {
 					Return(tt.mockFindByEmail.res, tt.mockFindByEmail.err)
 			}
 
+			if tt.mockRehash != nil {
+				userRepo.EXPECT().UpdateByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						if utils.PasswordNeedsRehash(user.Password) {
+							t.Errorf("userUsecase.Login() rehashed into an outdated hash %s", user.Password)
+						}
+						if err := utils.ComparePassword(user.Password, tt.args.payload.Password); err != nil {
+							t.Errorf("userUsecase.Login() rehashed into a hash that doesn't match the password")
+						}
+						return tt.mockRehash.err
+					})
+			}
+
+			// asked by Authenticate to keep the failures of a mfa login, then by Login itself
+			if tt.mockMFAIsEnabled != nil {
+				mfaUsecase.EXPECT().IsEnabled(gomock.Any(), userID).
+					Times(2).
+					Return(tt.mockMFAIsEnabled.res, tt.mockMFAIsEnabled.err)
+			}
+
+			if tt.mockCreateChallenge != nil {
+				mfaUsecase.EXPECT().CreateChallenge(gomock.Any(), userID).
+					Times(1).
+					Return(tt.mockCreateChallenge.res, tt.mockCreateChallenge.err)
+			}
+
 			if tt.mockCreateAccessToken != nil {
-				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), model.AccessToken).Times(1).DoAndReturn(func(ctx context.Context, userID string, tokenID string, tokenType model.TokenType) (string, error) {
+				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), gomock.Any(), model.AccessToken, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, userID string, familyID string, tokenID string, tokenType model.TokenType, metadata *model.SessionMetadata) (string, error) {
 					return tt.mockCreateAccessToken.res, tt.mockCreateAccessToken.err
 				})
 			}
 
 			if tt.mockCreateRefreshToken != nil {
-				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), model.RefreshToken).Times(1).DoAndReturn(func(ctx context.Context, userID string, tokenID string, tokenType model.TokenType) (string, error) {
+				tokenRepo.EXPECT().Create(gomock.Any(), userID, gomock.Any(), gomock.Any(), model.RefreshToken, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, userID string, familyID string, tokenID string, tokenType model.TokenType, metadata *model.SessionMetadata) (string, error) {
 					return tt.mockCreateRefreshToken.res, tt.mockCreateRefreshToken.err
 				})
 			}
@@ -723,6 +1273,10 @@ func Test_userUsecase_Login(t *testing.T) {
 			utils.ContinueOrFatal(err)
 			err = uc.InjectTokenRepo(tokenRepo)
 			utils.ContinueOrFatal(err)
+			err = uc.InjectMFAUsecase(mfaUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectLoginAttemptUsecase(loginAttemptUsecase)
+			utils.ContinueOrFatal(err)
 
 			got, err := uc.Login(ctx, tt.args.payload)
 			if (err != nil) != tt.wantErr {
@@ -775,6 +1329,7 @@ func Test_userUsecase_GetUserInfo(t *testing.T) {
 				FullName: "user",
 				Username: "username",
 				Email:    "user@gmail.com",
+				Status:   model.UserStatusActive,
 			},
 			wantErr: false,
 		},
@@ -839,12 +1394,51 @@ func Test_userUsecase_GetUserInfo(t *testing.T) {
 
 func Test_userUsecase_RefreshToken(t *testing.T) {
 	var (
-		userID  = utils.GenerateUUID()
-		tokenID = utils.GenerateUUID()
+		userID   = utils.GenerateUUID()
+		tokenID  = utils.GenerateUUID()
+		familyID = utils.GenerateUUID()
+		metadata = &model.SessionMetadata{
+			IPAddress:  "10.0.0.1",
+			UserAgent:  "grpc-go/1.54.0",
+			ClientName: "mobile",
+			DeviceID:   "device-1",
+		}
 	)
-	type mockIsValidToken struct {
-		res bool
-		err error
+	viper.Set("jwt.secret_key", "test-secret")
+
+	refreshToken, err := utils.GenerateToken(tokenID, userID, model.RefreshToken, time.Minute)
+	utils.ContinueOrFatal(err)
+	accessToken, err := utils.GenerateToken(tokenID, userID, model.AccessToken, time.Minute)
+	utils.ContinueOrFatal(err)
+	clientRefreshToken, err := utils.GenerateClientToken(tokenID, userID, "oauth_client", model.RefreshToken, time.Minute)
+	utils.ContinueOrFatal(err)
+	// issued before tokens carried their type
+	untypedToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &model.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			Issuer:    "auth-service",
+			ID:        tokenID,
+		},
+		UserID: userID,
+	}).SignedString([]byte("test-secret"))
+	utils.ContinueOrFatal(err)
+	neverExpiringToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &model.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer: "auth-service",
+			ID:     tokenID,
+		},
+		UserID:    userID,
+		TokenType: model.RefreshToken.String(),
+	}).SignedString([]byte("test-secret"))
+	utils.ContinueOrFatal(err)
+
+	type mockFindUser struct {
+		user *model.User
+		err  error
+	}
+	type mockClaimRefreshToken struct {
+		familyID string
+		err      error
 	}
 	type mockRevokeToken struct {
 		err error
@@ -853,38 +1447,43 @@ func Test_userUsecase_RefreshToken(t *testing.T) {
 		res string
 		err error
 	}
+	type mockRevokeFamily struct {
+		err error
+	}
+	type mockCreateSecurityEvent struct {
+		err error
+	}
 	type args struct {
 		payload *model.RefreshTokenPayload
 	}
 	tests := []struct {
-		name                   string
-		args                   args
-		mockIsValidToken       *mockIsValidToken
-		mockRevokeAccessToken  *mockRevokeToken
-		mockRevokeRefreshToken *mockRevokeToken
-		mockCreateAccessToken  *mockCreateToken
-		mockCreateRefreshToken *mockCreateToken
-		want                   *model.AuthResponse
-		wantErr                bool
+		name                    string
+		args                    args
+		verificationPolicy      string
+		mockFindUser            *mockFindUser
+		mockClaimRefreshToken   *mockClaimRefreshToken
+		mockRevokeAccessToken   *mockRevokeToken
+		mockCreateAccessToken   *mockCreateToken
+		mockCreateRefreshToken  *mockCreateToken
+		mockRevokeFamily        *mockRevokeFamily
+		mockCreateSecurityEvent *mockCreateSecurityEvent
+		want                    *model.AuthResponse
+		wantErr                 error
 	}{
 		{
 			name: "success",
 			args: args{
 				payload: &model.RefreshTokenPayload{
-					UserID:  userID,
-					TokenID: tokenID,
+					RefreshToken: refreshToken,
 				},
 			},
-			mockIsValidToken: &mockIsValidToken{
-				res: true,
-				err: nil,
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      nil,
 			},
 			mockRevokeAccessToken: &mockRevokeToken{
 				err: nil,
 			},
-			mockRevokeRefreshToken: &mockRevokeToken{
-				err: nil,
-			},
 			mockCreateAccessToken: &mockCreateToken{
 				res: "access-token",
 				err: nil,
@@ -897,96 +1496,243 @@ func Test_userUsecase_RefreshToken(t *testing.T) {
 				AccessToken:  "access-token",
 				RefreshToken: "refresh-token",
 			},
-			wantErr: false,
+			wantErr: nil,
 		},
 		{
-			name: "error invalid token",
+			name: "success untyped token",
 			args: args{
 				payload: &model.RefreshTokenPayload{
-					UserID:  userID,
-					TokenID: tokenID,
+					RefreshToken: untypedToken,
 				},
 			},
-			mockIsValidToken: &mockIsValidToken{
-				res: false,
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      nil,
+			},
+			mockRevokeAccessToken: &mockRevokeToken{
 				err: nil,
 			},
-			wantErr: true,
+			mockCreateAccessToken: &mockCreateToken{
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
+				res: "refresh-token",
+				err: nil,
+			},
+			want: &model.AuthResponse{
+				AccessToken:  "access-token",
+				RefreshToken: "refresh-token",
+			},
+			wantErr: nil,
 		},
 		{
-			name: "error validate token",
+			name: "error malformed token",
 			args: args{
 				payload: &model.RefreshTokenPayload{
-					UserID:  userID,
-					TokenID: tokenID,
+					RefreshToken: "not-a-jwt",
 				},
 			},
-			mockIsValidToken: &mockIsValidToken{
-				res: false,
-				err: errors.New("error"),
-			},
-			wantErr: true,
+			wantErr: model.ErrTokenMalformed,
 		},
 		{
-			name: "error revoke access token",
+			name: "error token without expiry",
 			args: args{
 				payload: &model.RefreshTokenPayload{
-					UserID:  userID,
-					TokenID: tokenID,
+					RefreshToken: neverExpiringToken,
 				},
 			},
-			mockIsValidToken: &mockIsValidToken{
-				res: true,
-				err: nil,
+			wantErr: model.ErrTokenInvalid,
+		},
+		{
+			name: "error access token used as refresh token",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: accessToken,
+				},
 			},
-			mockRevokeAccessToken: &mockRevokeToken{
-				err: errors.New("error"),
+			wantErr: model.ErrInvalidTokenType,
+		},
+		{
+			name: "error refresh token issued to an oauth client",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: clientRefreshToken,
+				},
 			},
-			wantErr: true,
+			wantErr: model.ErrTokenInvalid,
 		},
 		{
-			name: "error revoke refresh token",
+			name: "error invalid token",
 			args: args{
 				payload: &model.RefreshTokenPayload{
-					UserID:  userID,
-					TokenID: tokenID,
+					RefreshToken: refreshToken,
 				},
 			},
-			mockIsValidToken: &mockIsValidToken{
-				res: true,
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: "",
+				err:      model.ErrTokenInvalid,
+			},
+			wantErr: model.ErrTokenInvalid,
+		},
+		{
+			name: "error suspended user",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockFindUser: &mockFindUser{
+				user: &model.User{ID: userID, Status: model.UserStatusSuspended},
+			},
+			wantErr: model.ErrAccountSuspended,
+		},
+		{
+			name:               "error unverified email with block policy",
+			verificationPolicy: "block",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockFindUser: &mockFindUser{
+				user: &model.User{ID: userID, Status: model.UserStatusActive},
+			},
+			wantErr: model.ErrEmailNotVerified,
+		},
+		{
+			name: "error deleted user",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockFindUser: &mockFindUser{
+				user: nil,
+			},
+			wantErr: model.ErrTokenInvalid,
+		},
+		{
+			name: "error find user",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockFindUser: &mockFindUser{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+		{
+			name: "error claim token",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: "",
+				err:      errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+		{
+			name: "error reused token revoke family",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      model.ErrTokenReused,
+			},
+			mockRevokeFamily: &mockRevokeFamily{
 				err: nil,
 			},
-			mockRevokeAccessToken: &mockRevokeToken{
+			mockCreateSecurityEvent: &mockCreateSecurityEvent{
 				err: nil,
 			},
-			mockRevokeRefreshToken: &mockRevokeToken{
+			wantErr: model.ErrTokenReused,
+		},
+		{
+			name: "error reused token when recording event fail",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      model.ErrTokenReused,
+			},
+			mockRevokeFamily: &mockRevokeFamily{
+				err: errors.New("redis error"),
+			},
+			mockCreateSecurityEvent: &mockCreateSecurityEvent{
+				err: errors.New("db error"),
+			},
+			wantErr: model.ErrTokenReused,
+		},
+		{
+			name: "error revoke access token",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      nil,
+			},
+			mockRevokeAccessToken: &mockRevokeToken{
 				err: errors.New("error"),
 			},
-			wantErr: true,
+			wantErr: errors.New("error"),
 		},
 		{
 			name: "error generate new token",
 			args: args{
 				payload: &model.RefreshTokenPayload{
-					UserID:  userID,
-					TokenID: tokenID,
+					RefreshToken: refreshToken,
 				},
 			},
-			mockIsValidToken: &mockIsValidToken{
-				res: true,
-				err: nil,
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      nil,
 			},
 			mockRevokeAccessToken: &mockRevokeToken{
 				err: nil,
 			},
-			mockRevokeRefreshToken: &mockRevokeToken{
+			mockCreateAccessToken: &mockCreateToken{
+				res: "",
+				err: errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+		{
+			name: "error create refresh token",
+			args: args{
+				payload: &model.RefreshTokenPayload{
+					RefreshToken: refreshToken,
+				},
+			},
+			mockClaimRefreshToken: &mockClaimRefreshToken{
+				familyID: familyID,
+				err:      nil,
+			},
+			mockRevokeAccessToken: &mockRevokeToken{
 				err: nil,
 			},
 			mockCreateAccessToken: &mockCreateToken{
-				res: "",
+				res: "access-token",
+				err: nil,
+			},
+			mockCreateRefreshToken: &mockCreateToken{
 				err: errors.New("redis error"),
 			},
-			wantErr: true,
+			wantErr: errors.New("redis error"),
 		},
 	}
 	for _, tt := range tests {
@@ -995,51 +1741,90 @@ func Test_userUsecase_RefreshToken(t *testing.T) {
 			defer ctrl.Finish()
 
 			ctx := context.TODO()
+			ctx = context.WithValue(ctx, constant.KeySessionMetadataCtx, metadata)
+			viper.Set("email_verification.policy", tt.verificationPolicy)
+			defer viper.Set("email_verification.policy", "")
 
+			userRepo := mock.NewMockUserRepository(ctrl)
 			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			eventRepo := mock.NewMockSecurityEventRepository(ctrl)
 
-			if tt.mockIsValidToken != nil {
-				tokenRepo.EXPECT().
-					IsValidToken(gomock.Any(), tt.args.payload.UserID, tt.args.payload.TokenID, model.RefreshToken).
+			// every claimed token belong to an active user unless told otherwise
+			findUser := tt.mockFindUser
+			if findUser == nil && tt.mockClaimRefreshToken != nil {
+				findUser = &mockFindUser{user: &model.User{ID: userID, Status: model.UserStatusActive}}
+			}
+			if findUser != nil {
+				userRepo.EXPECT().
+					FindByID(gomock.Any(), userID).
 					Times(1).
-					Return(tt.mockIsValidToken.res, tt.mockIsValidToken.err)
+					Return(findUser.user, findUser.err)
 			}
 
-			if tt.mockRevokeAccessToken != nil {
+			if tt.mockClaimRefreshToken != nil {
 				tokenRepo.EXPECT().
-					Revoke(gomock.Any(), tt.args.payload.UserID, tt.args.payload.TokenID, model.AccessToken).AnyTimes().
+					ClaimRefreshToken(gomock.Any(), userID, tokenID).
 					Times(1).
-					Return(tt.mockRevokeAccessToken.err)
+					Return(tt.mockClaimRefreshToken.familyID, tt.mockClaimRefreshToken.err)
 			}
 
-			if tt.mockRevokeRefreshToken != nil {
+			if tt.mockRevokeAccessToken != nil {
 				tokenRepo.EXPECT().
-					Revoke(gomock.Any(), tt.args.payload.UserID, tt.args.payload.TokenID, model.RefreshToken).AnyTimes().
+					Revoke(gomock.Any(), userID, tokenID, model.AccessToken).
 					Times(1).
-					Return(tt.mockRevokeRefreshToken.err)
+					Return(tt.mockRevokeAccessToken.err)
 			}
 
 			if tt.mockCreateAccessToken != nil {
 				tokenRepo.EXPECT().
-					Create(gomock.Any(), tt.args.payload.UserID, gomock.Any(), model.AccessToken).
+					Create(gomock.Any(), userID, familyID, gomock.Any(), model.AccessToken, metadata).
 					Times(1).
 					Return(tt.mockCreateAccessToken.res, tt.mockCreateAccessToken.err)
 			}
 
 			if tt.mockCreateRefreshToken != nil {
 				tokenRepo.EXPECT().
-					Create(gomock.Any(), tt.args.payload.UserID, gomock.Any(), model.RefreshToken).
+					Create(gomock.Any(), userID, familyID, gomock.Any(), model.RefreshToken, metadata).
 					Times(1).
 					Return(tt.mockCreateRefreshToken.res, tt.mockCreateRefreshToken.err)
 			}
 
+			if tt.mockRevokeFamily != nil {
+				tokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), userID, familyID).
+					Times(1).
+					Return(tt.mockRevokeFamily.err)
+			}
+
+			if tt.mockCreateSecurityEvent != nil {
+				eventRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, event *model.SecurityEvent) error {
+						if event.UserID != userID || event.EventType != model.SecurityEventRefreshTokenReuse {
+							t.Errorf("unexpected security event %+v", event)
+						}
+						return tt.mockCreateSecurityEvent.err
+					})
+			}
+
 			uc := NewUserUsecase()
-			err := uc.InjectTokenRepo(tokenRepo)
+			err := uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectSecurityEventRepo(eventRepo)
 			utils.ContinueOrFatal(err)
 
 			got, err := uc.RefreshToken(ctx, tt.args.payload)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("userUsecase.RefreshToken() error = %v, wantErr %v", err, tt.wantErr)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("userUsecase.RefreshToken() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("userUsecase.RefreshToken() unexpected error = %v", err)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
@@ -1065,6 +1850,7 @@ func Test_userUsecase_Logout(t *testing.T) {
 		args                   args
 		mockRevokeAccessToken  *mockRevokeToken
 		mockRevokeRefreshToken *mockRevokeToken
+		mockRevokeSession      *mockRevokeToken
 		wantErr                bool
 	}{
 		{
@@ -1081,6 +1867,28 @@ func Test_userUsecase_Logout(t *testing.T) {
 			mockRevokeRefreshToken: &mockRevokeToken{
 				err: nil,
 			},
+			mockRevokeSession: &mockRevokeToken{
+				err: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success token without session",
+			args: args{
+				payload: &model.UserLogoutPayload{
+					UserID:  userID,
+					TokenID: tokenID,
+				},
+			},
+			mockRevokeAccessToken: &mockRevokeToken{
+				err: nil,
+			},
+			mockRevokeRefreshToken: &mockRevokeToken{
+				err: nil,
+			},
+			mockRevokeSession: &mockRevokeToken{
+				err: model.ErrSessionNotFound,
+			},
 			wantErr: false,
 		},
 		{
@@ -1112,6 +1920,25 @@ func Test_userUsecase_Logout(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error revoke session",
+			args: args{
+				payload: &model.UserLogoutPayload{
+					UserID:  userID,
+					TokenID: tokenID,
+				},
+			},
+			mockRevokeAccessToken: &mockRevokeToken{
+				err: nil,
+			},
+			mockRevokeRefreshToken: &mockRevokeToken{
+				err: nil,
+			},
+			mockRevokeSession: &mockRevokeToken{
+				err: errors.New("error"),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1136,6 +1963,13 @@ func Test_userUsecase_Logout(t *testing.T) {
 					Return(tt.mockRevokeRefreshToken.err)
 			}
 
+			if tt.mockRevokeSession != nil {
+				tokenRepo.EXPECT().
+					RevokeSession(gomock.Any(), tt.args.payload.UserID, tt.args.payload.TokenID).
+					Times(1).
+					Return(tt.mockRevokeSession.err)
+			}
+
 			uc := NewUserUsecase()
 			err := uc.InjectTokenRepo(tokenRepo)
 			utils.ContinueOrFatal(err)
@@ -1146,3 +1980,1023 @@ func Test_userUsecase_Logout(t *testing.T) {
 		})
 	}
 }
+
+func Test_userUsecase_ChangePassword(t *testing.T) {
+	var (
+		userID  = utils.GenerateUUID()
+		tokenID = utils.GenerateUUID()
+	)
+	hashedPassword, err := utils.HashPassword("current-password")
+	utils.ContinueOrFatal(err)
+	type mockFindUserByID struct {
+		res *model.User
+		err error
+	}
+	type mockValidatePassword struct {
+		err error
+	}
+	type mockUpdateUser struct {
+		err error
+	}
+	type mockRevokeAllSessions struct {
+		err error
+	}
+	type mockDeletePersonalAccessTokens struct {
+		err error
+	}
+	tests := []struct {
+		name                           string
+		userID                         string
+		payload                        *model.ChangePasswordPayload
+		mockFindUserByID               *mockFindUserByID
+		mockValidatePassword           *mockValidatePassword
+		mockUpdateUser                 *mockUpdateUser
+		mockRevokeAllSessions          *mockRevokeAllSessions
+		mockDeletePersonalAccessTokens *mockDeletePersonalAccessTokens
+		wantEvent                      bool
+		wantErr                        error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			mockUpdateUser: &mockUpdateUser{},
+			wantEvent:      true,
+		},
+		{
+			name:   "success revoke other sessions",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword:     "current-password",
+				NewPassword:         "new-password",
+				RevokeOtherSessions: true,
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			mockUpdateUser:                 &mockUpdateUser{},
+			mockRevokeAllSessions:          &mockRevokeAllSessions{},
+			mockDeletePersonalAccessTokens: &mockDeletePersonalAccessTokens{},
+			wantEvent:                      true,
+		},
+		{
+			name:   "error guest",
+			userID: constant.GuestID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+			},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name:   "error new password required",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+			},
+			wantErr: model.ErrPasswordRequired,
+		},
+		{
+			name:   "error wrong password",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "wrong-password",
+				NewPassword:     "new-password",
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			wantErr: model.ErrWrongPassword,
+		},
+		{
+			name:   "error password policy",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+				NewPassword:     "current-password",
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			mockValidatePassword: &mockValidatePassword{
+				err: &model.PasswordPolicyError{
+					Violations: []*model.PasswordPolicyViolation{
+						{Rule: model.PasswordRuleReused, Description: "must not be one of the last 5 passwords"},
+					},
+				},
+			},
+			wantErr: errors.New("password doesn't meet the policy: must not be one of the last 5 passwords"),
+		},
+		{
+			name:   "error user not found",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+			},
+			mockFindUserByID: &mockFindUserByID{},
+			wantErr:          model.ErrUserNotFound,
+		},
+		{
+			name:   "error directory managed user",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword, DirectoryManaged: true},
+			},
+			wantErr: model.ErrDirectoryManagedPassword,
+		},
+		{
+			name:   "error update user",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword: "current-password",
+				NewPassword:     "new-password",
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			mockUpdateUser: &mockUpdateUser{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+		{
+			name:   "error revoke other sessions",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword:     "current-password",
+				NewPassword:         "new-password",
+				RevokeOtherSessions: true,
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			mockUpdateUser: &mockUpdateUser{},
+			mockRevokeAllSessions: &mockRevokeAllSessions{
+				err: errors.New("redis error"),
+			},
+			wantErr: errors.New("redis error"),
+		},
+		{
+			name:   "error delete personal access tokens",
+			userID: userID,
+			payload: &model.ChangePasswordPayload{
+				CurrentPassword:     "current-password",
+				NewPassword:         "new-password",
+				RevokeOtherSessions: true,
+			},
+			mockFindUserByID: &mockFindUserByID{
+				res: &model.User{ID: userID, Username: "user1", Email: "user@gmail.com", Password: hashedPassword},
+			},
+			mockUpdateUser:        &mockUpdateUser{},
+			mockRevokeAllSessions: &mockRevokeAllSessions{},
+			mockDeletePersonalAccessTokens: &mockDeletePersonalAccessTokens{
+				err: errors.New("db error"),
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, tt.userID)
+			ctx = context.WithValue(ctx, constant.KeyTokenIDCtx, tokenID)
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			tokenRepo := mock.NewMockTokenRepository(ctrl)
+			personalAccessTokenRepo := mock.NewMockPersonalAccessTokenRepository(ctrl)
+			eventRepo := mock.NewMockSecurityEventRepository(ctrl)
+			passwordPolicyUC := mock.NewMockPasswordPolicyUsecase(ctrl)
+
+			if tt.mockFindUserByID != nil {
+				userRepo.EXPECT().FindByID(gomock.Any(), tt.userID).Times(1).Return(tt.mockFindUserByID.res, tt.mockFindUserByID.err)
+			}
+			if tt.mockValidatePassword != nil || tt.mockUpdateUser != nil {
+				var validateErr error
+				if tt.mockValidatePassword != nil {
+					validateErr = tt.mockValidatePassword.err
+				}
+				passwordPolicyUC.EXPECT().Validate(gomock.Any(), tt.mockFindUserByID.res, tt.payload.NewPassword).Times(1).Return(validateErr)
+			}
+			if tt.mockUpdateUser != nil && tt.mockUpdateUser.err == nil {
+				passwordPolicyUC.EXPECT().RecordPassword(gomock.Any(), tt.userID, gomock.Any()).Times(1).Return(nil)
+			}
+			if tt.mockUpdateUser != nil {
+				userRepo.EXPECT().UpdateByID(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						if err := utils.ComparePassword(user.Password, tt.payload.NewPassword); err != nil {
+							t.Errorf("userUsecase.ChangePassword() stored a hash that doesn't match the new password")
+						}
+						return tt.mockUpdateUser.err
+					})
+			}
+			if tt.mockRevokeAllSessions != nil {
+				// the calling session is kept
+				tokenRepo.EXPECT().RevokeAllSessions(gomock.Any(), tt.userID, tokenID).Times(1).Return(tt.mockRevokeAllSessions.err)
+			}
+			if tt.mockDeletePersonalAccessTokens != nil {
+				personalAccessTokenRepo.EXPECT().DeleteByUserID(gomock.Any(), tt.userID).Times(1).Return(tt.mockDeletePersonalAccessTokens.err)
+			}
+			if tt.wantEvent {
+				eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, event *model.SecurityEvent) error {
+						if event.EventType != model.SecurityEventPasswordChanged {
+							t.Errorf("userUsecase.ChangePassword() event = %s, want %s", event.EventType, model.SecurityEventPasswordChanged)
+						}
+						return nil
+					})
+			}
+
+			uc := NewUserUsecase()
+			err := uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectTokenRepo(tokenRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPersonalAccessTokenRepo(personalAccessTokenRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectSecurityEventRepo(eventRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectPasswordPolicyUsecase(passwordPolicyUC)
+			utils.ContinueOrFatal(err)
+
+			err = uc.ChangePassword(ctx, tt.payload)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userUsecase.ChangePassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_userUsecase_ChangePassword_invalidateUserCache(t *testing.T) {
+	hashedPassword, err := utils.HashPassword("current-password")
+	utils.ContinueOrFatal(err)
+	user := &model.User{
+		ID:       utils.GenerateUUID(),
+		FullName: "full name",
+		Username: "user1",
+		Email:    "user@gmail.com",
+		Password: hashedPassword,
+	}
+
+	dbConn, dbMock := utils.NewDBMock()
+	miniRedis := miniredis.RunT(t)
+	viper.Set("redis.cache_host", fmt.Sprintf("redis://%s", miniRedis.Addr()))
+	redisClient, err := infrastructure.NewRedisClient()
+	utils.ContinueOrFatal(err)
+	userRepo := repository.NewUserRepository()
+	err = userRepo.InjectDB(dbConn)
+	utils.ContinueOrFatal(err)
+	err = userRepo.InjectRedisClient(redisClient)
+	utils.ContinueOrFatal(err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	eventRepo := mock.NewMockSecurityEventRepository(ctrl)
+	eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	passwordPolicyUC := mock.NewMockPasswordPolicyUsecase(ctrl)
+	passwordPolicyUC.EXPECT().Validate(gomock.Any(), gomock.Any(), "new-password").Times(1).Return(nil)
+	passwordPolicyUC.EXPECT().RecordPassword(gomock.Any(), user.ID, gomock.Any()).Times(1).Return(nil)
+
+	uc := NewUserUsecase()
+	err = uc.InjectUserRepo(userRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectSecurityEventRepo(eventRepo)
+	utils.ContinueOrFatal(err)
+	err = uc.InjectPasswordPolicyUsecase(passwordPolicyUC)
+	utils.ContinueOrFatal(err)
+
+	userRows := func(password string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "full_name", "username", "email", "password"}).
+			AddRow(user.ID, user.FullName, user.Username, user.Email, password)
+	}
+
+	// the first read cache the user
+	dbMock.ExpectQuery("^SELECT .+ FROM \"users\"").WithArgs(user.ID).WillReturnRows(userRows(user.Password))
+	_, err = userRepo.FindByID(context.TODO(), user.ID)
+	utils.ContinueOrFatal(err)
+	if !miniRedis.Exists(model.NewUserCacheKeyByID(user.ID)) {
+		t.Fatalf("userRepository.FindByID() didn't cache the user")
+	}
+
+	dbMock.ExpectQuery("^SELECT .+ FROM \"users\"").WithArgs(user.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "email"}).AddRow(user.Username, user.Email))
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("UPDATE \"users\" SET").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, user.ID)
+	err = uc.ChangePassword(ctx, &model.ChangePasswordPayload{
+		CurrentPassword: "current-password",
+		NewPassword:     "new-password",
+	})
+	if err != nil {
+		t.Fatalf("userUsecase.ChangePassword() error = %v", err)
+	}
+	for _, cacheKey := range model.GetUserCacheKeys(user.ID, user.Username, user.Email) {
+		if miniRedis.Exists(cacheKey) {
+			t.Errorf("userUsecase.ChangePassword() kept cache key %s", cacheKey)
+		}
+	}
+
+	// the next read must go back to the database and see the new hash
+	newHashedPassword, err := utils.HashPassword("new-password")
+	utils.ContinueOrFatal(err)
+	dbMock.ExpectQuery("^SELECT .+ FROM \"users\"").WithArgs(user.ID).WillReturnRows(userRows(newHashedPassword))
+	got, err := userRepo.FindByID(context.TODO(), user.ID)
+	utils.ContinueOrFatal(err)
+	if got.Password != newHashedPassword {
+		t.Errorf("userRepository.FindByID() returned the stale password hash")
+	}
+	if err := dbMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_userUsecase_ProvisionUser(t *testing.T) {
+	var (
+		groupID   = utils.GenerateUUID()
+		userEmail = "jane@example.com"
+	)
+	tests := []struct {
+		name             string
+		payload          *model.ProvisionUserPayload
+		existingEmail    bool
+		takenUsernames   []string
+		wantUsername     string
+		wantFullName     string
+		wantUsernameLike string
+		wantErr          error
+	}{
+		{
+			name: "success",
+			payload: &model.ProvisionUserPayload{
+				FullName: "Jane Doe",
+				Username: "jane",
+				Email:    userEmail,
+			},
+			wantUsername: "jane",
+			wantFullName: "Jane Doe",
+		},
+		{
+			name: "success username from email",
+			payload: &model.ProvisionUserPayload{
+				Email: userEmail,
+			},
+			wantUsername: "jane",
+			wantFullName: "jane",
+		},
+		{
+			name: "success username taken",
+			payload: &model.ProvisionUserPayload{
+				Username: "jane",
+				Email:    userEmail,
+			},
+			takenUsernames:   []string{"jane"},
+			wantUsernameLike: "jane-",
+		},
+		{
+			name: "email already taken",
+			payload: &model.ProvisionUserPayload{
+				Username: "jane",
+				Email:    userEmail,
+			},
+			existingEmail: true,
+			wantErr:       model.ErrUsernameOrEmailAlreadyTaken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			groupRepo := mock.NewMockGroupRepository(ctrl)
+			userGroupRepo := mock.NewMockUserGroupRepository(ctrl)
+
+			if tt.existingEmail {
+				userRepo.EXPECT().FindByEmail(gomock.Any(), userEmail).Times(1).Return(&model.User{ID: utils.GenerateUUID()}, nil)
+			} else {
+				userRepo.EXPECT().FindByEmail(gomock.Any(), userEmail).Times(1).Return(nil, nil)
+				userRepo.EXPECT().FindByUsername(gomock.Any(), gomock.Any()).AnyTimes().
+					DoAndReturn(func(ctx context.Context, username string) (*model.User, error) {
+						for _, taken := range tt.takenUsernames {
+							if taken == username {
+								return &model.User{ID: utils.GenerateUUID(), Username: username}, nil
+							}
+						}
+						return nil, nil
+					})
+				userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				groupRepo.EXPECT().FindByName(gomock.Any(), constant.GroupDefault).Times(1).Return(&model.Group{ID: groupID, Name: constant.GroupDefault}, nil)
+				userGroupRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			}
+
+			uc := NewUserUsecase()
+			err := uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupRepo(groupRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserGroupRepo(userGroupRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ProvisionUser(context.TODO(), tt.payload)
+			if err != tt.wantErr {
+				t.Errorf("userUsecase.ProvisionUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Password != "" || got.Email != userEmail {
+				t.Errorf("userUsecase.ProvisionUser() = %v", got)
+			}
+			if tt.wantUsername != "" && (got.Username != tt.wantUsername || got.FullName != tt.wantFullName) {
+				t.Errorf("userUsecase.ProvisionUser() = %v, want username %v full name %v", got, tt.wantUsername, tt.wantFullName)
+			}
+			if tt.wantUsernameLike != "" && (got.Username == "jane" || !strings.HasPrefix(got.Username, tt.wantUsernameLike)) {
+				t.Errorf("userUsecase.ProvisionUser() username = %v, want prefix %v", got.Username, tt.wantUsernameLike)
+			}
+		})
+	}
+}
+
+func Test_userUsecase_Authenticate(t *testing.T) {
+	hashedPassword, err := utils.HashPassword("password")
+	utils.ContinueOrFatal(err)
+	var (
+		localUser     = &model.User{ID: utils.GenerateUUID(), Username: "local", Password: hashedPassword}
+		directoryUser = &model.User{ID: utils.GenerateUUID(), Username: "jane", DirectoryManaged: true}
+		suspendedUser = &model.User{ID: utils.GenerateUUID(), Username: "suspended", Password: hashedPassword, Status: model.UserStatusSuspended}
+		deletedUser   = &model.User{ID: utils.GenerateUUID(), Username: "deleted", Password: hashedPassword, Status: model.UserStatusDeleted}
+	)
+	type mockAuthenticator struct {
+		res *model.User
+		err error
+	}
+	tests := []struct {
+		name              string
+		payload           *model.UserLoginPayload
+		mockCheckErr      error
+		mockLocalUser     *model.User
+		mockAuthenticator *mockAuthenticator
+		mockMFAEnabled    bool
+		mockRecordErr     error
+		// valid credentials are recorded as a success even when the status refuse the login
+		wantRecordSuccess bool
+		want              *model.User
+		wantErr           error
+	}{
+		{
+			name:          "success local password",
+			payload:       &model.UserLoginPayload{Username: "local", Password: "password"},
+			mockLocalUser: localUser,
+			want:          localUser,
+		},
+		{
+			name:           "success with mfa keep the failures until the code is verified",
+			payload:        &model.UserLoginPayload{Username: "local", Password: "password"},
+			mockLocalUser:  localUser,
+			mockMFAEnabled: true,
+			want:           localUser,
+		},
+		{
+			name:              "success authenticator after wrong local password",
+			payload:           &model.UserLoginPayload{Username: "local", Password: "directory-password"},
+			mockLocalUser:     localUser,
+			mockAuthenticator: &mockAuthenticator{res: localUser},
+			want:              localUser,
+		},
+		{
+			name:              "directory managed user skip local password",
+			payload:           &model.UserLoginPayload{Username: "jane", Password: ""},
+			mockLocalUser:     directoryUser,
+			mockAuthenticator: &mockAuthenticator{res: directoryUser},
+			want:              directoryUser,
+		},
+		{
+			name:              "unknown user rejected by every authenticator",
+			payload:           &model.UserLoginPayload{Username: "john", Password: "password"},
+			mockAuthenticator: &mockAuthenticator{err: model.ErrWrongUsernameOrPassword},
+			wantErr:           model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:              "authenticator error",
+			payload:           &model.UserLoginPayload{Username: "john", Password: "password"},
+			mockAuthenticator: &mockAuthenticator{err: model.ErrDirectoryUnavailable},
+			wantErr:           model.ErrDirectoryUnavailable,
+		},
+		{
+			name:              "wrong password when the failure can't be recorded",
+			payload:           &model.UserLoginPayload{Username: "john", Password: "password"},
+			mockAuthenticator: &mockAuthenticator{err: model.ErrWrongUsernameOrPassword},
+			mockRecordErr:     errors.New("redis error"),
+			wantErr:           model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:          "success when the success can't be recorded",
+			payload:       &model.UserLoginPayload{Username: "local", Password: "password"},
+			mockLocalUser: localUser,
+			mockRecordErr: errors.New("redis error"),
+			want:          localUser,
+		},
+		{
+			name:              "error suspended user",
+			payload:           &model.UserLoginPayload{Username: "suspended", Password: "password"},
+			mockLocalUser:     suspendedUser,
+			wantRecordSuccess: true,
+			wantErr:           model.ErrAccountSuspended,
+		},
+		{
+			name:              "deleted user look like a wrong password",
+			payload:           &model.UserLoginPayload{Username: "deleted", Password: "password"},
+			mockLocalUser:     deletedUser,
+			wantRecordSuccess: true,
+			wantErr:           model.ErrWrongUsernameOrPassword,
+		},
+		{
+			name:         "error account locked",
+			payload:      &model.UserLoginPayload{Username: "local", Password: "password"},
+			mockCheckErr: model.ErrAccountLocked,
+			wantErr:      model.ErrAccountLocked,
+		},
+		{
+			name:         "error too many login attempts",
+			payload:      &model.UserLoginPayload{Username: "local", Password: "password"},
+			mockCheckErr: model.ErrTooManyLoginAttempts,
+			wantErr:      model.ErrTooManyLoginAttempts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			authenticator := mock.NewMockAuthenticator(ctrl)
+			loginAttemptUsecase := mock.NewMockLoginAttemptUsecase(ctrl)
+			mfaUsecase := mock.NewMockMFAUsecase(ctrl)
+
+			loginAttemptUsecase.EXPECT().Check(gomock.Any(), tt.payload.Username).Times(1).Return(tt.mockCheckErr)
+			if tt.mockCheckErr == nil {
+				userRepo.EXPECT().FindByUsername(gomock.Any(), tt.payload.Username).Times(1).Return(tt.mockLocalUser, nil)
+			}
+			if tt.mockCheckErr == nil && tt.mockLocalUser == nil {
+				userRepo.EXPECT().FindByEmail(gomock.Any(), tt.payload.Username).Times(1).Return(nil, nil)
+			}
+			if tt.mockAuthenticator != nil {
+				authenticator.EXPECT().Authenticate(gomock.Any(), tt.payload).Times(1).Return(tt.mockAuthenticator.res, tt.mockAuthenticator.err)
+			}
+			switch {
+			case tt.mockCheckErr != nil:
+			case tt.wantErr == nil || tt.wantRecordSuccess:
+				mfaUsecase.EXPECT().IsEnabled(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockMFAEnabled, nil)
+				if !tt.mockMFAEnabled {
+					loginAttemptUsecase.EXPECT().RecordSuccess(gomock.Any(), tt.payload.Username).Times(1).Return(tt.mockRecordErr)
+				}
+			case tt.wantErr == model.ErrWrongUsernameOrPassword:
+				loginAttemptUsecase.EXPECT().RecordFailure(gomock.Any(), tt.payload.Username).Times(1).Return(tt.mockRecordErr)
+			}
+
+			uc := NewUserUsecase()
+			err := uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectAuthenticators(authenticator)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectLoginAttemptUsecase(loginAttemptUsecase)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectMFAUsecase(mfaUsecase)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.Authenticate(context.TODO(), tt.payload)
+			if err != tt.wantErr {
+				t.Errorf("userUsecase.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("userUsecase.Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userUsecase_UpdateProfile(t *testing.T) {
+	user := &model.User{ID: utils.GenerateUUID(), FullName: "full name", Username: "username", Email: "user@gmail.com"}
+
+	tests := []struct {
+		name          string
+		userID        string
+		payload       *model.UpdateProfilePayload
+		wantFind      bool
+		mockUser      *model.User
+		wantUpdate    bool
+		mockUpdateErr error
+		want          string
+		wantErr       error
+	}{
+		{
+			name:       "success",
+			userID:     user.ID,
+			payload:    &model.UpdateProfilePayload{FullName: " new name "},
+			wantFind:   true,
+			mockUser:   user,
+			wantUpdate: true,
+			want:       "new name",
+		},
+		{
+			name:    "error guest",
+			payload: &model.UpdateProfilePayload{FullName: "new name"},
+			wantErr: model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error full name required",
+			userID:  user.ID,
+			payload: &model.UpdateProfilePayload{FullName: " "},
+			wantErr: model.ErrFullNameRequired,
+		},
+		{
+			name:     "error user not found",
+			userID:   user.ID,
+			payload:  &model.UpdateProfilePayload{FullName: "new name"},
+			wantFind: true,
+			wantErr:  model.ErrUserNotFound,
+		},
+		{
+			name:          "error update user",
+			userID:        user.ID,
+			payload:       &model.UpdateProfilePayload{FullName: "new name"},
+			wantFind:      true,
+			mockUser:      user,
+			wantUpdate:    true,
+			mockUpdateErr: errors.New("db error"),
+			wantErr:       errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.TODO()
+			if tt.userID != "" {
+				ctx = context.WithValue(ctx, constant.KeyUserIDCtx, tt.userID)
+			}
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			if tt.wantFind {
+				var mockUser *model.User
+				if tt.mockUser != nil {
+					copied := *tt.mockUser
+					mockUser = &copied
+				}
+				userRepo.EXPECT().FindByID(gomock.Any(), tt.userID).Times(1).Return(mockUser, nil)
+			}
+			if tt.wantUpdate {
+				userRepo.EXPECT().UpdateByID(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockUpdateErr)
+			}
+
+			uc := NewUserUsecase()
+			err := uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.UpdateProfile(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userUsecase.UpdateProfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (got.FullName != tt.want || got.Username != user.Username || got.Email != user.Email) {
+				t.Errorf("userUsecase.UpdateProfile() = %v, want full name %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userUsecase_UpdateUser(t *testing.T) {
+	adminID := utils.GenerateUUID()
+	verifiedAt := time.Now()
+	user := &model.User{ID: utils.GenerateUUID(), FullName: "full name", Username: "username", Email: "user@gmail.com", EmailVerifiedAt: &verifiedAt}
+	deletedUser := &model.User{ID: user.ID, Username: "username", Email: "user@gmail.com", Status: model.UserStatusDeleted}
+	otherUser := &model.User{ID: utils.GenerateUUID(), Username: "taken", Email: "taken@gmail.com"}
+	defaultGroup := &model.Group{ID: utils.GenerateUUID(), Name: constant.GroupDefault}
+	unverifiedGroup := &model.Group{ID: utils.GenerateUUID(), Name: constant.GroupUnverified}
+
+	tests := []struct {
+		name               string
+		payload            *model.UpdateUserPayload
+		verificationPolicy string
+		mockAccessErr      error
+		mockUser           *model.User
+		findUsername       string
+		mockByUsername     *model.User
+		findEmail          string
+		mockByEmail        *model.User
+		wantUpdate         bool
+		mockUpdateErr      error
+		wantReset          bool
+		mockResetErr       error
+		wantDemote         bool
+		want               *model.User
+		wantErr            error
+	}{
+		{
+			name:         "success change every field",
+			payload:      &model.UpdateUserPayload{UserID: user.ID, FullName: "new name", Username: "new-username", Email: "new@gmail.com"},
+			mockUser:     user,
+			findUsername: "new-username",
+			findEmail:    "new@gmail.com",
+			wantUpdate:   true,
+			wantReset:    true,
+			want:         &model.User{FullName: "new name", Username: "new-username", Email: "new@gmail.com"},
+		},
+		{
+			name:         "success username and email are normalized",
+			payload:      &model.UpdateUserPayload{UserID: user.ID, Username: " new.username ", Email: " New@Gmail.com "},
+			mockUser:     user,
+			findUsername: "new.username",
+			findEmail:    "new@gmail.com",
+			wantUpdate:   true,
+			wantReset:    true,
+			want:         &model.User{FullName: "full name", Username: "new.username", Email: "new@gmail.com"},
+		},
+		{
+			name:               "success new email move the user to the unverified group with the restrict policy",
+			payload:            &model.UpdateUserPayload{UserID: user.ID, Email: "new@gmail.com"},
+			verificationPolicy: "restrict",
+			mockUser:           user,
+			findEmail:          "new@gmail.com",
+			wantUpdate:         true,
+			wantReset:          true,
+			wantDemote:         true,
+			want:               &model.User{FullName: "full name", Username: "username", Email: "new@gmail.com"},
+		},
+		{
+			name:       "success empty and unchanged fields are kept",
+			payload:    &model.UpdateUserPayload{UserID: user.ID, Username: "username", Email: "USER@gmail.com"},
+			mockUser:   user,
+			wantUpdate: true,
+			want:       &model.User{FullName: "full name", Username: "username", Email: "user@gmail.com"},
+		},
+		{
+			name:          "error no access",
+			payload:       &model.UpdateUserPayload{UserID: user.ID, FullName: "new name"},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid username",
+			payload: &model.UpdateUserPayload{UserID: user.ID, Username: "new@username"},
+			wantErr: model.ErrInvalidUsername,
+		},
+		{
+			name:    "error invalid email",
+			payload: &model.UpdateUserPayload{UserID: user.ID, Email: "New User <new@gmail.com>"},
+			wantErr: model.ErrInvalidEmail,
+		},
+		{
+			name:    "error user not found",
+			payload: &model.UpdateUserPayload{UserID: user.ID, FullName: "new name"},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name:     "error deleted user",
+			payload:  &model.UpdateUserPayload{UserID: user.ID, FullName: "new name"},
+			mockUser: deletedUser,
+			wantErr:  model.ErrUserNotFound,
+		},
+		{
+			name:           "error username taken",
+			payload:        &model.UpdateUserPayload{UserID: user.ID, Username: "taken"},
+			mockUser:       user,
+			findUsername:   "taken",
+			mockByUsername: otherUser,
+			wantErr:        model.ErrUsernameOrEmailAlreadyTaken,
+		},
+		{
+			name:        "error email taken",
+			payload:     &model.UpdateUserPayload{UserID: user.ID, Email: "taken@gmail.com"},
+			mockUser:    user,
+			findEmail:   "taken@gmail.com",
+			mockByEmail: otherUser,
+			wantErr:     model.ErrUsernameOrEmailAlreadyTaken,
+		},
+		{
+			name:          "error update user",
+			payload:       &model.UpdateUserPayload{UserID: user.ID, FullName: "new name"},
+			mockUser:      user,
+			wantUpdate:    true,
+			mockUpdateErr: errors.New("db error"),
+			wantErr:       errors.New("db error"),
+		},
+		{
+			name:         "error reset verification",
+			payload:      &model.UpdateUserPayload{UserID: user.ID, Email: "new@gmail.com"},
+			mockUser:     user,
+			findEmail:    "new@gmail.com",
+			wantUpdate:   true,
+			wantReset:    true,
+			mockResetErr: errors.New("db error"),
+			wantErr:      errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			viper.Set("email_verification.policy", tt.verificationPolicy)
+			defer viper.Set("email_verification.policy", "")
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, adminID)
+
+			dbConn, dbMock := utils.NewDBMock()
+			authUC := mock.NewMockAuthUsecase(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			groupRepo := mock.NewMockGroupRepository(ctrl)
+			userGroupRepo := mock.NewMockUserGroupRepository(ctrl)
+			emailUC := mock.NewMockEmailVerificationUsecase(ctrl)
+
+			authUC.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.mockAccessErr == nil && tt.wantErr != model.ErrInvalidUsername && tt.wantErr != model.ErrInvalidEmail {
+				var mockUser *model.User
+				if tt.mockUser != nil {
+					copied := *tt.mockUser
+					mockUser = &copied
+				}
+				userRepo.EXPECT().FindByID(gomock.Any(), tt.payload.UserID).Times(1).Return(mockUser, nil)
+			}
+			if tt.findUsername != "" {
+				userRepo.EXPECT().FindByUsername(gomock.Any(), tt.findUsername).Times(1).Return(tt.mockByUsername, nil)
+			}
+			if tt.findEmail != "" {
+				userRepo.EXPECT().FindByEmail(gomock.Any(), tt.findEmail).Times(1).Return(tt.mockByEmail, nil)
+			}
+			if tt.wantUpdate {
+				dbMock.ExpectBegin()
+				userRepo.EXPECT().UpdateByID(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockUpdateErr)
+			}
+			if tt.wantReset {
+				userRepo.EXPECT().ResetEmailVerification(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						if user.Email != tt.findEmail {
+							t.Errorf("userUsecase.UpdateUser() reset the verification of %s, want %s", user.Email, tt.findEmail)
+						}
+						user.EmailVerifiedAt = nil
+						return tt.mockResetErr
+					})
+			}
+			if tt.wantDemote {
+				groupRepo.EXPECT().FindByName(gomock.Any(), constant.GroupDefault).Times(1).Return(defaultGroup, nil)
+				userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), user.ID, defaultGroup.ID).Times(1).
+					Return(&model.UserGroup{UserID: user.ID, GroupID: defaultGroup.ID}, nil)
+				userGroupRepo.EXPECT().DeleteByUserIDAndGroupID(gomock.Any(), user.ID, defaultGroup.ID).Times(1).Return(nil)
+				groupRepo.EXPECT().FindByName(gomock.Any(), constant.GroupUnverified).Times(1).Return(unverifiedGroup, nil)
+				userGroupRepo.EXPECT().FindByUserIDAndGroupID(gomock.Any(), user.ID, unverifiedGroup.ID).Times(1).Return(nil, nil)
+				userGroupRepo.EXPECT().Create(gomock.Any(), &model.UserGroup{UserID: user.ID, GroupID: unverifiedGroup.ID}).Times(1).Return(nil)
+			}
+			if tt.wantUpdate {
+				if tt.wantErr != nil {
+					dbMock.ExpectRollback()
+				} else {
+					dbMock.ExpectCommit()
+				}
+			}
+			if tt.wantReset && tt.wantErr == nil {
+				emailUC.EXPECT().SendToUser(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						if user.Email != tt.findEmail || user.IsEmailVerified() {
+							t.Errorf("userUsecase.UpdateUser() sent a verification of %s, verified %v", user.Email, user.IsEmailVerified())
+						}
+						return nil
+					})
+			}
+
+			uc := NewUserUsecase()
+			err := uc.InjectDB(dbConn)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectAuthUsecase(authUC)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectGroupRepo(groupRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserGroupRepo(userGroupRepo)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectEmailVerificationUsecase(emailUC)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.UpdateUser(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userUsecase.UpdateUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (got.FullName != tt.want.FullName || got.Username != tt.want.Username || got.Email != tt.want.Email) {
+				t.Errorf("userUsecase.UpdateUser() = %v, want %v", got, tt.want)
+			}
+			if err := dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_userUsecase_ListUsers(t *testing.T) {
+	adminID := utils.GenerateUUID()
+	createdAt := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	users := make([]*model.User, 0)
+	for i := 0; i < 3; i++ {
+		users = append(users, &model.User{ID: utils.GenerateUUID(), Username: fmt.Sprintf("user%d", i), CreatedAt: createdAt})
+	}
+	pageToken := model.EncodePageToken(&model.PageCursor{Key: createdAt.Format(time.RFC3339Nano), ID: users[0].ID})
+
+	tests := []struct {
+		name          string
+		payload       *model.ListUsersPayload
+		mockAccessErr error
+		wantFilter    *model.UserFilter
+		mockUsers     []*model.User
+		mockErr       error
+		wantUsers     int
+		wantNext      string
+		wantErr       error
+	}{
+		{
+			name:       "success first page with a next page",
+			payload:    &model.ListUsersPayload{PageSize: 2, Filter: model.UserFilter{Status: model.UserStatusActive}},
+			wantFilter: &model.UserFilter{Status: model.UserStatusActive, Limit: 3},
+			mockUsers:  users,
+			wantUsers:  2,
+			wantNext:   model.EncodePageToken(&model.PageCursor{Key: createdAt.Format(time.RFC3339Nano), ID: users[1].ID}),
+		},
+		{
+			name:       "success last page",
+			payload:    &model.ListUsersPayload{PageToken: pageToken},
+			wantFilter: &model.UserFilter{After: &model.PageCursor{Key: createdAt.Format(time.RFC3339Nano), ID: users[0].ID}, Limit: model.DefaultPageSize + 1},
+			mockUsers:  users[1:],
+			wantUsers:  2,
+		},
+		{
+			name:          "error no access",
+			payload:       &model.ListUsersPayload{},
+			mockAccessErr: model.ErrUnauthorizeAccess,
+			wantErr:       model.ErrUnauthorizeAccess,
+		},
+		{
+			name:    "error invalid page token",
+			payload: &model.ListUsersPayload{PageToken: "not-a-token"},
+			wantErr: model.ErrInvalidPageToken,
+		},
+		{
+			name:       "error find users",
+			payload:    &model.ListUsersPayload{PageSize: 500},
+			wantFilter: &model.UserFilter{Limit: model.MaxPageSize + 1},
+			mockErr:    errors.New("db error"),
+			wantErr:    errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.TODO(), constant.KeyUserIDCtx, adminID)
+
+			authUC := mock.NewMockAuthUsecase(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+
+			authUC.EXPECT().HasAccess(gomock.Any(), gomock.Any()).Times(1).Return(tt.mockAccessErr)
+			if tt.wantFilter != nil {
+				userRepo.EXPECT().FindAll(gomock.Any(), tt.wantFilter).Times(1).Return(tt.mockUsers, tt.mockErr)
+			}
+
+			uc := NewUserUsecase()
+			err := uc.InjectAuthUsecase(authUC)
+			utils.ContinueOrFatal(err)
+			err = uc.InjectUserRepo(userRepo)
+			utils.ContinueOrFatal(err)
+
+			got, err := uc.ListUsers(ctx, tt.payload)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("userUsecase.ListUsers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if len(got.Users) != tt.wantUsers || got.NextPageToken != tt.wantNext {
+				t.Errorf("userUsecase.ListUsers() = %d users, next page %q, want %d users, next page %q",
+					len(got.Users), got.NextPageToken, tt.wantUsers, tt.wantNext)
+			}
+		})
+	}
+}
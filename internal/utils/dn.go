@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// EqualDN compare two distinguished names ignoring case and spacing between components.
+func EqualDN(a string, b string) bool {
+	dnA, err := ldap.ParseDN(a)
+	if err != nil {
+		return strings.EqualFold(a, b)
+	}
+	dnB, err := ldap.ParseDN(b)
+	if err != nil {
+		return strings.EqualFold(a, b)
+	}
+	return dnA.EqualFold(dnB)
+}
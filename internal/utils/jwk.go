@@ -0,0 +1,274 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/krobus00/auth-service/internal/config"
+	"github.com/krobus00/auth-service/internal/model"
+)
+
+var (
+	ErrInvalidKeyFile       = errors.New("invalid key file")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrIDTokenSigningKey    = errors.New("id tokens need an asymmetric signing key")
+	ErrDuplicateKeyID       = errors.New("duplicate key id")
+)
+
+type tokenKey struct {
+	keyID      string
+	method     jwt.SigningMethod
+	privateKey crypto.PrivateKey
+	publicKey  crypto.PublicKey
+}
+
+type tokenKeyRing struct {
+	mu           sync.RWMutex
+	signingKey   *tokenKey
+	verification map[string]*tokenKey
+}
+
+var keyRing = &tokenKeyRing{
+	verification: make(map[string]*tokenKey),
+}
+
+// LoadTokenKeys load the signing key and every verification key from config.
+// With the default HS256 method tokens are signed with jwt.secret_key and no key file is needed.
+func LoadTokenKeys() error {
+	method := jwt.GetSigningMethod(config.TokenSigningMethod())
+	if method == nil {
+		return ErrUnsupportedAlgorithm
+	}
+
+	verification := make(map[string]*tokenKey)
+	var signingKey *tokenKey
+
+	if _, ok := method.(*jwt.SigningMethodHMAC); !ok {
+		privateKey, publicKey, err := readKeyFile(config.TokenSigningKeyFile())
+		if err != nil {
+			return err
+		}
+		if privateKey == nil {
+			return fmt.Errorf("%w: signing key must be a private key", ErrInvalidKeyFile)
+		}
+		signingKey = &tokenKey{
+			keyID:      config.TokenSigningKeyID(),
+			method:     method,
+			privateKey: privateKey,
+			publicKey:  publicKey,
+		}
+		if err := validateKeyMethod(signingKey); err != nil {
+			return err
+		}
+		verification[signingKey.keyID] = signingKey
+	}
+
+	for _, cfg := range config.TokenVerificationKeys() {
+		keyMethod := jwt.GetSigningMethod(cfg.Algorithm)
+		if keyMethod == nil {
+			return ErrUnsupportedAlgorithm
+		}
+		_, publicKey, err := readKeyFile(cfg.File)
+		if err != nil {
+			return err
+		}
+		key := &tokenKey{
+			keyID:     cfg.KeyID,
+			method:    keyMethod,
+			publicKey: publicKey,
+		}
+		if err := validateKeyMethod(key); err != nil {
+			return err
+		}
+		// a token carry only the key id, two keys sharing it couldn't be told apart
+		if _, ok := verification[key.keyID]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateKeyID, key.keyID)
+		}
+		verification[key.keyID] = key
+	}
+
+	keyRing.mu.Lock()
+	defer keyRing.mu.Unlock()
+	keyRing.signingKey = signingKey
+	keyRing.verification = verification
+
+	return nil
+}
+
+// JWKS return every asymmetric verification key as a JSON Web Key Set.
+func JWKS() (*model.JSONWebKeySet, error) {
+	keyRing.mu.RLock()
+	defer keyRing.mu.RUnlock()
+
+	jwks := &model.JSONWebKeySet{
+		Keys: make([]*model.JSONWebKey, 0),
+	}
+	for _, key := range keyRing.verification {
+		jwk, err := toJSONWebKey(key)
+		if err != nil {
+			return nil, err
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].KeyID < jwks.Keys[j].KeyID
+	})
+	return jwks, nil
+}
+
+// TokenSigningAlgorithm return the algorithm tokens are currently signed with.
+func TokenSigningAlgorithm() string {
+	keyRing.mu.RLock()
+	defer keyRing.mu.RUnlock()
+
+	if keyRing.signingKey == nil {
+		return jwt.SigningMethodHS256.Alg()
+	}
+	return keyRing.signingKey.method.Alg()
+}
+
+// IDTokenSigningSupported report whether id tokens can be issued. They are only signed with an
+// asymmetric key, any client could forge one signed with the service wide secret.
+func IDTokenSigningSupported() bool {
+	keyRing.mu.RLock()
+	defer keyRing.mu.RUnlock()
+
+	return keyRing.signingKey != nil
+}
+
+func signToken(token *jwt.Token) (string, error) {
+	keyRing.mu.RLock()
+	signingKey := keyRing.signingKey
+	keyRing.mu.RUnlock()
+
+	if signingKey == nil {
+		token.Method = jwt.SigningMethodHS256
+		return token.SignedString([]byte(config.TokenSecret()))
+	}
+
+	token.Method = signingKey.method
+	token.Header["alg"] = signingKey.method.Alg()
+	if signingKey.keyID != "" {
+		token.Header["kid"] = signingKey.keyID
+	}
+	return token.SignedString(signingKey.privateKey)
+}
+
+func tokenKeyFunc(token *jwt.Token) (interface{}, error) {
+	keyRing.mu.RLock()
+	defer keyRing.mu.RUnlock()
+
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		// shared secret is only accepted while the service itself signs with it, or until the
+		// tokens it signed before the migration expire
+		if token.Method != jwt.SigningMethodHS256 || (keyRing.signingKey != nil && !config.TokenAcceptSharedSecret()) {
+			return nil, errors.New("signing method invalid")
+		}
+		return []byte(config.TokenSecret()), nil
+	}
+
+	keyID, _ := token.Header["kid"].(string)
+	key, ok := keyRing.verification[keyID]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	if key.method.Alg() != token.Method.Alg() {
+		return nil, errors.New("signing method invalid")
+	}
+	return key.publicKey, nil
+}
+
+func readKeyFile(path string) (crypto.PrivateKey, crypto.PublicKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, nil, ErrInvalidKeyFile
+	}
+
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return nil, key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return nil, key, nil
+	}
+
+	var privateKey crypto.PrivateKey
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		privateKey = key
+	} else if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		privateKey = key
+	} else if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		privateKey = key
+	} else {
+		return nil, nil, ErrInvalidKeyFile
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, ErrInvalidKeyFile
+	}
+	return privateKey, signer.Public(), nil
+}
+
+func validateKeyMethod(key *tokenKey) error {
+	var valid bool
+	switch publicKey := key.publicKey.(type) {
+	case *rsa.PublicKey:
+		_, valid = key.method.(*jwt.SigningMethodRSA)
+	case *ecdsa.PublicKey:
+		method, ok := key.method.(*jwt.SigningMethodECDSA)
+		valid = ok && method.CurveBits == publicKey.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		_, valid = key.method.(*jwt.SigningMethodEd25519)
+	}
+	if !valid {
+		return fmt.Errorf("%w: key %s does not match %s", ErrUnsupportedAlgorithm, key.keyID, key.method.Alg())
+	}
+	return nil
+}
+
+func toJSONWebKey(key *tokenKey) (*model.JSONWebKey, error) {
+	jwk := &model.JSONWebKey{
+		KeyID:     key.keyID,
+		Use:       "sig",
+		Algorithm: key.method.Alg(),
+	}
+	switch publicKey := key.publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = encodeBase64URL(publicKey.N.Bytes())
+		jwk.E = encodeBase64URL(big.NewInt(int64(publicKey.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		jwk.KeyType = "EC"
+		jwk.Curve = publicKey.Curve.Params().Name
+		jwk.X = encodeBase64URL(publicKey.X.FillBytes(make([]byte, size)))
+		jwk.Y = encodeBase64URL(publicKey.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = encodeBase64URL(publicKey)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+	return jwk, nil
+}
+
+func encodeBase64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
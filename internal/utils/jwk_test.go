@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/krobus00/auth-service/internal/model"
+	"github.com/spf13/viper"
+)
+
+func writePEMFile(t *testing.T, blockType string, der []byte) string {
+	path := filepath.Join(t.TempDir(), "key.pem")
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	ContinueOrFatal(err)
+	return path
+}
+
+func Test_readKeyFile(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	ContinueOrFatal(err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ContinueOrFatal(err)
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	ContinueOrFatal(err)
+
+	pkcs8 := func(key crypto.PrivateKey) []byte {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		ContinueOrFatal(err)
+		return der
+	}
+	pkix := func(key crypto.PublicKey) []byte {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		ContinueOrFatal(err)
+		return der
+	}
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	ContinueOrFatal(err)
+
+	tests := []struct {
+		name        string
+		path        func(t *testing.T) string
+		wantPrivate bool
+		wantPublic  crypto.PublicKey
+		wantErr     error
+	}{
+		{
+			name:        "pkcs8 rsa private key",
+			path:        func(t *testing.T) string { return writePEMFile(t, "PRIVATE KEY", pkcs8(rsaKey)) },
+			wantPrivate: true,
+			wantPublic:  &rsaKey.PublicKey,
+		},
+		{
+			name: "pkcs1 rsa private key",
+			path: func(t *testing.T) string {
+				return writePEMFile(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+			},
+			wantPrivate: true,
+			wantPublic:  &rsaKey.PublicKey,
+		},
+		{
+			name:        "sec1 ec private key",
+			path:        func(t *testing.T) string { return writePEMFile(t, "EC PRIVATE KEY", ecDER) },
+			wantPrivate: true,
+			wantPublic:  &ecKey.PublicKey,
+		},
+		{
+			name:        "pkcs8 ed25519 private key",
+			path:        func(t *testing.T) string { return writePEMFile(t, "PRIVATE KEY", pkcs8(edKey)) },
+			wantPrivate: true,
+			wantPublic:  edPublicKey,
+		},
+		{
+			name:       "pkix public key",
+			path:       func(t *testing.T) string { return writePEMFile(t, "PUBLIC KEY", pkix(&ecKey.PublicKey)) },
+			wantPublic: &ecKey.PublicKey,
+		},
+		{
+			name: "pkcs1 rsa public key",
+			path: func(t *testing.T) string {
+				return writePEMFile(t, "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey))
+			},
+			wantPublic: &rsaKey.PublicKey,
+		},
+		{
+			name: "not pem",
+			path: func(t *testing.T) string {
+				path := filepath.Join(t.TempDir(), "key.pem")
+				ContinueOrFatal(os.WriteFile(path, []byte("not a key"), 0o600))
+				return path
+			},
+			wantErr: ErrInvalidKeyFile,
+		},
+		{
+			name:    "pem without a key",
+			path:    func(t *testing.T) string { return writePEMFile(t, "CERTIFICATE", []byte("garbage")) },
+			wantErr: ErrInvalidKeyFile,
+		},
+		{
+			name:    "missing file",
+			path:    func(t *testing.T) string { return filepath.Join(t.TempDir(), "missing.pem") },
+			wantErr: os.ErrNotExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, publicKey, err := readKeyFile(tt.path(t))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("readKeyFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (privateKey != nil) != tt.wantPrivate {
+				t.Errorf("readKeyFile() private key = %v, want private %v", privateKey, tt.wantPrivate)
+			}
+			if !reflect.DeepEqual(publicKey, tt.wantPublic) {
+				t.Errorf("readKeyFile() public key = %v, want %v", publicKey, tt.wantPublic)
+			}
+		})
+	}
+}
+
+func Test_toJSONWebKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	ContinueOrFatal(err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ContinueOrFatal(err)
+	edPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	ContinueOrFatal(err)
+
+	decode := func(value string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(value)
+		ContinueOrFatal(err)
+		return b
+	}
+
+	tests := []struct {
+		name    string
+		key     *tokenKey
+		check   func(t *testing.T, jwk *model.JSONWebKey)
+		wantKty string
+		wantErr error
+	}{
+		{
+			name:    "rsa",
+			key:     &tokenKey{keyID: "rsa", method: jwt.SigningMethodRS256, publicKey: &rsaKey.PublicKey},
+			wantKty: "RSA",
+			check: func(t *testing.T, jwk *model.JSONWebKey) {
+				if new(big.Int).SetBytes(decode(jwk.N)).Cmp(rsaKey.N) != 0 || int(new(big.Int).SetBytes(decode(jwk.E)).Int64()) != rsaKey.E {
+					t.Errorf("toJSONWebKey() modulus or exponent don't match the key")
+				}
+			},
+		},
+		{
+			name:    "ec coordinates are padded to the curve size",
+			key:     &tokenKey{keyID: "ec", method: jwt.SigningMethodES256, publicKey: &ecKey.PublicKey},
+			wantKty: "EC",
+			check: func(t *testing.T, jwk *model.JSONWebKey) {
+				x, y := decode(jwk.X), decode(jwk.Y)
+				if jwk.Curve != "P-256" || len(x) != 32 || len(y) != 32 ||
+					new(big.Int).SetBytes(x).Cmp(ecKey.X) != 0 || new(big.Int).SetBytes(y).Cmp(ecKey.Y) != 0 {
+					t.Errorf("toJSONWebKey() curve %s coordinates don't match the key", jwk.Curve)
+				}
+			},
+		},
+		{
+			name:    "ed25519",
+			key:     &tokenKey{keyID: "ed", method: jwt.SigningMethodEdDSA, publicKey: edPublicKey},
+			wantKty: "OKP",
+			check: func(t *testing.T, jwk *model.JSONWebKey) {
+				if jwk.Curve != "Ed25519" || !reflect.DeepEqual(decode(jwk.X), []byte(edPublicKey)) {
+					t.Errorf("toJSONWebKey() curve %s x don't match the key", jwk.Curve)
+				}
+			},
+		},
+		{
+			name:    "unsupported key",
+			key:     &tokenKey{keyID: "hmac", method: jwt.SigningMethodHS256},
+			wantErr: ErrUnsupportedAlgorithm,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toJSONWebKey(tt.key)
+			if err != tt.wantErr {
+				t.Fatalf("toJSONWebKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.KeyID != tt.key.keyID || got.Use != "sig" || got.Algorithm != tt.key.method.Alg() || got.KeyType != tt.wantKty {
+				t.Errorf("toJSONWebKey() = %+v", got)
+			}
+			tt.check(t, got)
+		})
+	}
+}
+
+func Test_LoadTokenKeys_duplicateKeyID(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ContinueOrFatal(err)
+	der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	ContinueOrFatal(err)
+	signingKeyFile := writePEMFile(t, "PRIVATE KEY", der)
+	der, err = x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	ContinueOrFatal(err)
+	publicKeyFile := writePEMFile(t, "PUBLIC KEY", der)
+
+	t.Cleanup(func() {
+		viper.Set("jwt.signing_method", "HS256")
+		viper.Set("jwt.verification_keys", nil)
+		ContinueOrFatal(LoadTokenKeys())
+	})
+
+	tests := []struct {
+		name             string
+		verificationKeys []map[string]string
+	}{
+		{
+			name: "verification key reuse the signing key id",
+			verificationKeys: []map[string]string{
+				{"key_id": "current", "algorithm": "ES256", "file": publicKeyFile},
+			},
+		},
+		{
+			name: "two verification keys share an id",
+			verificationKeys: []map[string]string{
+				{"key_id": "previous", "algorithm": "ES256", "file": publicKeyFile},
+				{"key_id": "previous", "algorithm": "ES256", "file": publicKeyFile},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("jwt.signing_method", "ES256")
+			viper.Set("jwt.signing_key_id", "current")
+			viper.Set("jwt.signing_key_file", signingKeyFile)
+			viper.Set("jwt.verification_keys", tt.verificationKeys)
+
+			if err := LoadTokenKeys(); !errors.Is(err, ErrDuplicateKeyID) {
+				t.Errorf("LoadTokenKeys() error = %v, wantErr %v", err, ErrDuplicateKeyID)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/krobus00/auth-service/internal/config"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2idSaltSize = 16
+	argon2idKeySize  = 32
+)
+
+var (
+	ErrPasswordMismatch        = errors.New("password mismatch")
+	ErrUnknownPasswordHash     = errors.New("unknown password hash format")
+	ErrMalformedPasswordHash   = errors.New("malformed password hash")
+	ErrUnsupportedArgonVersion = errors.New("unsupported argon2 version")
+)
+
+// PasswordHasher is one versioned password hashing scheme. Every hash carry the identifier of
+// the scheme and its parameters, so old hashes stay verifiable when the default change.
+type PasswordHasher interface {
+	// Match report whether hashedPassword was produced by this scheme.
+	Match(hashedPassword string) bool
+	Hash(password string) (string, error)
+	// Verify return ErrPasswordMismatch when password doesn't match hashedPassword.
+	Verify(hashedPassword string, password string) error
+	// NeedsRehash report whether hashedPassword was produced with parameters other than the current ones.
+	NeedsRehash(hashedPassword string) bool
+}
+
+// DefaultPasswordHasher hash every new password.
+func DefaultPasswordHasher() PasswordHasher {
+	return NewArgon2idHasher(config.PasswordHashArgon2Memory(), config.PasswordHashArgon2Iterations(), config.PasswordHashArgon2Parallelism(), config.PasswordHashPepper())
+}
+
+// passwordHashers list every scheme a stored hash may use, the default first.
+func passwordHashers() []PasswordHasher {
+	return []PasswordHasher{
+		DefaultPasswordHasher(),
+		NewBcryptHasher(config.BcryptCost(), config.BcryptSalt()),
+	}
+}
+
+func findPasswordHasher(hashedPassword string) (PasswordHasher, error) {
+	for _, hasher := range passwordHashers() {
+		if hasher.Match(hashedPassword) {
+			return hasher, nil
+		}
+	}
+	return nil, ErrUnknownPasswordHash
+}
+
+func HashPassword(password string) (string, error) {
+	return DefaultPasswordHasher().Hash(password)
+}
+
+func ComparePassword(hashedPassword string, password string) error {
+	hasher, err := findPasswordHasher(hashedPassword)
+	if err != nil {
+		return err
+	}
+	return hasher.Verify(hashedPassword, password)
+}
+
+// PasswordNeedsRehash report whether hashedPassword use an outdated scheme or parameters,
+// it should be replaced by a new hash the next time the password is known.
+func PasswordNeedsRehash(hashedPassword string) bool {
+	if !DefaultPasswordHasher().Match(hashedPassword) {
+		return true
+	}
+	return DefaultPasswordHasher().NeedsRehash(hashedPassword)
+}
+
+type argon2idHasher struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	pepper      string
+}
+
+// NewArgon2idHasher hash passwords into the PHC string format
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>.
+// The password is keyed with the pepper through HMAC-SHA256 before hashing.
+func NewArgon2idHasher(memory uint32, iterations uint32, parallelism uint8, pepper string) PasswordHasher {
+	return &argon2idHasher{
+		memory:      memory,
+		iterations:  iterations,
+		parallelism: parallelism,
+		pepper:      pepper,
+	}
+}
+
+func (h *argon2idHasher) Match(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, "$argon2id$")
+}
+
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey(h.pepperPassword(password), salt, h.iterations, h.memory, h.parallelism, argon2idKeySize)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.memory, h.iterations, h.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *argon2idHasher) Verify(hashedPassword string, password string) error {
+	params, salt, key, err := decodeArgon2idHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+	otherKey := argon2.IDKey(h.pepperPassword(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+func (h *argon2idHasher) NeedsRehash(hashedPassword string) bool {
+	params, _, key, err := decodeArgon2idHash(hashedPassword)
+	if err != nil {
+		return true
+	}
+	return params.memory != h.memory ||
+		params.iterations != h.iterations ||
+		params.parallelism != h.parallelism ||
+		len(key) != argon2idKeySize
+}
+
+func (h *argon2idHasher) pepperPassword(password string) []byte {
+	mac := hmac.New(sha256.New, []byte(h.pepper))
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+func decodeArgon2idHash(hashedPassword string) (*argon2idParams, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, key
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, ErrMalformedPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, ErrMalformedPasswordHash
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, ErrUnsupportedArgonVersion
+	}
+
+	params := new(argon2idParams)
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return nil, nil, nil, ErrMalformedPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrMalformedPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrMalformedPasswordHash
+	}
+
+	return params, salt, key, nil
+}
+
+type bcryptHasher struct {
+	cost int
+	salt string
+}
+
+// NewBcryptHasher handle the hashes made before argon2id, where the configured salt is
+// appended to the password.
+func NewBcryptHasher(cost int, salt string) PasswordHasher {
+	return &bcryptHasher{
+		cost: cost,
+		salt: salt,
+	}
+}
+
+func (h *bcryptHasher) Match(hashedPassword string) bool {
+	_, err := bcrypt.Cost([]byte(hashedPassword))
+	return err == nil
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password+h.salt), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashedPassword), nil
+}
+
+func (h *bcryptHasher) Verify(hashedPassword string, password string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password+h.salt))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrPasswordMismatch
+	}
+	return err
+}
+
+func (h *bcryptHasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	return err != nil || cost != h.cost
+}
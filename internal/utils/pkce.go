@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+const (
+	codeVerifierMinLength = 43
+	codeVerifierMaxLength = 128
+)
+
+// NewCodeChallenge derive the S256 PKCE challenge of a code verifier.
+func NewCodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// VerifyCodeChallenge check the code verifier against the S256 challenge sent to /authorize.
+func VerifyCodeChallenge(codeChallenge string, codeVerifier string) bool {
+	if len(codeVerifier) < codeVerifierMinLength || len(codeVerifier) > codeVerifierMaxLength {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(NewCodeChallenge(codeVerifier)), []byte(codeChallenge)) == 1
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const secretSize = 32
+
+// GenerateSecret return a random url safe secret with the given prefix.
+func GenerateSecret(prefix string) (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashSecret hash a generated secret for storage and lookup.
+// Secrets are high entropy so a fast hash is enough, unlike passwords.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// CompareSecret report whether the secret match the stored hash in constant time.
+func CompareSecret(hashedSecret string, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(HashSecret(secret))) == 1
+}
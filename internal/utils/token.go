@@ -6,24 +6,32 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
-	"github.com/krobus00/auth-service/internal/config"
 	"github.com/krobus00/auth-service/internal/model"
 )
 
-func GenerateToken(tokenID string, userID string, expDuration time.Duration) (string, error) {
+const TokenIssuer = "auth-service"
+
+func GenerateToken(tokenID string, userID string, tokenType model.TokenType, expDuration time.Duration) (string, error) {
+	return GenerateClientToken(tokenID, userID, "", tokenType, expDuration)
+}
+
+// GenerateClientToken sign a token issued to an OAuth client, the client is carried in the clientID claim.
+func GenerateClientToken(tokenID string, userID string, clientID string, tokenType model.TokenType, expDuration time.Duration) (string, error) {
 	claims := model.JWTClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expDuration)),
-			Issuer:    "auth-service",
+			Issuer:    TokenIssuer,
 			ID:        tokenID,
 		},
-		UserID: userID,
+		UserID:    userID,
+		TokenType: tokenType.String(),
+		ClientID:  clientID,
 	}
 	token := jwt.NewWithClaims(
 		jwt.SigningMethodHS256,
 		claims,
 	)
-	accessToken, err := token.SignedString([]byte(config.TokenSecret()))
+	accessToken, err := signToken(token)
 	if err != nil {
 		return "", err
 	}
@@ -31,18 +39,23 @@ func GenerateToken(tokenID string, userID string, expDuration time.Duration) (st
 	return accessToken, nil
 }
 
-func ParseToken(tokenString string) (*jwt.Token, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if method, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("signing method invalid")
-		} else if method != jwt.SigningMethodHS256 {
-			return nil, errors.New("signing method invalid")
-		}
+// GenerateIDToken sign the OpenID Connect id token with the access token signing key, which must be
+// an asymmetric one.
+func GenerateIDToken(claims *model.IDTokenClaims) (string, error) {
+	if !IDTokenSigningSupported() {
+		return "", ErrIDTokenSigningKey
+	}
+	token := jwt.NewWithClaims(
+		jwt.SigningMethodHS256,
+		claims,
+	)
+	return signToken(token)
+}
 
-		return []byte(config.TokenSecret()), nil
-	})
+func ParseToken(tokenString string) (*jwt.Token, error) {
+	token, err := jwt.Parse(tokenString, tokenKeyFunc)
 	if err != nil {
-		return nil, err
+		return nil, parseTokenError(err)
 	}
 	if !token.Valid {
 		return nil, model.ErrTokenInvalid
@@ -51,6 +64,21 @@ func ParseToken(tokenString string) (*jwt.Token, error) {
 	return token, nil
 }
 
+// ParseTokenClaims verify the token signature and expiry then return the typed claims.
+func ParseTokenClaims(tokenString string) (*model.JWTClaims, error) {
+	claims := new(model.JWTClaims)
+	token, err := jwt.ParseWithClaims(tokenString, claims, tokenKeyFunc)
+	if err != nil {
+		return nil, parseTokenError(err)
+	}
+	// the expiry is optional for the jwt library, every token issued here carry one
+	if !token.Valid || claims.ExpiresAt == nil {
+		return nil, model.ErrTokenInvalid
+	}
+
+	return claims, nil
+}
+
 func GetUserID(token *jwt.Token) (string, error) {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
@@ -74,3 +102,14 @@ func GetTokenID(token *jwt.Token) (string, error) {
 	}
 	return fmt.Sprintf("%v", val), nil
 }
+
+func parseTokenError(err error) error {
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return model.ErrTokenExpired
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return model.ErrTokenMalformed
+	default:
+		return model.ErrTokenInvalid
+	}
+}
@@ -35,11 +35,25 @@ var file_pb_auth_auth_service_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x6d,
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x70, 0x62,
 	0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x70,
+	0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1d, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x13, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6d, 0x66, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xa8, 0x0c, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x6f, 0x74, 0x6f, 0x32, 0xcb, 0x28, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74,
 	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
@@ -52,72 +66,144 @@ var file_pb_auth_auth_service_proto_rawDesc = []byte{
 	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
 	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b, 0x53, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74,
+	0x4a, 0x57, 0x4b, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
 	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
-	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12,
-	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x0a, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x4f, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x00, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x70,
+	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x62,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x70, 0x62,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x13, 0x46, 0x69,
+	0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x62,
 	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e,
-	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x62,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x71, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x70, 0x62,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
 	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
 	0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f,
@@ -137,88 +223,387 @@ var file_pb_auth_auth_service_proto_rawDesc = []byte{
 	0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65,
 	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x09,
-	0x5a, 0x07, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70,
+	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29,
+	0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x19, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
+	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12,
+	0x26, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x00, 0x12, 0x56, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1b, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
+	0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74,
+	0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x55, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x09, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x4d, 0x46, 0x41, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4d, 0x46, 0x41, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4d, 0x46, 0x41,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x46, 0x41, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x42, 0x09, 0x5a, 0x07, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_pb_auth_auth_service_proto_goTypes = []interface{}{
-	(*GetUserInfoRequest)(nil),           // 0: pb.auth.GetUserInfoRequest
-	(*HasAccessRequest)(nil),             // 1: pb.auth.HasAccessRequest
-	(*RefreshTokenRequest)(nil),          // 2: pb.auth.RefreshTokenRequest
-	(*LoginRequest)(nil),                 // 3: pb.auth.LoginRequest
-	(*RegisterRequest)(nil),              // 4: pb.auth.RegisterRequest
-	(*LogoutRequest)(nil),                // 5: pb.auth.LogoutRequest
-	(*FindPermissionByIDRequest)(nil),    // 6: pb.auth.FindPermissionByIDRequest
-	(*FindPermissionByNameRequest)(nil),  // 7: pb.auth.FindPermissionByNameRequest
-	(*CreatePermissionRequest)(nil),      // 8: pb.auth.CreatePermissionRequest
-	(*DeletePermissionRequest)(nil),      // 9: pb.auth.DeletePermissionRequest
-	(*FindGroupByIDRequest)(nil),         // 10: pb.auth.FindGroupByIDRequest
-	(*FindGroupByNameRequest)(nil),       // 11: pb.auth.FindGroupByNameRequest
-	(*CreateGroupRequest)(nil),           // 12: pb.auth.CreateGroupRequest
-	(*DeleteGroupRequest)(nil),           // 13: pb.auth.DeleteGroupRequest
-	(*FindGroupPermissionRequest)(nil),   // 14: pb.auth.FindGroupPermissionRequest
-	(*CreateGroupPermissionRequest)(nil), // 15: pb.auth.CreateGroupPermissionRequest
-	(*DeleteGroupPermissionRequest)(nil), // 16: pb.auth.DeleteGroupPermissionRequest
-	(*FindAllUserGroupsRequest)(nil),     // 17: pb.auth.FindAllUserGroupsRequest
-	(*FindUserGroupRequest)(nil),         // 18: pb.auth.FindUserGroupRequest
-	(*CreateUserGroupRequest)(nil),       // 19: pb.auth.CreateUserGroupRequest
-	(*DeleteUserGroupRequest)(nil),       // 20: pb.auth.DeleteUserGroupRequest
-	(*User)(nil),                         // 21: pb.auth.User
-	(*wrapperspb.BoolValue)(nil),         // 22: google.protobuf.BoolValue
-	(*AuthResponse)(nil),                 // 23: pb.auth.AuthResponse
-	(*emptypb.Empty)(nil),                // 24: google.protobuf.Empty
-	(*Permission)(nil),                   // 25: pb.auth.Permission
-	(*Group)(nil),                        // 26: pb.auth.Group
-	(*GroupPermission)(nil),              // 27: pb.auth.GroupPermission
-	(*FindAllUserGroupsResponse)(nil),    // 28: pb.auth.FindAllUserGroupsResponse
-	(*UserGroup)(nil),                    // 29: pb.auth.UserGroup
+	(*GetUserInfoRequest)(nil),                  // 0: pb.auth.GetUserInfoRequest
+	(*HasAccessRequest)(nil),                    // 1: pb.auth.HasAccessRequest
+	(*RefreshTokenRequest)(nil),                 // 2: pb.auth.RefreshTokenRequest
+	(*ValidateTokenRequest)(nil),                // 3: pb.auth.ValidateTokenRequest
+	(*emptypb.Empty)(nil),                       // 4: google.protobuf.Empty
+	(*LoginRequest)(nil),                        // 5: pb.auth.LoginRequest
+	(*RegisterRequest)(nil),                     // 6: pb.auth.RegisterRequest
+	(*LogoutRequest)(nil),                       // 7: pb.auth.LogoutRequest
+	(*ChangePasswordRequest)(nil),               // 8: pb.auth.ChangePasswordRequest
+	(*UpdateProfileRequest)(nil),                // 9: pb.auth.UpdateProfileRequest
+	(*UnlockUserRequest)(nil),                   // 10: pb.auth.UnlockUserRequest
+	(*SuspendUserRequest)(nil),                  // 11: pb.auth.SuspendUserRequest
+	(*DeactivateUserRequest)(nil),               // 12: pb.auth.DeactivateUserRequest
+	(*ReactivateUserRequest)(nil),               // 13: pb.auth.ReactivateUserRequest
+	(*DeleteUserRequest)(nil),                   // 14: pb.auth.DeleteUserRequest
+	(*UpdateUserRequest)(nil),                   // 15: pb.auth.UpdateUserRequest
+	(*ListUsersRequest)(nil),                    // 16: pb.auth.ListUsersRequest
+	(*FindPermissionByIDRequest)(nil),           // 17: pb.auth.FindPermissionByIDRequest
+	(*FindPermissionByNameRequest)(nil),         // 18: pb.auth.FindPermissionByNameRequest
+	(*CreatePermissionRequest)(nil),             // 19: pb.auth.CreatePermissionRequest
+	(*DeletePermissionRequest)(nil),             // 20: pb.auth.DeletePermissionRequest
+	(*ListPermissionsRequest)(nil),              // 21: pb.auth.ListPermissionsRequest
+	(*FindGroupByIDRequest)(nil),                // 22: pb.auth.FindGroupByIDRequest
+	(*FindGroupByNameRequest)(nil),              // 23: pb.auth.FindGroupByNameRequest
+	(*CreateGroupRequest)(nil),                  // 24: pb.auth.CreateGroupRequest
+	(*DeleteGroupRequest)(nil),                  // 25: pb.auth.DeleteGroupRequest
+	(*ListGroupsRequest)(nil),                   // 26: pb.auth.ListGroupsRequest
+	(*FindGroupPermissionRequest)(nil),          // 27: pb.auth.FindGroupPermissionRequest
+	(*CreateGroupPermissionRequest)(nil),        // 28: pb.auth.CreateGroupPermissionRequest
+	(*DeleteGroupPermissionRequest)(nil),        // 29: pb.auth.DeleteGroupPermissionRequest
+	(*ListGroupPermissionsRequest)(nil),         // 30: pb.auth.ListGroupPermissionsRequest
+	(*ListGroupsWithPermissionRequest)(nil),     // 31: pb.auth.ListGroupsWithPermissionRequest
+	(*FindAllUserGroupsRequest)(nil),            // 32: pb.auth.FindAllUserGroupsRequest
+	(*FindUserGroupRequest)(nil),                // 33: pb.auth.FindUserGroupRequest
+	(*CreateUserGroupRequest)(nil),              // 34: pb.auth.CreateUserGroupRequest
+	(*DeleteUserGroupRequest)(nil),              // 35: pb.auth.DeleteUserGroupRequest
+	(*ListGroupMembersRequest)(nil),             // 36: pb.auth.ListGroupMembersRequest
+	(*ListUsersWithPermissionRequest)(nil),      // 37: pb.auth.ListUsersWithPermissionRequest
+	(*ListSessionsRequest)(nil),                 // 38: pb.auth.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),                // 39: pb.auth.RevokeSessionRequest
+	(*RevokeAllSessionsRequest)(nil),            // 40: pb.auth.RevokeAllSessionsRequest
+	(*CreatePersonalAccessTokenRequest)(nil),    // 41: pb.auth.CreatePersonalAccessTokenRequest
+	(*RevokePersonalAccessTokenRequest)(nil),    // 42: pb.auth.RevokePersonalAccessTokenRequest
+	(*ClientCredentialsRequest)(nil),            // 43: pb.auth.ClientCredentialsRequest
+	(*CreateServiceAccountRequest)(nil),         // 44: pb.auth.CreateServiceAccountRequest
+	(*FindServiceAccountByIDRequest)(nil),       // 45: pb.auth.FindServiceAccountByIDRequest
+	(*DeleteServiceAccountRequest)(nil),         // 46: pb.auth.DeleteServiceAccountRequest
+	(*FindAllServiceAccountGroupsRequest)(nil),  // 47: pb.auth.FindAllServiceAccountGroupsRequest
+	(*ServiceAccountGroupRequest)(nil),          // 48: pb.auth.ServiceAccountGroupRequest
+	(*CreateOAuthClientRequest)(nil),            // 49: pb.auth.CreateOAuthClientRequest
+	(*DeleteOAuthClientRequest)(nil),            // 50: pb.auth.DeleteOAuthClientRequest
+	(*LinkUserIdentityRequest)(nil),             // 51: pb.auth.LinkUserIdentityRequest
+	(*UnlinkUserIdentityRequest)(nil),           // 52: pb.auth.UnlinkUserIdentityRequest
+	(*ConfirmMFARequest)(nil),                   // 53: pb.auth.ConfirmMFARequest
+	(*DisableMFARequest)(nil),                   // 54: pb.auth.DisableMFARequest
+	(*VerifyMFARequest)(nil),                    // 55: pb.auth.VerifyMFARequest
+	(*SendVerificationEmailRequest)(nil),        // 56: pb.auth.SendVerificationEmailRequest
+	(*VerifyEmailRequest)(nil),                  // 57: pb.auth.VerifyEmailRequest
+	(*RequestPasswordResetRequest)(nil),         // 58: pb.auth.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),                // 59: pb.auth.ResetPasswordRequest
+	(*User)(nil),                                // 60: pb.auth.User
+	(*wrapperspb.BoolValue)(nil),                // 61: google.protobuf.BoolValue
+	(*AuthResponse)(nil),                        // 62: pb.auth.AuthResponse
+	(*ValidateTokenResponse)(nil),               // 63: pb.auth.ValidateTokenResponse
+	(*GetJWKSResponse)(nil),                     // 64: pb.auth.GetJWKSResponse
+	(*ListUsersResponse)(nil),                   // 65: pb.auth.ListUsersResponse
+	(*Permission)(nil),                          // 66: pb.auth.Permission
+	(*ListPermissionsResponse)(nil),             // 67: pb.auth.ListPermissionsResponse
+	(*Group)(nil),                               // 68: pb.auth.Group
+	(*ListGroupsResponse)(nil),                  // 69: pb.auth.ListGroupsResponse
+	(*GroupPermission)(nil),                     // 70: pb.auth.GroupPermission
+	(*ListGroupPermissionsResponse)(nil),        // 71: pb.auth.ListGroupPermissionsResponse
+	(*ListGroupsWithPermissionResponse)(nil),    // 72: pb.auth.ListGroupsWithPermissionResponse
+	(*FindAllUserGroupsResponse)(nil),           // 73: pb.auth.FindAllUserGroupsResponse
+	(*UserGroup)(nil),                           // 74: pb.auth.UserGroup
+	(*ListGroupMembersResponse)(nil),            // 75: pb.auth.ListGroupMembersResponse
+	(*ListUsersWithPermissionResponse)(nil),     // 76: pb.auth.ListUsersWithPermissionResponse
+	(*ListSessionsResponse)(nil),                // 77: pb.auth.ListSessionsResponse
+	(*CreatePersonalAccessTokenResponse)(nil),   // 78: pb.auth.CreatePersonalAccessTokenResponse
+	(*ListPersonalAccessTokensResponse)(nil),    // 79: pb.auth.ListPersonalAccessTokensResponse
+	(*CreateServiceAccountResponse)(nil),        // 80: pb.auth.CreateServiceAccountResponse
+	(*ServiceAccount)(nil),                      // 81: pb.auth.ServiceAccount
+	(*FindAllServiceAccountGroupsResponse)(nil), // 82: pb.auth.FindAllServiceAccountGroupsResponse
+	(*ServiceAccountGroup)(nil),                 // 83: pb.auth.ServiceAccountGroup
+	(*CreateOAuthClientResponse)(nil),           // 84: pb.auth.CreateOAuthClientResponse
+	(*FindAllUserIdentitiesResponse)(nil),       // 85: pb.auth.FindAllUserIdentitiesResponse
+	(*LinkUserIdentityResponse)(nil),            // 86: pb.auth.LinkUserIdentityResponse
+	(*EnrollMFAResponse)(nil),                   // 87: pb.auth.EnrollMFAResponse
+	(*ConfirmMFAResponse)(nil),                  // 88: pb.auth.ConfirmMFAResponse
 }
 var file_pb_auth_auth_service_proto_depIdxs = []int32{
 	0,  // 0: pb.auth.AuthService.GetUserInfo:input_type -> pb.auth.GetUserInfoRequest
 	1,  // 1: pb.auth.AuthService.HasAccess:input_type -> pb.auth.HasAccessRequest
 	2,  // 2: pb.auth.AuthService.RefreshToken:input_type -> pb.auth.RefreshTokenRequest
-	3,  // 3: pb.auth.AuthService.Login:input_type -> pb.auth.LoginRequest
-	4,  // 4: pb.auth.AuthService.Register:input_type -> pb.auth.RegisterRequest
-	5,  // 5: pb.auth.AuthService.Logout:input_type -> pb.auth.LogoutRequest
-	6,  // 6: pb.auth.AuthService.FindPermissionByID:input_type -> pb.auth.FindPermissionByIDRequest
-	7,  // 7: pb.auth.AuthService.FindPermissionByName:input_type -> pb.auth.FindPermissionByNameRequest
-	8,  // 8: pb.auth.AuthService.CreatePermission:input_type -> pb.auth.CreatePermissionRequest
-	9,  // 9: pb.auth.AuthService.DeletePermission:input_type -> pb.auth.DeletePermissionRequest
-	10, // 10: pb.auth.AuthService.FindGroupByID:input_type -> pb.auth.FindGroupByIDRequest
-	11, // 11: pb.auth.AuthService.FindGroupByName:input_type -> pb.auth.FindGroupByNameRequest
-	12, // 12: pb.auth.AuthService.CreateGroup:input_type -> pb.auth.CreateGroupRequest
-	13, // 13: pb.auth.AuthService.DeleteGroupByID:input_type -> pb.auth.DeleteGroupRequest
-	14, // 14: pb.auth.AuthService.FindGroupPermission:input_type -> pb.auth.FindGroupPermissionRequest
-	15, // 15: pb.auth.AuthService.CreateGroupPermission:input_type -> pb.auth.CreateGroupPermissionRequest
-	16, // 16: pb.auth.AuthService.DeleteGroupPermission:input_type -> pb.auth.DeleteGroupPermissionRequest
-	17, // 17: pb.auth.AuthService.FindAllUserGroups:input_type -> pb.auth.FindAllUserGroupsRequest
-	18, // 18: pb.auth.AuthService.FindUserGroup:input_type -> pb.auth.FindUserGroupRequest
-	19, // 19: pb.auth.AuthService.CreateUserGroup:input_type -> pb.auth.CreateUserGroupRequest
-	20, // 20: pb.auth.AuthService.DeleteUserGroup:input_type -> pb.auth.DeleteUserGroupRequest
-	21, // 21: pb.auth.AuthService.GetUserInfo:output_type -> pb.auth.User
-	22, // 22: pb.auth.AuthService.HasAccess:output_type -> google.protobuf.BoolValue
-	23, // 23: pb.auth.AuthService.RefreshToken:output_type -> pb.auth.AuthResponse
-	23, // 24: pb.auth.AuthService.Login:output_type -> pb.auth.AuthResponse
-	23, // 25: pb.auth.AuthService.Register:output_type -> pb.auth.AuthResponse
-	24, // 26: pb.auth.AuthService.Logout:output_type -> google.protobuf.Empty
-	25, // 27: pb.auth.AuthService.FindPermissionByID:output_type -> pb.auth.Permission
-	25, // 28: pb.auth.AuthService.FindPermissionByName:output_type -> pb.auth.Permission
-	25, // 29: pb.auth.AuthService.CreatePermission:output_type -> pb.auth.Permission
-	24, // 30: pb.auth.AuthService.DeletePermission:output_type -> google.protobuf.Empty
-	26, // 31: pb.auth.AuthService.FindGroupByID:output_type -> pb.auth.Group
-	26, // 32: pb.auth.AuthService.FindGroupByName:output_type -> pb.auth.Group
-	26, // 33: pb.auth.AuthService.CreateGroup:output_type -> pb.auth.Group
-	24, // 34: pb.auth.AuthService.DeleteGroupByID:output_type -> google.protobuf.Empty
-	27, // 35: pb.auth.AuthService.FindGroupPermission:output_type -> pb.auth.GroupPermission
-	27, // 36: pb.auth.AuthService.CreateGroupPermission:output_type -> pb.auth.GroupPermission
-	24, // 37: pb.auth.AuthService.DeleteGroupPermission:output_type -> google.protobuf.Empty
-	28, // 38: pb.auth.AuthService.FindAllUserGroups:output_type -> pb.auth.FindAllUserGroupsResponse
-	29, // 39: pb.auth.AuthService.FindUserGroup:output_type -> pb.auth.UserGroup
-	29, // 40: pb.auth.AuthService.CreateUserGroup:output_type -> pb.auth.UserGroup
-	24, // 41: pb.auth.AuthService.DeleteUserGroup:output_type -> google.protobuf.Empty
-	21, // [21:42] is the sub-list for method output_type
-	0,  // [0:21] is the sub-list for method input_type
+	3,  // 3: pb.auth.AuthService.ValidateToken:input_type -> pb.auth.ValidateTokenRequest
+	4,  // 4: pb.auth.AuthService.GetJWKS:input_type -> google.protobuf.Empty
+	5,  // 5: pb.auth.AuthService.Login:input_type -> pb.auth.LoginRequest
+	6,  // 6: pb.auth.AuthService.Register:input_type -> pb.auth.RegisterRequest
+	7,  // 7: pb.auth.AuthService.Logout:input_type -> pb.auth.LogoutRequest
+	8,  // 8: pb.auth.AuthService.ChangePassword:input_type -> pb.auth.ChangePasswordRequest
+	9,  // 9: pb.auth.AuthService.UpdateProfile:input_type -> pb.auth.UpdateProfileRequest
+	10, // 10: pb.auth.AuthService.UnlockUser:input_type -> pb.auth.UnlockUserRequest
+	11, // 11: pb.auth.AuthService.SuspendUser:input_type -> pb.auth.SuspendUserRequest
+	12, // 12: pb.auth.AuthService.DeactivateUser:input_type -> pb.auth.DeactivateUserRequest
+	13, // 13: pb.auth.AuthService.ReactivateUser:input_type -> pb.auth.ReactivateUserRequest
+	14, // 14: pb.auth.AuthService.DeleteUser:input_type -> pb.auth.DeleteUserRequest
+	15, // 15: pb.auth.AuthService.UpdateUser:input_type -> pb.auth.UpdateUserRequest
+	16, // 16: pb.auth.AuthService.ListUsers:input_type -> pb.auth.ListUsersRequest
+	17, // 17: pb.auth.AuthService.FindPermissionByID:input_type -> pb.auth.FindPermissionByIDRequest
+	18, // 18: pb.auth.AuthService.FindPermissionByName:input_type -> pb.auth.FindPermissionByNameRequest
+	19, // 19: pb.auth.AuthService.CreatePermission:input_type -> pb.auth.CreatePermissionRequest
+	20, // 20: pb.auth.AuthService.DeletePermission:input_type -> pb.auth.DeletePermissionRequest
+	21, // 21: pb.auth.AuthService.ListPermissions:input_type -> pb.auth.ListPermissionsRequest
+	22, // 22: pb.auth.AuthService.FindGroupByID:input_type -> pb.auth.FindGroupByIDRequest
+	23, // 23: pb.auth.AuthService.FindGroupByName:input_type -> pb.auth.FindGroupByNameRequest
+	24, // 24: pb.auth.AuthService.CreateGroup:input_type -> pb.auth.CreateGroupRequest
+	25, // 25: pb.auth.AuthService.DeleteGroupByID:input_type -> pb.auth.DeleteGroupRequest
+	26, // 26: pb.auth.AuthService.ListGroups:input_type -> pb.auth.ListGroupsRequest
+	27, // 27: pb.auth.AuthService.FindGroupPermission:input_type -> pb.auth.FindGroupPermissionRequest
+	28, // 28: pb.auth.AuthService.CreateGroupPermission:input_type -> pb.auth.CreateGroupPermissionRequest
+	29, // 29: pb.auth.AuthService.DeleteGroupPermission:input_type -> pb.auth.DeleteGroupPermissionRequest
+	30, // 30: pb.auth.AuthService.ListGroupPermissions:input_type -> pb.auth.ListGroupPermissionsRequest
+	31, // 31: pb.auth.AuthService.ListGroupsWithPermission:input_type -> pb.auth.ListGroupsWithPermissionRequest
+	32, // 32: pb.auth.AuthService.FindAllUserGroups:input_type -> pb.auth.FindAllUserGroupsRequest
+	33, // 33: pb.auth.AuthService.FindUserGroup:input_type -> pb.auth.FindUserGroupRequest
+	34, // 34: pb.auth.AuthService.CreateUserGroup:input_type -> pb.auth.CreateUserGroupRequest
+	35, // 35: pb.auth.AuthService.DeleteUserGroup:input_type -> pb.auth.DeleteUserGroupRequest
+	36, // 36: pb.auth.AuthService.ListGroupMembers:input_type -> pb.auth.ListGroupMembersRequest
+	37, // 37: pb.auth.AuthService.ListUsersWithPermission:input_type -> pb.auth.ListUsersWithPermissionRequest
+	38, // 38: pb.auth.AuthService.ListSessions:input_type -> pb.auth.ListSessionsRequest
+	39, // 39: pb.auth.AuthService.RevokeSession:input_type -> pb.auth.RevokeSessionRequest
+	40, // 40: pb.auth.AuthService.RevokeAllSessions:input_type -> pb.auth.RevokeAllSessionsRequest
+	41, // 41: pb.auth.AuthService.CreatePersonalAccessToken:input_type -> pb.auth.CreatePersonalAccessTokenRequest
+	4,  // 42: pb.auth.AuthService.ListPersonalAccessTokens:input_type -> google.protobuf.Empty
+	42, // 43: pb.auth.AuthService.RevokePersonalAccessToken:input_type -> pb.auth.RevokePersonalAccessTokenRequest
+	43, // 44: pb.auth.AuthService.ClientCredentials:input_type -> pb.auth.ClientCredentialsRequest
+	44, // 45: pb.auth.AuthService.CreateServiceAccount:input_type -> pb.auth.CreateServiceAccountRequest
+	45, // 46: pb.auth.AuthService.FindServiceAccountByID:input_type -> pb.auth.FindServiceAccountByIDRequest
+	46, // 47: pb.auth.AuthService.DeleteServiceAccount:input_type -> pb.auth.DeleteServiceAccountRequest
+	47, // 48: pb.auth.AuthService.FindAllServiceAccountGroups:input_type -> pb.auth.FindAllServiceAccountGroupsRequest
+	48, // 49: pb.auth.AuthService.CreateServiceAccountGroup:input_type -> pb.auth.ServiceAccountGroupRequest
+	48, // 50: pb.auth.AuthService.DeleteServiceAccountGroup:input_type -> pb.auth.ServiceAccountGroupRequest
+	49, // 51: pb.auth.AuthService.CreateOAuthClient:input_type -> pb.auth.CreateOAuthClientRequest
+	50, // 52: pb.auth.AuthService.DeleteOAuthClient:input_type -> pb.auth.DeleteOAuthClientRequest
+	4,  // 53: pb.auth.AuthService.FindAllUserIdentities:input_type -> google.protobuf.Empty
+	51, // 54: pb.auth.AuthService.LinkUserIdentity:input_type -> pb.auth.LinkUserIdentityRequest
+	52, // 55: pb.auth.AuthService.UnlinkUserIdentity:input_type -> pb.auth.UnlinkUserIdentityRequest
+	4,  // 56: pb.auth.AuthService.EnrollMFA:input_type -> google.protobuf.Empty
+	53, // 57: pb.auth.AuthService.ConfirmMFA:input_type -> pb.auth.ConfirmMFARequest
+	54, // 58: pb.auth.AuthService.DisableMFA:input_type -> pb.auth.DisableMFARequest
+	55, // 59: pb.auth.AuthService.VerifyMFA:input_type -> pb.auth.VerifyMFARequest
+	56, // 60: pb.auth.AuthService.SendVerificationEmail:input_type -> pb.auth.SendVerificationEmailRequest
+	57, // 61: pb.auth.AuthService.VerifyEmail:input_type -> pb.auth.VerifyEmailRequest
+	58, // 62: pb.auth.AuthService.RequestPasswordReset:input_type -> pb.auth.RequestPasswordResetRequest
+	59, // 63: pb.auth.AuthService.ResetPassword:input_type -> pb.auth.ResetPasswordRequest
+	60, // 64: pb.auth.AuthService.GetUserInfo:output_type -> pb.auth.User
+	61, // 65: pb.auth.AuthService.HasAccess:output_type -> google.protobuf.BoolValue
+	62, // 66: pb.auth.AuthService.RefreshToken:output_type -> pb.auth.AuthResponse
+	63, // 67: pb.auth.AuthService.ValidateToken:output_type -> pb.auth.ValidateTokenResponse
+	64, // 68: pb.auth.AuthService.GetJWKS:output_type -> pb.auth.GetJWKSResponse
+	62, // 69: pb.auth.AuthService.Login:output_type -> pb.auth.AuthResponse
+	62, // 70: pb.auth.AuthService.Register:output_type -> pb.auth.AuthResponse
+	4,  // 71: pb.auth.AuthService.Logout:output_type -> google.protobuf.Empty
+	4,  // 72: pb.auth.AuthService.ChangePassword:output_type -> google.protobuf.Empty
+	60, // 73: pb.auth.AuthService.UpdateProfile:output_type -> pb.auth.User
+	4,  // 74: pb.auth.AuthService.UnlockUser:output_type -> google.protobuf.Empty
+	4,  // 75: pb.auth.AuthService.SuspendUser:output_type -> google.protobuf.Empty
+	4,  // 76: pb.auth.AuthService.DeactivateUser:output_type -> google.protobuf.Empty
+	4,  // 77: pb.auth.AuthService.ReactivateUser:output_type -> google.protobuf.Empty
+	4,  // 78: pb.auth.AuthService.DeleteUser:output_type -> google.protobuf.Empty
+	60, // 79: pb.auth.AuthService.UpdateUser:output_type -> pb.auth.User
+	65, // 80: pb.auth.AuthService.ListUsers:output_type -> pb.auth.ListUsersResponse
+	66, // 81: pb.auth.AuthService.FindPermissionByID:output_type -> pb.auth.Permission
+	66, // 82: pb.auth.AuthService.FindPermissionByName:output_type -> pb.auth.Permission
+	66, // 83: pb.auth.AuthService.CreatePermission:output_type -> pb.auth.Permission
+	4,  // 84: pb.auth.AuthService.DeletePermission:output_type -> google.protobuf.Empty
+	67, // 85: pb.auth.AuthService.ListPermissions:output_type -> pb.auth.ListPermissionsResponse
+	68, // 86: pb.auth.AuthService.FindGroupByID:output_type -> pb.auth.Group
+	68, // 87: pb.auth.AuthService.FindGroupByName:output_type -> pb.auth.Group
+	68, // 88: pb.auth.AuthService.CreateGroup:output_type -> pb.auth.Group
+	4,  // 89: pb.auth.AuthService.DeleteGroupByID:output_type -> google.protobuf.Empty
+	69, // 90: pb.auth.AuthService.ListGroups:output_type -> pb.auth.ListGroupsResponse
+	70, // 91: pb.auth.AuthService.FindGroupPermission:output_type -> pb.auth.GroupPermission
+	70, // 92: pb.auth.AuthService.CreateGroupPermission:output_type -> pb.auth.GroupPermission
+	4,  // 93: pb.auth.AuthService.DeleteGroupPermission:output_type -> google.protobuf.Empty
+	71, // 94: pb.auth.AuthService.ListGroupPermissions:output_type -> pb.auth.ListGroupPermissionsResponse
+	72, // 95: pb.auth.AuthService.ListGroupsWithPermission:output_type -> pb.auth.ListGroupsWithPermissionResponse
+	73, // 96: pb.auth.AuthService.FindAllUserGroups:output_type -> pb.auth.FindAllUserGroupsResponse
+	74, // 97: pb.auth.AuthService.FindUserGroup:output_type -> pb.auth.UserGroup
+	74, // 98: pb.auth.AuthService.CreateUserGroup:output_type -> pb.auth.UserGroup
+	4,  // 99: pb.auth.AuthService.DeleteUserGroup:output_type -> google.protobuf.Empty
+	75, // 100: pb.auth.AuthService.ListGroupMembers:output_type -> pb.auth.ListGroupMembersResponse
+	76, // 101: pb.auth.AuthService.ListUsersWithPermission:output_type -> pb.auth.ListUsersWithPermissionResponse
+	77, // 102: pb.auth.AuthService.ListSessions:output_type -> pb.auth.ListSessionsResponse
+	4,  // 103: pb.auth.AuthService.RevokeSession:output_type -> google.protobuf.Empty
+	4,  // 104: pb.auth.AuthService.RevokeAllSessions:output_type -> google.protobuf.Empty
+	78, // 105: pb.auth.AuthService.CreatePersonalAccessToken:output_type -> pb.auth.CreatePersonalAccessTokenResponse
+	79, // 106: pb.auth.AuthService.ListPersonalAccessTokens:output_type -> pb.auth.ListPersonalAccessTokensResponse
+	4,  // 107: pb.auth.AuthService.RevokePersonalAccessToken:output_type -> google.protobuf.Empty
+	62, // 108: pb.auth.AuthService.ClientCredentials:output_type -> pb.auth.AuthResponse
+	80, // 109: pb.auth.AuthService.CreateServiceAccount:output_type -> pb.auth.CreateServiceAccountResponse
+	81, // 110: pb.auth.AuthService.FindServiceAccountByID:output_type -> pb.auth.ServiceAccount
+	4,  // 111: pb.auth.AuthService.DeleteServiceAccount:output_type -> google.protobuf.Empty
+	82, // 112: pb.auth.AuthService.FindAllServiceAccountGroups:output_type -> pb.auth.FindAllServiceAccountGroupsResponse
+	83, // 113: pb.auth.AuthService.CreateServiceAccountGroup:output_type -> pb.auth.ServiceAccountGroup
+	4,  // 114: pb.auth.AuthService.DeleteServiceAccountGroup:output_type -> google.protobuf.Empty
+	84, // 115: pb.auth.AuthService.CreateOAuthClient:output_type -> pb.auth.CreateOAuthClientResponse
+	4,  // 116: pb.auth.AuthService.DeleteOAuthClient:output_type -> google.protobuf.Empty
+	85, // 117: pb.auth.AuthService.FindAllUserIdentities:output_type -> pb.auth.FindAllUserIdentitiesResponse
+	86, // 118: pb.auth.AuthService.LinkUserIdentity:output_type -> pb.auth.LinkUserIdentityResponse
+	4,  // 119: pb.auth.AuthService.UnlinkUserIdentity:output_type -> google.protobuf.Empty
+	87, // 120: pb.auth.AuthService.EnrollMFA:output_type -> pb.auth.EnrollMFAResponse
+	88, // 121: pb.auth.AuthService.ConfirmMFA:output_type -> pb.auth.ConfirmMFAResponse
+	4,  // 122: pb.auth.AuthService.DisableMFA:output_type -> google.protobuf.Empty
+	62, // 123: pb.auth.AuthService.VerifyMFA:output_type -> pb.auth.AuthResponse
+	4,  // 124: pb.auth.AuthService.SendVerificationEmail:output_type -> google.protobuf.Empty
+	4,  // 125: pb.auth.AuthService.VerifyEmail:output_type -> google.protobuf.Empty
+	4,  // 126: pb.auth.AuthService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	4,  // 127: pb.auth.AuthService.ResetPassword:output_type -> google.protobuf.Empty
+	64, // [64:128] is the sub-list for method output_type
+	0,  // [0:64] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -235,6 +620,14 @@ func file_pb_auth_auth_service_proto_init() {
 	file_pb_auth_group_proto_init()
 	file_pb_auth_group_permission_proto_init()
 	file_pb_auth_user_group_proto_init()
+	file_pb_auth_session_proto_init()
+	file_pb_auth_personal_access_token_proto_init()
+	file_pb_auth_service_account_proto_init()
+	file_pb_auth_oauth_proto_init()
+	file_pb_auth_user_identity_proto_init()
+	file_pb_auth_mfa_proto_init()
+	file_pb_auth_email_verification_proto_init()
+	file_pb_auth_password_reset_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
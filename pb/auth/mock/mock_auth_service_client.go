@@ -38,6 +38,66 @@ func (m *MockAuthServiceClient) EXPECT() *MockAuthServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServiceClient) ChangePassword(arg0 context.Context, arg1 *auth.ChangePasswordRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceClientMockRecorder) ChangePassword(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ChangePassword), varargs...)
+}
+
+// ClientCredentials mocks base method.
+func (m *MockAuthServiceClient) ClientCredentials(arg0 context.Context, arg1 *auth.ClientCredentialsRequest, arg2 ...grpc.CallOption) (*auth.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClientCredentials", varargs...)
+	ret0, _ := ret[0].(*auth.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClientCredentials indicates an expected call of ClientCredentials.
+func (mr *MockAuthServiceClientMockRecorder) ClientCredentials(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientCredentials", reflect.TypeOf((*MockAuthServiceClient)(nil).ClientCredentials), varargs...)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockAuthServiceClient) ConfirmMFA(arg0 context.Context, arg1 *auth.ConfirmMFARequest, arg2 ...grpc.CallOption) (*auth.ConfirmMFAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmMFA", varargs...)
+	ret0, _ := ret[0].(*auth.ConfirmMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockAuthServiceClientMockRecorder) ConfirmMFA(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).ConfirmMFA), varargs...)
+}
+
 // CreateGroup mocks base method.
 func (m *MockAuthServiceClient) CreateGroup(arg0 context.Context, arg1 *auth.CreateGroupRequest, arg2 ...grpc.CallOption) (*auth.Group, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +138,26 @@ func (mr *MockAuthServiceClientMockRecorder) CreateGroupPermission(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupPermission", reflect.TypeOf((*MockAuthServiceClient)(nil).CreateGroupPermission), varargs...)
 }
 
+// CreateOAuthClient mocks base method.
+func (m *MockAuthServiceClient) CreateOAuthClient(arg0 context.Context, arg1 *auth.CreateOAuthClientRequest, arg2 ...grpc.CallOption) (*auth.CreateOAuthClientResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOAuthClient", varargs...)
+	ret0, _ := ret[0].(*auth.CreateOAuthClientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOAuthClient indicates an expected call of CreateOAuthClient.
+func (mr *MockAuthServiceClientMockRecorder) CreateOAuthClient(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOAuthClient", reflect.TypeOf((*MockAuthServiceClient)(nil).CreateOAuthClient), varargs...)
+}
+
 // CreatePermission mocks base method.
 func (m *MockAuthServiceClient) CreatePermission(arg0 context.Context, arg1 *auth.CreatePermissionRequest, arg2 ...grpc.CallOption) (*auth.Permission, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +178,66 @@ func (mr *MockAuthServiceClientMockRecorder) CreatePermission(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermission", reflect.TypeOf((*MockAuthServiceClient)(nil).CreatePermission), varargs...)
 }
 
+// CreatePersonalAccessToken mocks base method.
+func (m *MockAuthServiceClient) CreatePersonalAccessToken(arg0 context.Context, arg1 *auth.CreatePersonalAccessTokenRequest, arg2 ...grpc.CallOption) (*auth.CreatePersonalAccessTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePersonalAccessToken", varargs...)
+	ret0, _ := ret[0].(*auth.CreatePersonalAccessTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonalAccessToken indicates an expected call of CreatePersonalAccessToken.
+func (mr *MockAuthServiceClientMockRecorder) CreatePersonalAccessToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalAccessToken", reflect.TypeOf((*MockAuthServiceClient)(nil).CreatePersonalAccessToken), varargs...)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockAuthServiceClient) CreateServiceAccount(arg0 context.Context, arg1 *auth.CreateServiceAccountRequest, arg2 ...grpc.CallOption) (*auth.CreateServiceAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccount", varargs...)
+	ret0, _ := ret[0].(*auth.CreateServiceAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockAuthServiceClientMockRecorder) CreateServiceAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockAuthServiceClient)(nil).CreateServiceAccount), varargs...)
+}
+
+// CreateServiceAccountGroup mocks base method.
+func (m *MockAuthServiceClient) CreateServiceAccountGroup(arg0 context.Context, arg1 *auth.ServiceAccountGroupRequest, arg2 ...grpc.CallOption) (*auth.ServiceAccountGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountGroup", varargs...)
+	ret0, _ := ret[0].(*auth.ServiceAccountGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountGroup indicates an expected call of CreateServiceAccountGroup.
+func (mr *MockAuthServiceClientMockRecorder) CreateServiceAccountGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountGroup", reflect.TypeOf((*MockAuthServiceClient)(nil).CreateServiceAccountGroup), varargs...)
+}
+
 // CreateUserGroup mocks base method.
 func (m *MockAuthServiceClient) CreateUserGroup(arg0 context.Context, arg1 *auth.CreateUserGroupRequest, arg2 ...grpc.CallOption) (*auth.UserGroup, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +258,26 @@ func (mr *MockAuthServiceClientMockRecorder) CreateUserGroup(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserGroup", reflect.TypeOf((*MockAuthServiceClient)(nil).CreateUserGroup), varargs...)
 }
 
+// DeactivateUser mocks base method.
+func (m *MockAuthServiceClient) DeactivateUser(arg0 context.Context, arg1 *auth.DeactivateUserRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeactivateUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockAuthServiceClientMockRecorder) DeactivateUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockAuthServiceClient)(nil).DeactivateUser), varargs...)
+}
+
 // DeleteGroupByID mocks base method.
 func (m *MockAuthServiceClient) DeleteGroupByID(arg0 context.Context, arg1 *auth.DeleteGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +318,26 @@ func (mr *MockAuthServiceClientMockRecorder) DeleteGroupPermission(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupPermission", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteGroupPermission), varargs...)
 }
 
+// DeleteOAuthClient mocks base method.
+func (m *MockAuthServiceClient) DeleteOAuthClient(arg0 context.Context, arg1 *auth.DeleteOAuthClientRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOAuthClient", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOAuthClient indicates an expected call of DeleteOAuthClient.
+func (mr *MockAuthServiceClientMockRecorder) DeleteOAuthClient(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuthClient", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteOAuthClient), varargs...)
+}
+
 // DeletePermission mocks base method.
 func (m *MockAuthServiceClient) DeletePermission(arg0 context.Context, arg1 *auth.DeletePermissionRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +358,66 @@ func (mr *MockAuthServiceClientMockRecorder) DeletePermission(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermission", reflect.TypeOf((*MockAuthServiceClient)(nil).DeletePermission), varargs...)
 }
 
+// DeleteServiceAccount mocks base method.
+func (m *MockAuthServiceClient) DeleteServiceAccount(arg0 context.Context, arg1 *auth.DeleteServiceAccountRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceAccount", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceAccount indicates an expected call of DeleteServiceAccount.
+func (mr *MockAuthServiceClientMockRecorder) DeleteServiceAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccount", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteServiceAccount), varargs...)
+}
+
+// DeleteServiceAccountGroup mocks base method.
+func (m *MockAuthServiceClient) DeleteServiceAccountGroup(arg0 context.Context, arg1 *auth.ServiceAccountGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceAccountGroup", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceAccountGroup indicates an expected call of DeleteServiceAccountGroup.
+func (mr *MockAuthServiceClientMockRecorder) DeleteServiceAccountGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccountGroup", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteServiceAccountGroup), varargs...)
+}
+
+// DeleteUser mocks base method.
+func (m *MockAuthServiceClient) DeleteUser(arg0 context.Context, arg1 *auth.DeleteUserRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockAuthServiceClientMockRecorder) DeleteUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteUser), varargs...)
+}
+
 // DeleteUserGroup mocks base method.
 func (m *MockAuthServiceClient) DeleteUserGroup(arg0 context.Context, arg1 *auth.DeleteUserGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +438,66 @@ func (mr *MockAuthServiceClientMockRecorder) DeleteUserGroup(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserGroup", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteUserGroup), varargs...)
 }
 
+// DisableMFA mocks base method.
+func (m *MockAuthServiceClient) DisableMFA(arg0 context.Context, arg1 *auth.DisableMFARequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableMFA", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthServiceClientMockRecorder) DisableMFA(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).DisableMFA), varargs...)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAuthServiceClient) EnrollMFA(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*auth.EnrollMFAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnrollMFA", varargs...)
+	ret0, _ := ret[0].(*auth.EnrollMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAuthServiceClientMockRecorder) EnrollMFA(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).EnrollMFA), varargs...)
+}
+
+// FindAllServiceAccountGroups mocks base method.
+func (m *MockAuthServiceClient) FindAllServiceAccountGroups(arg0 context.Context, arg1 *auth.FindAllServiceAccountGroupsRequest, arg2 ...grpc.CallOption) (*auth.FindAllServiceAccountGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllServiceAccountGroups", varargs...)
+	ret0, _ := ret[0].(*auth.FindAllServiceAccountGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllServiceAccountGroups indicates an expected call of FindAllServiceAccountGroups.
+func (mr *MockAuthServiceClientMockRecorder) FindAllServiceAccountGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllServiceAccountGroups", reflect.TypeOf((*MockAuthServiceClient)(nil).FindAllServiceAccountGroups), varargs...)
+}
+
 // FindAllUserGroups mocks base method.
 func (m *MockAuthServiceClient) FindAllUserGroups(arg0 context.Context, arg1 *auth.FindAllUserGroupsRequest, arg2 ...grpc.CallOption) (*auth.FindAllUserGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +518,26 @@ func (mr *MockAuthServiceClientMockRecorder) FindAllUserGroups(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUserGroups", reflect.TypeOf((*MockAuthServiceClient)(nil).FindAllUserGroups), varargs...)
 }
 
+// FindAllUserIdentities mocks base method.
+func (m *MockAuthServiceClient) FindAllUserIdentities(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*auth.FindAllUserIdentitiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllUserIdentities", varargs...)
+	ret0, _ := ret[0].(*auth.FindAllUserIdentitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllUserIdentities indicates an expected call of FindAllUserIdentities.
+func (mr *MockAuthServiceClientMockRecorder) FindAllUserIdentities(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUserIdentities", reflect.TypeOf((*MockAuthServiceClient)(nil).FindAllUserIdentities), varargs...)
+}
+
 // FindGroupByID mocks base method.
 func (m *MockAuthServiceClient) FindGroupByID(arg0 context.Context, arg1 *auth.FindGroupByIDRequest, arg2 ...grpc.CallOption) (*auth.Group, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +638,26 @@ func (mr *MockAuthServiceClientMockRecorder) FindPermissionByName(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPermissionByName", reflect.TypeOf((*MockAuthServiceClient)(nil).FindPermissionByName), varargs...)
 }
 
+// FindServiceAccountByID mocks base method.
+func (m *MockAuthServiceClient) FindServiceAccountByID(arg0 context.Context, arg1 *auth.FindServiceAccountByIDRequest, arg2 ...grpc.CallOption) (*auth.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindServiceAccountByID", varargs...)
+	ret0, _ := ret[0].(*auth.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServiceAccountByID indicates an expected call of FindServiceAccountByID.
+func (mr *MockAuthServiceClientMockRecorder) FindServiceAccountByID(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServiceAccountByID", reflect.TypeOf((*MockAuthServiceClient)(nil).FindServiceAccountByID), varargs...)
+}
+
 // FindUserGroup mocks base method.
 func (m *MockAuthServiceClient) FindUserGroup(arg0 context.Context, arg1 *auth.FindUserGroupRequest, arg2 ...grpc.CallOption) (*auth.UserGroup, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +678,26 @@ func (mr *MockAuthServiceClientMockRecorder) FindUserGroup(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserGroup", reflect.TypeOf((*MockAuthServiceClient)(nil).FindUserGroup), varargs...)
 }
 
+// GetJWKS mocks base method.
+func (m *MockAuthServiceClient) GetJWKS(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*auth.GetJWKSResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetJWKS", varargs...)
+	ret0, _ := ret[0].(*auth.GetJWKSResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJWKS indicates an expected call of GetJWKS.
+func (mr *MockAuthServiceClientMockRecorder) GetJWKS(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockAuthServiceClient)(nil).GetJWKS), varargs...)
+}
+
 // GetUserInfo mocks base method.
 func (m *MockAuthServiceClient) GetUserInfo(arg0 context.Context, arg1 *auth.GetUserInfoRequest, arg2 ...grpc.CallOption) (*auth.User, error) {
 	m.ctrl.T.Helper()
@@ -378,44 +738,264 @@ func (mr *MockAuthServiceClientMockRecorder) HasAccess(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAccess", reflect.TypeOf((*MockAuthServiceClient)(nil).HasAccess), varargs...)
 }
 
-// Login mocks base method.
-func (m *MockAuthServiceClient) Login(arg0 context.Context, arg1 *auth.LoginRequest, arg2 ...grpc.CallOption) (*auth.AuthResponse, error) {
+// LinkUserIdentity mocks base method.
+func (m *MockAuthServiceClient) LinkUserIdentity(arg0 context.Context, arg1 *auth.LinkUserIdentityRequest, arg2 ...grpc.CallOption) (*auth.LinkUserIdentityResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Login", varargs...)
-	ret0, _ := ret[0].(*auth.AuthResponse)
+	ret := m.ctrl.Call(m, "LinkUserIdentity", varargs...)
+	ret0, _ := ret[0].(*auth.LinkUserIdentityResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
-func (mr *MockAuthServiceClientMockRecorder) Login(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// LinkUserIdentity indicates an expected call of LinkUserIdentity.
+func (mr *MockAuthServiceClientMockRecorder) LinkUserIdentity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceClient)(nil).Login), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkUserIdentity", reflect.TypeOf((*MockAuthServiceClient)(nil).LinkUserIdentity), varargs...)
 }
 
-// Logout mocks base method.
-func (m *MockAuthServiceClient) Logout(arg0 context.Context, arg1 *auth.LogoutRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+// ListGroupMembers mocks base method.
+func (m *MockAuthServiceClient) ListGroupMembers(arg0 context.Context, arg1 *auth.ListGroupMembersRequest, arg2 ...grpc.CallOption) (*auth.ListGroupMembersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Logout", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret := m.ctrl.Call(m, "ListGroupMembers", varargs...)
+	ret0, _ := ret[0].(*auth.ListGroupMembersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Logout indicates an expected call of Logout.
-func (mr *MockAuthServiceClientMockRecorder) Logout(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListGroupMembers indicates an expected call of ListGroupMembers.
+func (mr *MockAuthServiceClientMockRecorder) ListGroupMembers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupMembers", reflect.TypeOf((*MockAuthServiceClient)(nil).ListGroupMembers), varargs...)
+}
+
+// ListGroupPermissions mocks base method.
+func (m *MockAuthServiceClient) ListGroupPermissions(arg0 context.Context, arg1 *auth.ListGroupPermissionsRequest, arg2 ...grpc.CallOption) (*auth.ListGroupPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGroupPermissions", varargs...)
+	ret0, _ := ret[0].(*auth.ListGroupPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupPermissions indicates an expected call of ListGroupPermissions.
+func (mr *MockAuthServiceClientMockRecorder) ListGroupPermissions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupPermissions", reflect.TypeOf((*MockAuthServiceClient)(nil).ListGroupPermissions), varargs...)
+}
+
+// ListGroups mocks base method.
+func (m *MockAuthServiceClient) ListGroups(arg0 context.Context, arg1 *auth.ListGroupsRequest, arg2 ...grpc.CallOption) (*auth.ListGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGroups", varargs...)
+	ret0, _ := ret[0].(*auth.ListGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockAuthServiceClientMockRecorder) ListGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockAuthServiceClient)(nil).ListGroups), varargs...)
+}
+
+// ListGroupsWithPermission mocks base method.
+func (m *MockAuthServiceClient) ListGroupsWithPermission(arg0 context.Context, arg1 *auth.ListGroupsWithPermissionRequest, arg2 ...grpc.CallOption) (*auth.ListGroupsWithPermissionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGroupsWithPermission", varargs...)
+	ret0, _ := ret[0].(*auth.ListGroupsWithPermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupsWithPermission indicates an expected call of ListGroupsWithPermission.
+func (mr *MockAuthServiceClientMockRecorder) ListGroupsWithPermission(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupsWithPermission", reflect.TypeOf((*MockAuthServiceClient)(nil).ListGroupsWithPermission), varargs...)
+}
+
+// ListPermissions mocks base method.
+func (m *MockAuthServiceClient) ListPermissions(arg0 context.Context, arg1 *auth.ListPermissionsRequest, arg2 ...grpc.CallOption) (*auth.ListPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPermissions", varargs...)
+	ret0, _ := ret[0].(*auth.ListPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockAuthServiceClientMockRecorder) ListPermissions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockAuthServiceClient)(nil).ListPermissions), varargs...)
+}
+
+// ListPersonalAccessTokens mocks base method.
+func (m *MockAuthServiceClient) ListPersonalAccessTokens(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*auth.ListPersonalAccessTokensResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPersonalAccessTokens", varargs...)
+	ret0, _ := ret[0].(*auth.ListPersonalAccessTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalAccessTokens indicates an expected call of ListPersonalAccessTokens.
+func (mr *MockAuthServiceClientMockRecorder) ListPersonalAccessTokens(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalAccessTokens", reflect.TypeOf((*MockAuthServiceClient)(nil).ListPersonalAccessTokens), varargs...)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthServiceClient) ListSessions(arg0 context.Context, arg1 *auth.ListSessionsRequest, arg2 ...grpc.CallOption) (*auth.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*auth.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServiceClientMockRecorder) ListSessions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthServiceClient)(nil).ListSessions), varargs...)
+}
+
+// ListUsers mocks base method.
+func (m *MockAuthServiceClient) ListUsers(arg0 context.Context, arg1 *auth.ListUsersRequest, arg2 ...grpc.CallOption) (*auth.ListUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(*auth.ListUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAuthServiceClientMockRecorder) ListUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAuthServiceClient)(nil).ListUsers), varargs...)
+}
+
+// ListUsersWithPermission mocks base method.
+func (m *MockAuthServiceClient) ListUsersWithPermission(arg0 context.Context, arg1 *auth.ListUsersWithPermissionRequest, arg2 ...grpc.CallOption) (*auth.ListUsersWithPermissionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsersWithPermission", varargs...)
+	ret0, _ := ret[0].(*auth.ListUsersWithPermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersWithPermission indicates an expected call of ListUsersWithPermission.
+func (mr *MockAuthServiceClientMockRecorder) ListUsersWithPermission(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersWithPermission", reflect.TypeOf((*MockAuthServiceClient)(nil).ListUsersWithPermission), varargs...)
+}
+
+// Login mocks base method.
+func (m *MockAuthServiceClient) Login(arg0 context.Context, arg1 *auth.LoginRequest, arg2 ...grpc.CallOption) (*auth.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Login", varargs...)
+	ret0, _ := ret[0].(*auth.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceClientMockRecorder) Login(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceClient)(nil).Login), varargs...)
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceClient) Logout(arg0 context.Context, arg1 *auth.LogoutRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Logout", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceClientMockRecorder) Logout(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
+}
+
+// ReactivateUser mocks base method.
+func (m *MockAuthServiceClient) ReactivateUser(arg0 context.Context, arg1 *auth.ReactivateUserRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReactivateUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateUser indicates an expected call of ReactivateUser.
+func (mr *MockAuthServiceClientMockRecorder) ReactivateUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateUser", reflect.TypeOf((*MockAuthServiceClient)(nil).ReactivateUser), varargs...)
 }
 
 // RefreshToken mocks base method.
@@ -457,3 +1037,283 @@ func (mr *MockAuthServiceClientMockRecorder) Register(arg0, arg1 interface{}, ar
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceClient)(nil).Register), varargs...)
 }
+
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServiceClient) RequestPasswordReset(arg0 context.Context, arg1 *auth.RequestPasswordResetRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPasswordReset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceClientMockRecorder) RequestPasswordReset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServiceClient)(nil).RequestPasswordReset), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthServiceClient) ResetPassword(arg0 context.Context, arg1 *auth.ResetPasswordRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceClientMockRecorder) ResetPassword(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ResetPassword), varargs...)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockAuthServiceClient) RevokeAllSessions(arg0 context.Context, arg1 *auth.RevokeAllSessionsRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAllSessions", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockAuthServiceClientMockRecorder) RevokeAllSessions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeAllSessions), varargs...)
+}
+
+// RevokePersonalAccessToken mocks base method.
+func (m *MockAuthServiceClient) RevokePersonalAccessToken(arg0 context.Context, arg1 *auth.RevokePersonalAccessTokenRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokePersonalAccessToken", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePersonalAccessToken indicates an expected call of RevokePersonalAccessToken.
+func (mr *MockAuthServiceClientMockRecorder) RevokePersonalAccessToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalAccessToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokePersonalAccessToken), varargs...)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthServiceClient) RevokeSession(arg0 context.Context, arg1 *auth.RevokeSessionRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServiceClientMockRecorder) RevokeSession(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeSession), varargs...)
+}
+
+// SendVerificationEmail mocks base method.
+func (m *MockAuthServiceClient) SendVerificationEmail(arg0 context.Context, arg1 *auth.SendVerificationEmailRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendVerificationEmail", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendVerificationEmail indicates an expected call of SendVerificationEmail.
+func (mr *MockAuthServiceClientMockRecorder) SendVerificationEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationEmail", reflect.TypeOf((*MockAuthServiceClient)(nil).SendVerificationEmail), varargs...)
+}
+
+// SuspendUser mocks base method.
+func (m *MockAuthServiceClient) SuspendUser(arg0 context.Context, arg1 *auth.SuspendUserRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockAuthServiceClientMockRecorder) SuspendUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockAuthServiceClient)(nil).SuspendUser), varargs...)
+}
+
+// UnlinkUserIdentity mocks base method.
+func (m *MockAuthServiceClient) UnlinkUserIdentity(arg0 context.Context, arg1 *auth.UnlinkUserIdentityRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnlinkUserIdentity", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlinkUserIdentity indicates an expected call of UnlinkUserIdentity.
+func (mr *MockAuthServiceClientMockRecorder) UnlinkUserIdentity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkUserIdentity", reflect.TypeOf((*MockAuthServiceClient)(nil).UnlinkUserIdentity), varargs...)
+}
+
+// UnlockUser mocks base method.
+func (m *MockAuthServiceClient) UnlockUser(arg0 context.Context, arg1 *auth.UnlockUserRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnlockUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockAuthServiceClientMockRecorder) UnlockUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockAuthServiceClient)(nil).UnlockUser), varargs...)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockAuthServiceClient) UpdateProfile(arg0 context.Context, arg1 *auth.UpdateProfileRequest, arg2 ...grpc.CallOption) (*auth.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProfile", varargs...)
+	ret0, _ := ret[0].(*auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockAuthServiceClientMockRecorder) UpdateProfile(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockAuthServiceClient)(nil).UpdateProfile), varargs...)
+}
+
+// UpdateUser mocks base method.
+func (m *MockAuthServiceClient) UpdateUser(arg0 context.Context, arg1 *auth.UpdateUserRequest, arg2 ...grpc.CallOption) (*auth.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUser", varargs...)
+	ret0, _ := ret[0].(*auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockAuthServiceClientMockRecorder) UpdateUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockAuthServiceClient)(nil).UpdateUser), varargs...)
+}
+
+// ValidateToken mocks base method.
+func (m *MockAuthServiceClient) ValidateToken(arg0 context.Context, arg1 *auth.ValidateTokenRequest, arg2 ...grpc.CallOption) (*auth.ValidateTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateToken", varargs...)
+	ret0, _ := ret[0].(*auth.ValidateTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockAuthServiceClientMockRecorder) ValidateToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockAuthServiceClient)(nil).ValidateToken), varargs...)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAuthServiceClient) VerifyEmail(arg0 context.Context, arg1 *auth.VerifyEmailRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEmail", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthServiceClientMockRecorder) VerifyEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifyEmail), varargs...)
+}
+
+// VerifyMFA mocks base method.
+func (m *MockAuthServiceClient) VerifyMFA(arg0 context.Context, arg1 *auth.VerifyMFARequest, arg2 ...grpc.CallOption) (*auth.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyMFA", varargs...)
+	ret0, _ := ret[0].(*auth.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthServiceClientMockRecorder) VerifyMFA(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifyMFA), varargs...)
+}
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.2
+// source: pb/auth/password_policy.proto
+
+package auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PasswordPolicyError is attached to the InvalidArgument status of a rejected password.
+type PasswordPolicyError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Violations []*PasswordPolicyViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations"`
+}
+
+func (x *PasswordPolicyError) Reset() {
+	*x = PasswordPolicyError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_auth_password_policy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordPolicyError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicyError) ProtoMessage() {}
+
+func (x *PasswordPolicyError) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_auth_password_policy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicyError.ProtoReflect.Descriptor instead.
+func (*PasswordPolicyError) Descriptor() ([]byte, []int) {
+	return file_pb_auth_password_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PasswordPolicyError) GetViolations() []*PasswordPolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type PasswordPolicyViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule        string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+}
+
+func (x *PasswordPolicyViolation) Reset() {
+	*x = PasswordPolicyViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_auth_password_policy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordPolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicyViolation) ProtoMessage() {}
+
+func (x *PasswordPolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_auth_password_policy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicyViolation.ProtoReflect.Descriptor instead.
+func (*PasswordPolicyViolation) Descriptor() ([]byte, []int) {
+	return file_pb_auth_password_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PasswordPolicyViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PasswordPolicyViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_pb_auth_password_policy_proto protoreflect.FileDescriptor
+
+var file_pb_auth_password_policy_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x22, 0x57, 0x0a, 0x13, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x40, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x4f, 0x0a, 0x17, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x09, 0x5a, 0x07, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pb_auth_password_policy_proto_rawDescOnce sync.Once
+	file_pb_auth_password_policy_proto_rawDescData = file_pb_auth_password_policy_proto_rawDesc
+)
+
+func file_pb_auth_password_policy_proto_rawDescGZIP() []byte {
+	file_pb_auth_password_policy_proto_rawDescOnce.Do(func() {
+		file_pb_auth_password_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_auth_password_policy_proto_rawDescData)
+	})
+	return file_pb_auth_password_policy_proto_rawDescData
+}
+
+var file_pb_auth_password_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pb_auth_password_policy_proto_goTypes = []interface{}{
+	(*PasswordPolicyError)(nil),     // 0: pb.auth.PasswordPolicyError
+	(*PasswordPolicyViolation)(nil), // 1: pb.auth.PasswordPolicyViolation
+}
+var file_pb_auth_password_policy_proto_depIdxs = []int32{
+	1, // 0: pb.auth.PasswordPolicyError.violations:type_name -> pb.auth.PasswordPolicyViolation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pb_auth_password_policy_proto_init() }
+func file_pb_auth_password_policy_proto_init() {
+	if File_pb_auth_password_policy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pb_auth_password_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordPolicyError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_auth_password_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordPolicyViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pb_auth_password_policy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pb_auth_password_policy_proto_goTypes,
+		DependencyIndexes: file_pb_auth_password_policy_proto_depIdxs,
+		MessageInfos:      file_pb_auth_password_policy_proto_msgTypes,
+	}.Build()
+	File_pb_auth_password_policy_proto = out.File
+	file_pb_auth_password_policy_proto_rawDesc = nil
+	file_pb_auth_password_policy_proto_goTypes = nil
+	file_pb_auth_password_policy_proto_depIdxs = nil
+}